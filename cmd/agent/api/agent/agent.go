@@ -59,6 +59,7 @@ func SetupHandlers(r *mux.Router) *mux.Router {
 	r.HandleFunc("/config/{setting}", setRuntimeConfig).Methods("POST")
 	r.HandleFunc("/tagger-list", getTaggerList).Methods("GET")
 	r.HandleFunc("/secrets", secretInfo).Methods("GET")
+	r.HandleFunc("/secrets/invalidate", secretInvalidate).Methods("POST")
 
 	return r
 }
@@ -408,6 +409,30 @@ func secretInfo(w http.ResponseWriter, r *http.Request) {
 	w.Write(jsonInfo)
 }
 
+// for testing purpose
+var (
+	invalidateSecretHandle = secrets.InvalidateHandle
+	invalidateAllSecrets   = secrets.InvalidateAll
+)
+
+// secretInvalidate removes the secret handle given in the 'handle' form value from the secrets cache, or every handle
+// when it's empty, and returns the number of handles removed
+func secretInvalidate(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm() //nolint:errcheck
+	handle := r.Form.Get("handle")
+
+	invalidated := 0
+	if handle == "" {
+		invalidated = invalidateAllSecrets()
+	} else if invalidateSecretHandle(handle) {
+		invalidated = 1
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	j, _ := json.Marshal(map[string]int{"invalidated": invalidated})
+	w.Write(j)
+}
+
 // max returns the maximum value between a and b.
 func max(a, b int) int {
 	if a > b {
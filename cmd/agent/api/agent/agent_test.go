@@ -0,0 +1,52 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package agent
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/secrets"
+)
+
+func TestSecretInvalidate(t *testing.T) {
+	var invalidated []string
+	invalidateSecretHandle = func(handle string) bool {
+		invalidated = append(invalidated, handle)
+		return true
+	}
+	invalidateAllSecrets = func() int { return 3 }
+	defer func() {
+		invalidateSecretHandle = secrets.InvalidateHandle
+		invalidateAllSecrets = secrets.InvalidateAll
+	}()
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/agent/secrets/invalidate", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		secretInvalidate(w, req)
+		return w
+	}
+
+	// handles are received as they were given, whatever characters they contain
+	handle := "a+b&c%20d"
+	w := post(url.Values{"handle": {handle}}.Encode())
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{handle}, invalidated)
+	assert.JSONEq(t, `{"invalidated": 1}`, w.Body.String())
+
+	w = post(url.Values{"handle": {""}}.Encode())
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Len(t, invalidated, 1)
+	assert.JSONEq(t, `{"invalidated": 3}`, w.Body.String())
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/metadata"
 	"github.com/DataDog/datadog-agent/pkg/metadata/host"
 	"github.com/DataDog/datadog-agent/pkg/pidfile"
+	"github.com/DataDog/datadog-agent/pkg/secrets"
 	"github.com/DataDog/datadog-agent/pkg/serializer"
 	"github.com/DataDog/datadog-agent/pkg/snmp/traps"
 	"github.com/DataDog/datadog-agent/pkg/status/health"
@@ -323,6 +324,9 @@ func StartAgent() error {
 	// start the autoconfig, this will immediately run any configured check
 	common.StartAutoConfig()
 
+	// keep the secrets up to date when the backend rotates them
+	secrets.StartRefresh()
+
 	// setup the metadata collector
 	common.MetadataScheduler = metadata.NewScheduler(s)
 	if err := metadata.SetupMetadataCollection(common.MetadataScheduler, metadata.AllDefaultCollectors); err != nil {
@@ -360,6 +364,7 @@ func StopAgent() {
 	if common.AC != nil {
 		common.AC.Stop()
 	}
+	secrets.StopRefresh()
 	if common.MetadataScheduler != nil {
 		common.MetadataScheduler.Stop()
 	}
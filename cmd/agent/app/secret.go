@@ -6,21 +6,30 @@
 package app
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/url"
 	"os"
+	"path/filepath"
+	"sort"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
 	"github.com/DataDog/datadog-agent/cmd/agent/common"
 	"github.com/DataDog/datadog-agent/pkg/api/util"
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/providers"
 	"github.com/DataDog/datadog-agent/pkg/config"
 	"github.com/DataDog/datadog-agent/pkg/secrets"
 )
 
 func init() {
 	AgentCmd.AddCommand(secretInfoCommand)
+	secretInfoCommand.AddCommand(secretHandlesCommand)
+	secretInfoCommand.AddCommand(secretCheckCommand)
+	secretInfoCommand.AddCommand(secretInvalidateCommand)
 }
 
 var secretInfoCommand = &cobra.Command{
@@ -58,6 +67,275 @@ var secretInfoCommand = &cobra.Command{
 	},
 }
 
+var secretHandlesCommand = &cobra.Command{
+	Use:   "handles",
+	Short: "Print the secret handles referenced in the configuration, without fetching them.",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		if flagNoColor {
+			color.NoColor = true
+		}
+
+		err := common.SetupConfigWithoutSecrets(confFilePath, "")
+		if err != nil {
+			fmt.Printf("unable to set up global agent configuration: %v\n", err)
+			return nil
+		}
+
+		err = config.SetupLogger(loggerName, config.GetEnv("DD_LOG_LEVEL", "off"), "", "", false, true, false)
+		if err != nil {
+			fmt.Printf("Cannot setup logger, exiting: %v\n", err)
+			return err
+		}
+
+		if err := showSecretHandles(); err != nil {
+			fmt.Println(err)
+			return nil
+		}
+		return nil
+	},
+}
+
+var secretCheckCommand = &cobra.Command{
+	Use:   "check",
+	Short: "Check that the secret backend can be used by the agent.",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		if flagNoColor {
+			color.NoColor = true
+		}
+
+		err := common.SetupConfigWithoutSecrets(confFilePath, "")
+		if err != nil {
+			fmt.Printf("unable to set up global agent configuration: %v\n", err)
+			return nil
+		}
+
+		err = config.SetupLogger(loggerName, config.GetEnv("DD_LOG_LEVEL", "off"), "", "", false, true, false)
+		if err != nil {
+			fmt.Printf("Cannot setup logger, exiting: %v\n", err)
+			return err
+		}
+
+		config.InitSecrets(config.Datadog)
+		results, err := secrets.SelfCheck()
+		if err != nil {
+			fmt.Println(err)
+			return nil
+		}
+		secrets.PrintSelfCheck(color.Output, results)
+
+		for _, result := range results {
+			if result.Error != "" {
+				return fmt.Errorf("the secret backend self check failed")
+			}
+		}
+		return nil
+	},
+}
+
+var secretInvalidateCommand = &cobra.Command{
+	Use:   "invalidate [handle]",
+	Short: "Remove a secret handle, or every handle, from the running agent cache so that it's fetched again on next use.",
+	Long: `Remove a secret handle, or every handle when none is given, from the running agent cache. The invalidated secrets
+are fetched again from the secret backend the next time a configuration referencing them is loaded. Every invalidated
+secret costs a call to the secret backend, don't invalidate them more often than needed.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		if flagNoColor {
+			color.NoColor = true
+		}
+
+		err := common.SetupConfigWithoutSecrets(confFilePath, "")
+		if err != nil {
+			fmt.Printf("unable to set up global agent configuration: %v\n", err)
+			return nil
+		}
+
+		err = config.SetupLogger(loggerName, config.GetEnv("DD_LOG_LEVEL", "off"), "", "", false, true, false)
+		if err != nil {
+			fmt.Printf("Cannot setup logger, exiting: %v\n", err)
+			return err
+		}
+
+		if err := util.SetAuthToken(); err != nil {
+			fmt.Println(err)
+			return nil
+		}
+
+		handle := ""
+		if len(args) == 1 {
+			handle = args[0]
+		}
+		return invalidateSecrets(handle)
+	},
+}
+
+// collectSecretHandles returns the secret handles referenced in the agent configuration and in the integrations
+// configuration files, grouped by the name of the configuration referencing them
+func collectSecretHandles() (map[string][]string, error) {
+	handles := map[string][]string{}
+
+	if path := config.Datadog.ConfigFileUsed(); path != "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %s", path, err)
+		}
+		configHandles, err := secrets.CollectHandles(data)
+		if err != nil {
+			return nil, fmt.Errorf("could not collect the secret handles of %s: %s", path, err)
+		}
+		if len(configHandles) > 0 {
+			handles[filepath.Base(path)] = configHandles
+		}
+	}
+
+	fileProvider := providers.NewFileConfigProvider(common.ConfSearchPaths(config.Datadog.GetString("confd_path")))
+	configs, err := fileProvider.Collect()
+	if err != nil {
+		return nil, fmt.Errorf("could not collect the integrations configuration: %s", err)
+	}
+	for _, conf := range configs {
+		data := [][]byte{conf.InitConfig, conf.MetricConfig, conf.LogsConfig}
+		for _, instance := range conf.Instances {
+			data = append(data, instance)
+		}
+
+		for _, d := range data {
+			configHandles, err := secrets.CollectHandles(d)
+			if err != nil {
+				return nil, fmt.Errorf("could not collect the secret handles of %s: %s", conf.Name, err)
+			}
+			handles[conf.Name] = append(handles[conf.Name], configHandles...)
+		}
+	}
+
+	for origin, originHandles := range handles {
+		if len(originHandles) == 0 {
+			delete(handles, origin)
+			continue
+		}
+		sort.Strings(originHandles)
+		deduped := originHandles[:1]
+		for _, handle := range originHandles[1:] {
+			if handle != deduped[len(deduped)-1] {
+				deduped = append(deduped, handle)
+			}
+		}
+		handles[origin] = deduped
+	}
+	return handles, nil
+}
+
+// getCachedSecretHandles returns the secret handles already fetched by the running agent
+func getCachedSecretHandles() (map[string]bool, error) {
+	if err := util.SetAuthToken(); err != nil {
+		return nil, err
+	}
+
+	c := util.GetClient(false)
+	ipcAddress, err := config.GetIPCAddress()
+	if err != nil {
+		return nil, err
+	}
+	apiConfigURL := fmt.Sprintf("https://%v:%v/agent/secrets", ipcAddress, config.Datadog.GetInt("cmd_port"))
+
+	r, err := util.DoGet(c, apiConfigURL)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &secrets.SecretInfo{}
+	if err := json.Unmarshal(r, info); err != nil {
+		return nil, fmt.Errorf("Could not Unmarshal agent answer: %s", r)
+	}
+
+	cached := map[string]bool{}
+	for handle := range info.SecretsHandles {
+		cached[handle] = true
+	}
+	return cached, nil
+}
+
+func showSecretHandles() error {
+	handles, err := collectSecretHandles()
+	if err != nil {
+		return err
+	}
+	if len(handles) == 0 {
+		fmt.Println("No secret handles are referenced in the configuration.")
+		return nil
+	}
+
+	cached, err := getCachedSecretHandles()
+	if err != nil {
+		fmt.Printf("Could not reach the agent, the cache status of the secret handles is unknown: %v\n\n", err)
+	}
+
+	origins := make([]string, 0, len(handles))
+	for origin := range handles {
+		origins = append(origins, origin)
+	}
+	sort.Strings(origins)
+
+	for _, origin := range origins {
+		fmt.Printf("%s:\n", color.BlueString(origin))
+		for _, handle := range handles[origin] {
+			switch {
+			case cached == nil:
+				fmt.Printf("  - %s\n", handle)
+			case cached[handle]:
+				fmt.Printf("  - %s (%s)\n", handle, color.GreenString("cached"))
+			default:
+				fmt.Printf("  - %s (%s)\n", handle, color.YellowString("not cached"))
+			}
+		}
+	}
+	return nil
+}
+
+// invalidateSecrets asks the running agent to remove the handle from its secrets cache, or every handle when it's empty
+func invalidateSecrets(handle string) error {
+	c := util.GetClient(false)
+	ipcAddress, err := config.GetIPCAddress()
+	if err != nil {
+		return err
+	}
+	endpoint := fmt.Sprintf("https://%v:%v/agent/secrets/invalidate", ipcAddress, config.Datadog.GetInt("cmd_port"))
+	body := url.Values{"handle": {handle}}.Encode()
+
+	r, err := util.DoPost(c, endpoint, "application/x-www-form-urlencoded", bytes.NewBuffer([]byte(body)))
+	if err != nil {
+		var errMap = make(map[string]string)
+		json.Unmarshal(r, &errMap) //nolint:errcheck
+		// If the error has been marshalled into a json object, check it and return it properly
+		if e, found := errMap["error"]; found {
+			return fmt.Errorf("%s", e)
+		}
+		return fmt.Errorf("Could not reach agent: %v\nMake sure the agent is running before invalidating secrets and contact support if you continue having issues", err)
+	}
+
+	answer := struct {
+		Invalidated int `json:"invalidated"`
+	}{}
+	if err := json.Unmarshal(r, &answer); err != nil {
+		return fmt.Errorf("Could not Unmarshal agent answer: %s", r)
+	}
+
+	switch {
+	case handle == "":
+		fmt.Printf("%d secrets were invalidated, they will be fetched again on next use.\n", answer.Invalidated)
+	case answer.Invalidated == 0:
+		fmt.Printf("Secret '%s' was not cached by the agent.\n", handle)
+	default:
+		fmt.Printf("Secret '%s' was invalidated, it will be fetched again on next use.\n", handle)
+	}
+	return nil
+}
+
 func showSecretInfo() error {
 	c := util.GetClient(false)
 	ipcAddress, err := config.GetIPCAddress()
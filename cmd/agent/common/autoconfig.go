@@ -15,10 +15,20 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/config"
 	lsched "github.com/DataDog/datadog-agent/pkg/logs/scheduler"
 	lstatus "github.com/DataDog/datadog-agent/pkg/logs/status"
+	"github.com/DataDog/datadog-agent/pkg/secrets"
 	"github.com/DataDog/datadog-agent/pkg/tagger"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
+// ConfSearchPaths returns the paths searched for integration configuration files
+func ConfSearchPaths(confdPath string) []string {
+	return []string{
+		confdPath,
+		filepath.Join(GetDistPath(), "conf.d"),
+		"",
+	}
+}
+
 // SetupAutoConfig configures the global AutoConfig:
 //   1. add the configuration providers
 //   2. add the check loaders
@@ -44,14 +54,12 @@ func SetupAutoConfig(confdPath string) {
 	// create the Autoconfig instance
 	AC = autodiscovery.NewAutoConfig(metaScheduler)
 
+	// reschedule the checks using a secret rotated by the secrets backend
+	secrets.Subscribe(AC.ProcessSecretUpdate)
+
 	// Add the configuration providers
 	// File Provider is hardocded and always enabled
-	confSearchPaths := []string{
-		confdPath,
-		filepath.Join(GetDistPath(), "conf.d"),
-		"",
-	}
-	AC.AddConfigProvider(providers.NewFileConfigProvider(confSearchPaths), false, 0)
+	AC.AddConfigProvider(providers.NewFileConfigProvider(ConfSearchPaths(confdPath)), false, 0)
 
 	// Register additional configuration providers
 	var CP []config.ConfigurationProviders
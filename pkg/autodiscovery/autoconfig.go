@@ -6,8 +6,10 @@
 package autodiscovery
 
 import (
+	"bytes"
 	"expvar"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -298,6 +300,7 @@ func (ac *AutoConfig) processNewConfig(config integration.Config) []integration.
 	}
 
 	// decrypt and store non-template config in AC as well
+	encrypted := copyConfig(config)
 	config, err := decryptConfig(config)
 	if err != nil {
 		log.Errorf("Dropping conf for '%s': %s", config.Name, err.Error())
@@ -306,6 +309,9 @@ func (ac *AutoConfig) processNewConfig(config integration.Config) []integration.
 	configs = append(configs, config)
 
 	ac.store.setLoadedConfig(config)
+	if hasSecrets(encrypted) {
+		ac.store.setEncryptedConfig(config, encrypted)
+	}
 
 	return configs
 }
@@ -419,31 +425,48 @@ func (ac *AutoConfig) RemoveScheduler(name string) {
 	ac.scheduler.Deregister(name)
 }
 
+// for testing purpose
+var decryptSecrets = secrets.Decrypt
+
 func decryptConfig(conf integration.Config) (integration.Config, error) {
 	var err error
 
 	// init_config
-	conf.InitConfig, err = secrets.Decrypt(conf.InitConfig, conf.Name)
+	conf.InitConfig, err = decryptSecrets(conf.InitConfig, conf.Name)
 	if err != nil {
 		return conf, fmt.Errorf("error while decrypting secrets in 'init_config': %s", err)
 	}
 
-	// instances
-	for idx := range conf.Instances {
-		conf.Instances[idx], err = secrets.Decrypt(conf.Instances[idx], conf.Name)
-		if err != nil {
-			return conf, fmt.Errorf("error while decrypting secrets in an instance: %s", err)
+	// instances, an instance referencing a secret that can't be fetched is
+	// dropped without affecting the other ones
+	if len(conf.Instances) > 0 {
+		instances := make([]integration.Data, 0, len(conf.Instances))
+		instanceErrors := []string{}
+		for _, instance := range conf.Instances {
+			decrypted, err := decryptSecrets(instance, conf.Name)
+			if err != nil {
+				instanceErrors = append(instanceErrors, err.Error())
+				continue
+			}
+			instances = append(instances, decrypted)
+		}
+		if len(instances) == 0 {
+			return conf, fmt.Errorf("error while decrypting secrets in an instance: %s", strings.Join(instanceErrors, "; "))
+		}
+		if len(instanceErrors) > 0 {
+			log.Errorf("Dropping %d instance(s) of '%s', error while decrypting secrets: %s", len(instanceErrors), conf.Name, strings.Join(instanceErrors, "; "))
 		}
+		conf.Instances = instances
 	}
 
 	// metrics
-	conf.MetricConfig, err = secrets.Decrypt(conf.MetricConfig, conf.Name)
+	conf.MetricConfig, err = decryptSecrets(conf.MetricConfig, conf.Name)
 	if err != nil {
 		return conf, fmt.Errorf("error while decrypting secrets in 'metrics': %s", err)
 	}
 
 	// logs
-	conf.LogsConfig, err = secrets.Decrypt(conf.LogsConfig, conf.Name)
+	conf.LogsConfig, err = decryptSecrets(conf.LogsConfig, conf.Name)
 	if err != nil {
 		return conf, fmt.Errorf("error while decrypting secrets 'logs': %s", err)
 	}
@@ -451,7 +474,75 @@ func decryptConfig(conf integration.Config) (integration.Config, error) {
 	return conf, nil
 }
 
+// copyConfig returns a copy of the config that isn't altered when the original config is decrypted
+func copyConfig(conf integration.Config) integration.Config {
+	conf.Instances = append([]integration.Data(nil), conf.Instances...)
+	return conf
+}
+
+// hasSecrets returns whether the config references any secret handle
+func hasSecrets(conf integration.Config) bool {
+	return referencesSecret(conf, "")
+}
+
+// referencesSecret returns whether the config references a secret handle, or any
+// handle when it's empty
+func referencesSecret(conf integration.Config, handle string) bool {
+	pattern := []byte("ENC[" + handle)
+	if handle != "" {
+		pattern = append(pattern, ']')
+	}
+
+	data := append([]integration.Data{conf.InitConfig, conf.MetricConfig, conf.LogsConfig}, conf.Instances...)
+	for _, d := range data {
+		if bytes.Contains(d, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// ProcessSecretUpdate decrypts again the loaded configs referencing a secret handle whose
+// value was rotated by the secrets backend, and reschedules them with the new value
+func (ac *AutoConfig) ProcessSecretUpdate(handle string, _ string) {
+	var oldConfigs, newConfigs []integration.Config
+
+	loadedConfigs, encryptedConfigs := ac.store.getEncryptedConfigs()
+	for digest, encrypted := range encryptedConfigs {
+		if !referencesSecret(encrypted, handle) {
+			continue
+		}
+
+		config, err := decryptConfig(copyConfig(encrypted))
+		if err != nil {
+			log.Errorf("Could not update secret '%s' in '%s', keeping the previous configuration: %s", handle, encrypted.Name, err)
+			continue
+		}
+		if config.Digest() == digest {
+			continue
+		}
+
+		log.Infof("Secret '%s' was updated, rescheduling '%s'", handle, config.Name)
+		ac.store.rotateLoadedConfig(loadedConfigs[digest], config, encrypted)
+		oldConfigs = append(oldConfigs, loadedConfigs[digest])
+		newConfigs = append(newConfigs, config)
+	}
+
+	if len(oldConfigs) == 0 {
+		return
+	}
+	ac.unschedule(oldConfigs)
+	ac.schedule(newConfigs)
+}
+
 func (ac *AutoConfig) processRemovedConfigs(configs []integration.Config) {
+	// configs referencing secrets are loaded under the digest of their decrypted value
+	loadedConfigs := make([]integration.Config, 0, len(configs))
+	for _, c := range configs {
+		loadedConfigs = append(loadedConfigs, ac.store.getLoadedConfig(c))
+	}
+	configs = loadedConfigs
+
 	ac.unschedule(configs)
 	for _, c := range configs {
 		ac.store.removeLoadedConfig(c)
@@ -534,12 +625,16 @@ func (ac *AutoConfig) resolveTemplateForService(tpl integration.Config, svc list
 		errorStats.setResolveWarning(tpl.Name, newErr.Error())
 		return tpl, log.Warn(newErr)
 	}
+	encrypted := copyConfig(config)
 	resolvedConfig, err := decryptConfig(config)
 	if err != nil {
 		newErr := fmt.Errorf("error decrypting secrets in config %s for service %s: %v", config.Name, svc.GetEntity(), err)
 		return config, log.Warn(newErr)
 	}
 	ac.store.setLoadedConfig(resolvedConfig)
+	if hasSecrets(encrypted) {
+		ac.store.setEncryptedConfig(resolvedConfig, encrypted)
+	}
 	ac.store.addConfigForService(svc.GetEntity(), resolvedConfig)
 	ac.store.addConfigForTemplate(tpl.Digest(), resolvedConfig)
 	ac.store.setTagsHashForService(
@@ -6,6 +6,7 @@
 package autodiscovery
 
 import (
+	"bytes"
 	"errors"
 	"sync"
 	"testing"
@@ -20,6 +21,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/autodiscovery/providers/names"
 	"github.com/DataDog/datadog-agent/pkg/autodiscovery/scheduler"
 	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/secrets"
 	"github.com/DataDog/datadog-agent/pkg/util/retry"
 )
 
@@ -384,3 +386,118 @@ func TestCheckOverride(t *testing.T) {
 	})
 	assert.Len(t, ac.resolveTemplate(tpl), 1)
 }
+
+type MockScheduler struct {
+	scheduled   []integration.Config
+	unscheduled []integration.Config
+}
+
+func (s *MockScheduler) Schedule(configs []integration.Config) {
+	s.scheduled = append(s.scheduled, configs...)
+}
+
+func (s *MockScheduler) Unschedule(configs []integration.Config) {
+	s.unscheduled = append(s.unscheduled, configs...)
+}
+
+func (s *MockScheduler) Stop() {}
+
+func TestProcessSecretUpdate(t *testing.T) {
+	secretValues := map[string]string{"pass": "p1", "user": "u1"}
+	decryptSecrets = func(data []byte, origin string) ([]byte, error) {
+		for handle, value := range secretValues {
+			data = bytes.Replace(data, []byte("ENC["+handle+"]"), []byte(value), -1)
+		}
+		return data, nil
+	}
+	defer func() { decryptSecrets = secrets.Decrypt }()
+
+	ms := scheduler.NewMetaScheduler()
+	sch := &MockScheduler{}
+	ms.Register("mock", sch)
+	ac := NewAutoConfig(ms)
+
+	withSecret := integration.Config{
+		Name:      "redis",
+		Instances: []integration.Data{integration.Data("password: ENC[pass]")},
+	}
+	withOtherSecret := integration.Config{
+		Name:      "mysql",
+		Instances: []integration.Data{integration.Data("user: ENC[user]")},
+	}
+	withoutSecret := integration.Config{
+		Name:      "memory",
+		Instances: []integration.Data{integration.Data("foo: bar")},
+	}
+	configs := ac.processNewConfig(withSecret)
+	configs = append(configs, ac.processNewConfig(withOtherSecret)...)
+	configs = append(configs, ac.processNewConfig(withoutSecret)...)
+	ac.schedule(configs)
+	require.Len(t, sch.scheduled, 3)
+	assert.Equal(t, integration.Data("password: p1"), sch.scheduled[0].Instances[0])
+	sch.scheduled = nil
+
+	// only the config referencing the rotated secret is rescheduled
+	secretValues["pass"] = "p2"
+	ac.ProcessSecretUpdate("pass", "p2")
+	require.Len(t, sch.unscheduled, 1)
+	assert.Equal(t, integration.Data("password: p1"), sch.unscheduled[0].Instances[0])
+	require.Len(t, sch.scheduled, 1)
+	assert.Equal(t, integration.Data("password: p2"), sch.scheduled[0].Instances[0])
+
+	loaded := ac.GetLoadedConfigs()
+	assert.Len(t, loaded, 3)
+	assert.Contains(t, loaded, sch.scheduled[0].Digest())
+
+	// a value that didn't change doesn't reschedule anything
+	sch.scheduled, sch.unscheduled = nil, nil
+	ac.ProcessSecretUpdate("pass", "p2")
+	assert.Len(t, sch.unscheduled, 0)
+	assert.Len(t, sch.scheduled, 0)
+
+	// the provider removing the config unschedules the rotated one
+	ac.processRemovedConfigs([]integration.Config{withSecret})
+	require.Len(t, sch.unscheduled, 1)
+	assert.Equal(t, integration.Data("password: p2"), sch.unscheduled[0].Instances[0])
+	assert.Len(t, ac.GetLoadedConfigs(), 2)
+}
+
+func TestProcessRemovedEncryptedConfig(t *testing.T) {
+	decryptSecrets = func(data []byte, origin string) ([]byte, error) {
+		if bytes.Contains(data, []byte("ENC[missing]")) {
+			return nil, errors.New("secret 'missing' not found")
+		}
+		return bytes.Replace(data, []byte("ENC[pass]"), []byte("p1"), -1), nil
+	}
+	defer func() { decryptSecrets = secrets.Decrypt }()
+
+	ms := scheduler.NewMetaScheduler()
+	sch := &MockScheduler{}
+	ms.Register("mock", sch)
+	ac := NewAutoConfig(ms)
+
+	// the provider keeps the configs as it collected them, with their secret handles
+	withSecret := integration.Config{
+		Name:      "redis",
+		Instances: []integration.Data{integration.Data("password: ENC[pass]")},
+	}
+	withMissingSecret := integration.Config{
+		Name: "mysql",
+		Instances: []integration.Data{
+			integration.Data("password: ENC[pass]"),
+			integration.Data("password: ENC[missing]"),
+		},
+	}
+	configs := ac.processNewConfig(withSecret)
+	configs = append(configs, ac.processNewConfig(withMissingSecret)...)
+	ac.schedule(configs)
+	require.Len(t, sch.scheduled, 2)
+	assert.Equal(t, integration.Data("password: ENC[pass]"), withSecret.Instances[0])
+	assert.Len(t, sch.scheduled[1].Instances, 1)
+
+	ac.processRemovedConfigs([]integration.Config{withSecret, withMissingSecret})
+	require.Len(t, sch.unscheduled, 2)
+	assert.Equal(t, configs[0].Digest(), sch.unscheduled[0].Digest())
+	assert.Equal(t, configs[1].Digest(), sch.unscheduled[1].Digest())
+	assert.Len(t, ac.GetLoadedConfigs(), 0)
+}
@@ -18,6 +18,8 @@ type store struct {
 	serviceToTagsHash map[string]string
 	templateToConfigs map[string][]integration.Config
 	loadedConfigs     map[string]integration.Config
+	encryptedConfigs  map[string]integration.Config
+	providerDigests   map[string]string
 	nameToJMXMetrics  map[string]integration.Data
 	adIDToServices    map[string]map[string]bool
 	entityToService   map[string]listeners.Service
@@ -32,6 +34,8 @@ func newStore() *store {
 		serviceToTagsHash: make(map[string]string),
 		templateToConfigs: make(map[string][]integration.Config),
 		loadedConfigs:     make(map[string]integration.Config),
+		encryptedConfigs:  make(map[string]integration.Config),
+		providerDigests:   make(map[string]string),
 		nameToJMXMetrics:  make(map[string]integration.Data),
 		adIDToServices:    make(map[string]map[string]bool),
 		entityToService:   make(map[string]listeners.Service),
@@ -120,7 +124,92 @@ func (s *store) setLoadedConfig(config integration.Config) {
 func (s *store) removeLoadedConfig(config integration.Config) {
 	s.m.Lock()
 	defer s.m.Unlock()
-	delete(s.loadedConfigs, config.Digest())
+	digest := config.Digest()
+	delete(s.loadedConfigs, digest)
+	delete(s.encryptedConfigs, digest)
+	for providerDigest, loadedDigest := range s.providerDigests {
+		if providerDigest == digest || loadedDigest == digest {
+			delete(s.providerDigests, providerDigest)
+		}
+	}
+}
+
+// setEncryptedConfig stores the config a loaded config was decrypted from, the
+// provider keeps reporting it by the digest of the encrypted config
+func (s *store) setEncryptedConfig(loaded integration.Config, encrypted integration.Config) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	loadedDigest, providerDigest := loaded.Digest(), encrypted.Digest()
+	s.encryptedConfigs[loadedDigest] = encrypted
+	if providerDigest != loadedDigest {
+		s.providerDigests[providerDigest] = loadedDigest
+	}
+}
+
+// getEncryptedConfigs returns the loaded configs referencing secrets and the configs
+// they were decrypted from, by loaded config digest
+func (s *store) getEncryptedConfigs() (map[string]integration.Config, map[string]integration.Config) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	loaded := make(map[string]integration.Config, len(s.encryptedConfigs))
+	encrypted := make(map[string]integration.Config, len(s.encryptedConfigs))
+	for digest, config := range s.encryptedConfigs {
+		loaded[digest] = s.loadedConfigs[digest]
+		encrypted[digest] = config
+	}
+	return loaded, encrypted
+}
+
+// rotateLoadedConfig replaces a loaded config by the one decrypted again after a secret
+// it references was rotated
+func (s *store) rotateLoadedConfig(oldConfig integration.Config, newConfig integration.Config, encrypted integration.Config) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	oldDigest, newDigest := oldConfig.Digest(), newConfig.Digest()
+	delete(s.loadedConfigs, oldDigest)
+	delete(s.encryptedConfigs, oldDigest)
+	s.loadedConfigs[newDigest] = newConfig
+	s.encryptedConfigs[newDigest] = encrypted
+
+	// the config is still known by the digest it was reported with by its provider
+	providerDigest := oldDigest
+	for digest, loadedDigest := range s.providerDigests {
+		if loadedDigest == oldDigest {
+			providerDigest = digest
+		}
+	}
+	s.providerDigests[providerDigest] = newDigest
+
+	for entity, configs := range s.serviceToConfigs {
+		s.serviceToConfigs[entity] = replaceConfig(configs, oldDigest, newConfig)
+	}
+	for tplDigest, configs := range s.templateToConfigs {
+		s.templateToConfigs[tplDigest] = replaceConfig(configs, oldDigest, newConfig)
+	}
+}
+
+// getLoadedConfig returns the config currently loaded for a config reported by its
+// provider, which differs when its secrets were decrypted or rotated, or the config itself
+func (s *store) getLoadedConfig(config integration.Config) integration.Config {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if digest, found := s.providerDigests[config.Digest()]; found {
+		if loaded, found := s.loadedConfigs[digest]; found {
+			return loaded
+		}
+	}
+	return config
+}
+
+// replaceConfig replaces the config matching a digest in a slice of configs
+func replaceConfig(configs []integration.Config, digest string, config integration.Config) []integration.Config {
+	for idx := range configs {
+		if configs[idx].Digest() == digest {
+			configs[idx] = config
+		}
+	}
+	return configs
 }
 
 // getLoadedConfigs returns all loaded and resolved configs
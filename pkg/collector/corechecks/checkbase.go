@@ -7,6 +7,8 @@ package corechecks
 
 import (
 	"fmt"
+	"hash/fnv"
+	"os"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -37,11 +39,16 @@ import (
 //
 // If custom tags are set in the instance configuration, they will
 // be automatically appended to each send done by this check.
+//
+// Checks whose instances should not run in sync across a fleet of agents
+// can add a jitter to their interval with NewCheckBaseWithIntervalAndJitter()
+// or SetIntervalJitter().
 type CheckBase struct {
 	checkName      string
 	checkID        check.ID
 	latestWarnings []error
 	checkInterval  time.Duration
+	maxJitter      time.Duration
 	source         string
 	telemetry      bool
 }
@@ -61,6 +68,14 @@ func NewCheckBaseWithInterval(name string, defaultInterval time.Duration) CheckB
 	}
 }
 
+// NewCheckBaseWithIntervalAndJitter returns a check base struct with a given check name and
+// interval, plus a jitter of at most maxJitter added to the interval
+func NewCheckBaseWithIntervalAndJitter(name string, defaultInterval, maxJitter time.Duration) CheckBase {
+	c := NewCheckBaseWithInterval(name, defaultInterval)
+	c.maxJitter = maxJitter
+	return c
+}
+
 // BuildID is to be called by the check's Config() method to generate
 // the unique check ID.
 func (c *CheckBase) BuildID(instance, initConfig integration.Data) {
@@ -172,10 +187,38 @@ func (c *CheckBase) Warnf(format string, params ...interface{}) error {
 // long-running checks (persisting after Run() exits)
 func (c *CheckBase) Stop() {}
 
+// SetIntervalJitter sets the maximum jitter added to the interval of the check,
+// typically from the instance configuration. A zero maxJitter disables it.
+func (c *CheckBase) SetIntervalJitter(maxJitter time.Duration) {
+	c.maxJitter = maxJitter
+}
+
 // Interval returns the scheduling time for the check.
 // Long-running checks should override to return 0.
 func (c *CheckBase) Interval() time.Duration {
-	return c.checkInterval
+	if c.checkInterval == 0 {
+		return 0
+	}
+	return c.checkInterval + c.intervalJitter()
+}
+
+// intervalJitter returns the jitter added to the interval of the check, between 0 and
+// maxJitter. It is derived from the hostname and the check ID rather than picked at random,
+// so that it is stable across reloads of an agent while being spread across agents.
+func (c *CheckBase) intervalJitter() time.Duration {
+	if c.maxJitter <= 0 {
+		return 0
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		log.Debugf("unable to get the hostname to compute the interval jitter of check %s: %s", string(c.ID()), err)
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(hostname))  //nolint:errcheck
+	h.Write([]byte(c.checkID)) //nolint:errcheck
+	return time.Duration(h.Sum64() % uint64(c.maxJitter+1))
 }
 
 // String returns the name of the check, the same for every instance
@@ -68,3 +68,26 @@ func TestCommonConfigureCustomID(t *testing.T) {
 	assert.Equal(t, string(mycheck.ID()), "test:foobar:bd63a7031add5db9")
 	mockSender.AssertExpectations(t)
 }
+
+func TestIntervalJitter(t *testing.T) {
+	mycheck := &dummyCheck{
+		CheckBase: NewCheckBaseWithIntervalAndJitter("test", 60*time.Second, 30*time.Second),
+	}
+	interval := mycheck.Interval()
+	assert.True(t, interval >= 60*time.Second)
+	assert.True(t, interval <= 90*time.Second)
+
+	// The jitter only depends on the host and the check ID
+	othercheck := &dummyCheck{
+		CheckBase: NewCheckBaseWithIntervalAndJitter("test", 60*time.Second, 30*time.Second),
+	}
+	assert.Equal(t, interval, othercheck.Interval())
+
+	mycheck.SetIntervalJitter(0)
+	assert.Equal(t, 60*time.Second, mycheck.Interval())
+
+	longRunning := &dummyCheck{
+		CheckBase: NewCheckBaseWithIntervalAndJitter("test", 0, 30*time.Second),
+	}
+	assert.Equal(t, time.Duration(0), longRunning.Interval())
+}
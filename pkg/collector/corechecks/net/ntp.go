@@ -6,10 +6,16 @@
 package net
 
 import (
+	"bytes"
+	"encoding/binary"
 	"expvar"
 	"fmt"
 	"math"
+	"net"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/beevik/ntp"
@@ -27,31 +33,282 @@ import (
 const ntpCheckName = "ntp"
 const defaultMinCollectionInterval = 900 // 15 minutes, to follow pool.ntp.org's guidelines on the query rate
 
+// Methods used to aggregate the offsets of multiple hosts into a single offset
+const (
+	aggregationMedian      = "median"
+	aggregationMean        = "mean"
+	aggregationMinRTT      = "min_rtt"
+	aggregationTrimmedMean = "trimmed_mean"
+	aggregationWeighted    = "weighted"
+)
+
+// trimmedMeanRatio is the fraction of the lowest and of the highest offsets
+// discarded by the trimmed_mean aggregation
+const trimmedMeanRatio = 0.25
+
+// minWeightRTT is the round-trip time, in seconds, below which all responses get the
+// same weight in the weighted aggregation, to avoid infinite weights
+const minWeightRTT = 0.001
+
+// asymmetricDelayMinRTT is the round-trip time, in seconds, above which a sharp change of
+// the round-trip time of a host is reported as an asymmetric delay
+const asymmetricDelayMinRTT = 0.1
+
+// asymmetricDelayMaxChange is the relative change of the round-trip time of a host between
+// two runs above which the delay is considered asymmetric
+const asymmetricDelayMaxChange = 0.5
+
+// maxKoDBackoffRuns is the maximum number of runs a host is skipped for after a Kiss-o'-Death
+const maxKoDBackoffRuns = 64
+
+// NTP versions used to query the hosts: NTPv4 by default, NTPv3 for the hosts not answering NTPv4 queries
+const (
+	ntpVersion3 = 3
+	ntpVersion4 = 4
+)
+
 var (
-	ntpExpVar = expvar.NewFloat("ntpOffset")
+	ntpExpVar         = expvar.NewFloat("ntpOffset")
+	ntpLastSyncExpVar = expvar.NewInt("ntpLastSyncUnix")
 	// for testing purpose
 	ntpQuery = ntp.QueryWithOptions
 
 	tlmNtpOffset = telemetry.NewGauge("check", "ntp_offset",
 		nil, "Ntp offset")
+	tlmNtpLastSync = telemetry.NewGauge("check", "ntp_last_sync_unix",
+		nil, "Unix timestamp of the last successful ntp query")
+
+	defaultHosts = []string{"0.datadog.pool.ntp.org", "1.datadog.pool.ntp.org", "2.datadog.pool.ntp.org", "3.datadog.pool.ntp.org"}
 )
 
+// ntpHostOffset is the clock offset and round-trip time reported by a single NTP server
+type ntpHostOffset struct {
+	host   string
+	offset float64
+	rtt    float64
+	leap   ntp.LeapIndicator
+	// version is the NTP version the host answered to
+	version int
+	// stratum, rootDelay and rootDispersion describe the quality of the server time source
+	stratum        uint8
+	rootDelay      float64
+	rootDispersion float64
+	// referenceID and referenceTime identify the time source of the server and when it last
+	// synchronized to it
+	referenceID   string
+	referenceTime time.Time
+	// rejected is set when the offset is an outlier, or its round-trip time is too high,
+	// and it is ignored by the aggregation
+	rejected bool
+	// asymmetricDelay is set when the round-trip time is high and changed sharply since the
+	// previous run, the network delay is then likely asymmetric and the offset untrustworthy
+	asymmetricDelay bool
+}
+
+// ntpHostError is the error encountered when querying a single NTP server
+type ntpHostError struct {
+	host string
+	err  error
+	// invalid is set when the server responded, but its response didn't pass validation
+	invalid bool
+	leap    ntp.LeapIndicator
+	// kissCode is set when the server sent a Kiss-o'-Death
+	kissCode string
+	// skipped is set when the server wasn't queried, as it's backing off after a Kiss-o'-Death
+	skipped bool
+	// proxyFailed is set when the server couldn't be queried because of the proxy
+	proxyFailed bool
+}
+
+// Statuses of the hosts in the diagnostics of a run
+const (
+	ntpHostStatusOK       = "ok"
+	ntpHostStatusRejected = "rejected"
+	ntpHostStatusTimeout  = "timeout"
+	ntpHostStatusInvalid  = "invalid"
+	ntpHostStatusKoD      = "kod"
+	ntpHostStatusSkipped  = "skipped"
+	ntpHostStatusError    = "error"
+)
+
+// maxServiceCheckMessageLength is the length above which the host diagnostics are cut
+// from the service check message, to keep it readable
+const maxServiceCheckMessageLength = 400
+
+// ntpOffsetResult is the outcome of querying the hosts of a run
+type ntpOffsetResult struct {
+	// offset is the aggregated clock offset, only set when the run got one
+	offset      float64
+	hostOffsets []ntpHostOffset
+	hostErrors  []ntpHostError
+}
+
+// ntpHostDiagnostic is the status of a single host in a run, with its offset when it responded
+type ntpHostDiagnostic struct {
+	host   string
+	status string
+	offset float64
+	// kissCode is the code of the Kiss-o'-Death sent or backed off from
+	kissCode string
+}
+
+func (d ntpHostDiagnostic) String() string {
+	switch d.status {
+	case ntpHostStatusOK, ntpHostStatusRejected:
+		return fmt.Sprintf("%s: %s (offset %vs)", d.host, d.status, d.offset)
+	case ntpHostStatusKoD, ntpHostStatusSkipped:
+		return fmt.Sprintf("%s: %s (%s)", d.host, d.status, d.kissCode)
+	}
+	return fmt.Sprintf("%s: %s", d.host, d.status)
+}
+
+// diagnostics returns the status of every host queried or skipped during the run
+func (r ntpOffsetResult) diagnostics() []ntpHostDiagnostic {
+	diagnostics := make([]ntpHostDiagnostic, 0, len(r.hostOffsets)+len(r.hostErrors))
+	for _, h := range r.hostOffsets {
+		status := ntpHostStatusOK
+		if h.rejected {
+			status = ntpHostStatusRejected
+		}
+		diagnostics = append(diagnostics, ntpHostDiagnostic{host: h.host, status: status, offset: h.offset})
+	}
+	for _, h := range r.hostErrors {
+		diagnostic := ntpHostDiagnostic{host: h.host, status: ntpHostStatusError, kissCode: h.kissCode}
+		if netErr, ok := h.err.(net.Error); ok && netErr.Timeout() {
+			diagnostic.status = ntpHostStatusTimeout
+		} else if h.invalid {
+			diagnostic.status = ntpHostStatusInvalid
+		} else if h.skipped {
+			diagnostic.status = ntpHostStatusSkipped
+		} else if h.kissCode != "" {
+			diagnostic.status = ntpHostStatusKoD
+		}
+		diagnostics = append(diagnostics, diagnostic)
+	}
+	return diagnostics
+}
+
+// summary returns the diagnostics of the hosts as a single line prefixed by message, the hosts
+// not fitting in maxServiceCheckMessageLength are only counted
+func (r ntpOffsetResult) summary(message string) string {
+	diagnostics := r.diagnostics()
+	if len(diagnostics) == 0 {
+		return message
+	}
+
+	var b strings.Builder
+	b.WriteString(message)
+	if message != "" {
+		b.WriteString(". ")
+	}
+	for i, d := range diagnostics {
+		entry := d.String()
+		if i > 0 {
+			entry = ", " + entry
+		}
+		if b.Len()+len(entry) > maxServiceCheckMessageLength && i > 0 {
+			fmt.Fprintf(&b, " and %d more hosts", len(diagnostics)-i)
+			break
+		}
+		b.WriteString(entry)
+	}
+	return b.String()
+}
+
+// ntpKoDBackoff tracks the runs to skip for a host that sent Kiss-o'-Death packets
+type ntpKoDBackoff struct {
+	kissCode string
+	// backoffRuns doubles with every consecutive Kiss-o'-Death received from the host
+	backoffRuns int
+	skipRuns    int
+}
+
+// ntpQueryResult is the outcome of querying a single NTP server
+type ntpQueryResult struct {
+	index    int
+	host     string
+	response *ntp.Response
+	err      error
+	version  int
+}
+
 // NTPCheck only has sender and config
 type NTPCheck struct {
 	core.CheckBase
 	cfg            *ntpConfig
 	lastCollection time.Time
 	errCount       int
+	kodBackoffs    map[string]*ntpKoDBackoff
+	resolver       *ntpResolver
+	// lastStatus is the status of the last run that got a clock offset
+	lastStatus metrics.ServiceCheckStatus
+	// lastOffset is the clock offset of the last run that got one, at lastOffsetTime
+	lastOffset     float64
+	lastOffsetTime time.Time
+	// lastRunSucceeded is set when the last run got a clock offset, the drift rate is only
+	// computed between two consecutive successful runs
+	lastRunSucceeded bool
+	// startupGraceRuns is the number of remaining runs whose failure doesn't submit service
+	// checks, it drops to 0 as soon as a run gets a clock offset
+	startupGraceRuns int
+	// versions is the NTP version negotiated with each host that answered
+	versions map[string]int
+	// queryOutcomes holds whether each of the last success_window queries of a host succeeded
+	queryOutcomes map[string][]bool
+	// lastRTTs is the round-trip time of the last response of each host
+	lastRTTs map[string]float64
 }
 
 type ntpInstanceConfig struct {
-	OffsetThreshold        int      `yaml:"offset_threshold"`
-	Host                   string   `yaml:"host"`
-	Hosts                  []string `yaml:"hosts"`
-	Port                   int      `yaml:"port"`
-	Timeout                int      `yaml:"timeout"`
-	Version                int      `yaml:"version"`
-	UseLocalDefinedServers bool     `yaml:"use_local_defined_servers"`
+	OffsetThreshold        float64         `yaml:"offset_threshold"`
+	WarningOffsetThreshold float64         `yaml:"warning_offset_threshold"`
+	Host                   string          `yaml:"host"`
+	Hosts                  []string        `yaml:"-"`
+	HostsConfig            []ntpHostConfig `yaml:"hosts"`
+	Port                   int             `yaml:"port"`
+	Timeout                int             `yaml:"timeout"`
+	Version                int             `yaml:"version"`
+	UseLocalDefinedServers bool            `yaml:"use_local_defined_servers"`
+	MaxConcurrency         int             `yaml:"max_concurrency"`
+	MinResponsiveHosts     int             `yaml:"min_responsive_hosts"`
+	Aggregation            string          `yaml:"aggregation"`
+	MaxRTT                 float64         `yaml:"max_rtt"`
+	MaxOffsetDeviation     float64         `yaml:"max_offset_deviation"`
+	MaxAbsoluteOffset      float64         `yaml:"max_absolute_offset"`
+	PerHostServiceCheck    bool            `yaml:"per_host_service_check"`
+	DNSCacheTTL            int             `yaml:"dns_cache_ttl"`
+	CollectionJitter       int             `yaml:"collection_jitter"`
+	StaleOffsetMaxAge      int             `yaml:"stale_offset_max_age"`
+	UseNTS                 bool            `yaml:"use_nts"`
+	NTSKEServer            string          `yaml:"nts_ke_server"`
+	Proxy                  string          `yaml:"proxy"`
+	Protocol               string          `yaml:"protocol"`
+	StartupGraceRuns       int             `yaml:"startup_grace_runs"`
+	SourceAddress          string          `yaml:"source_address"`
+	CollectServerMetrics   bool            `yaml:"collect_server_metrics"`
+	SuccessWindow          int             `yaml:"success_window"`
+	SubmitOffsetHistogram  bool            `yaml:"submit_offset_histogram"`
+	SingleSource           bool            `yaml:"single_source"`
+}
+
+// ntpHostConfig is an entry of the hosts list, either a bare host name, or a
+// host with its own port, timeout and version overriding the instance ones
+type ntpHostConfig struct {
+	Host    string `yaml:"host"`
+	Port    int    `yaml:"port"`
+	Timeout int    `yaml:"timeout"`
+	Version int    `yaml:"version"`
+	// Prefer hosts are queried first, the other ones only when they don't get enough offsets
+	Prefer bool `yaml:"prefer"`
+}
+
+// UnmarshalYAML accepts both a bare host name and a structured entry
+func (h *ntpHostConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&h.Host); err == nil {
+		return nil
+	}
+	type plain ntpHostConfig
+	return unmarshal((*plain)(h))
 }
 
 type ntpInitConfig struct{}
@@ -59,6 +316,48 @@ type ntpInitConfig struct{}
 type ntpConfig struct {
 	instance ntpInstanceConfig
 	initConf ntpInitConfig
+	proxy    *ntpSOCKS5Proxy
+	// hostsConfig holds the options of each host of the hosts list, indexed by host key
+	hostsConfig map[string]ntpHostConfig
+	// versionFallback is set when no version is configured for the instance, the hosts
+	// without their own version rejecting NTPv4 queries are then queried with NTPv3
+	versionFallback bool
+}
+
+// hostKey returns the key identifying a host in the hosts list and in the
+// ntp_server tag: the host name, followed by its port when it isn't queried
+// on the instance one, so that a server listening on several ports is
+// reported once per port
+func (c *ntpConfig) hostKey(h ntpHostConfig) string {
+	if h.Port == 0 || h.Port == c.instance.Port {
+		return h.Host
+	}
+	return net.JoinHostPort(h.Host, strconv.Itoa(h.Port))
+}
+
+// hostTiers splits the hosts list in the preferred hosts, queried first, and the other
+// ones. There is a single tier when no host, or every host, is preferred.
+func (c *ntpConfig) hostTiers() [][]string {
+	var preferred, others []string
+	for _, host := range c.instance.Hosts {
+		if c.hostsConfig[host].Prefer {
+			preferred = append(preferred, host)
+		} else {
+			others = append(others, host)
+		}
+	}
+	if len(preferred) == 0 || len(others) == 0 {
+		return [][]string{c.instance.Hosts}
+	}
+	return [][]string{preferred, others}
+}
+
+// queryHost returns the host name to query for the given host key
+func (c *ntpConfig) queryHost(key string) string {
+	if h, found := c.hostsConfig[key]; found {
+		return h.Host
+	}
+	return key
 }
 
 func (c *NTPCheck) String() string {
@@ -68,12 +367,19 @@ func (c *NTPCheck) String() string {
 func (c *ntpConfig) parse(data []byte, initData []byte, getLocalServers func() ([]string, error)) error {
 	var instance ntpInstanceConfig
 	var initConf ntpInitConfig
-	defaultVersion := 3
+	defaultVersion := ntpVersion4
 	defaultTimeout := 5
 	defaultPort := 123
-	defaultOffsetThreshold := 60
+	defaultOffsetThreshold := 60.0
+	defaultMaxConcurrency := 4
+	defaultSuccessWindow := 10
+	defaultMaxAbsoluteOffset := 3600.0
+	defaultStartupGraceRuns := 1
 
-	defaultHosts := []string{"0.datadog.pool.ntp.org", "1.datadog.pool.ntp.org", "2.datadog.pool.ntp.org", "3.datadog.pool.ntp.org"}
+	// default values
+	instance.CollectServerMetrics = true
+	// 0 disables the grace period, the default can't be applied after unmarshalling
+	instance.StartupGraceRuns = defaultStartupGraceRuns
 
 	if err := yaml.Unmarshal(data, &instance); err != nil {
 		return err
@@ -84,14 +390,83 @@ func (c *ntpConfig) parse(data []byte, initData []byte, getLocalServers func() (
 	}
 
 	c.instance = instance
-	var localNtpServers []string
+
+	// The NTP client library only supports unauthenticated NTP. Refuse to run
+	// rather than silently trusting unauthenticated responses.
+	if c.instance.UseNTS || c.instance.NTSKEServer != "" {
+		return fmt.Errorf("use_nts or nts_ke_server is set but Network Time Security is not supported by the ntp check")
+	}
+
+	switch c.instance.Protocol {
+	case ntpProtocolUDP, ntpProtocolTCP:
+	case "":
+		c.instance.Protocol = ntpProtocolUDP
+	default:
+		return fmt.Errorf("unsupported protocol %q, only %s and %s are supported", c.instance.Protocol, ntpProtocolUDP, ntpProtocolTCP)
+	}
+
 	var err error
+	if c.instance.Proxy != "" {
+		if c.instance.Protocol != ntpProtocolUDP {
+			return fmt.Errorf("proxy is only supported with the %s protocol", ntpProtocolUDP)
+		}
+		c.proxy, err = newNTPSOCKS5Proxy(c.instance.Proxy)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.instance.SourceAddress != "" {
+		if err = checkSourceAddress(c.instance.SourceAddress); err != nil {
+			return err
+		}
+	}
+
+	if c.instance.Port == 0 {
+		c.instance.Port = defaultPort
+	}
+
+	c.hostsConfig = make(map[string]ntpHostConfig)
+	for _, h := range c.instance.HostsConfig {
+		if h.Host == "" {
+			return fmt.Errorf("an entry of hosts has no host")
+		}
+		host, port, err := splitNTPHostPort(h.Host)
+		if err != nil {
+			return err
+		}
+		h.Host = host
+		if h.Port == 0 {
+			h.Port = port
+		}
+		key := c.hostKey(h)
+		c.instance.Hosts = append(c.instance.Hosts, key)
+		c.hostsConfig[key] = h
+	}
+	if c.instance.Host != "" {
+		host, port, err := splitNTPHostPort(c.instance.Host)
+		if err != nil {
+			return err
+		}
+		h := ntpHostConfig{Host: host, Port: port}
+		key := c.hostKey(h)
+		if _, found := c.hostsConfig[key]; !found {
+			c.hostsConfig[key] = h
+		}
+		c.instance.Host = key
+	}
+
+	var localNtpServers []string
 	if c.instance.UseLocalDefinedServers {
 		localNtpServers, err = getLocalServers()
 		if err != nil {
 			return err
 		}
-		log.Infof("Use local defined servers: %v", localNtpServers)
+		if len(localNtpServers) > 0 {
+			log.Infof("Use local defined servers: %v", localNtpServers)
+		} else {
+			log.Infof("No local defined servers found, using the configured hosts")
+		}
 	}
 
 	if len(localNtpServers) > 0 {
@@ -107,13 +482,22 @@ func (c *ntpConfig) parse(data []byte, initData []byte, getLocalServers func() (
 		c.instance.Hosts = hosts
 	}
 	if c.instance.Hosts == nil {
+		// An empty hosts list is a misconfiguration rather than a request for the default servers,
+		// refuse to schedule a check that would never get any offset
+		if c.instance.HostsConfig != nil {
+			if c.instance.UseLocalDefinedServers {
+				return fmt.Errorf("no ntp server to query: no server is defined locally and hosts is empty")
+			}
+			return fmt.Errorf("no ntp server to query: hosts is empty")
+		}
 		c.instance.Hosts = defaultHosts
 	}
-	if c.instance.Port == 0 {
-		c.instance.Port = defaultPort
+	if c.instance.SingleSource && len(c.instance.Hosts) != 1 {
+		return fmt.Errorf("single_source requires exactly one ntp server to query, %d are configured", len(c.instance.Hosts))
 	}
 	if c.instance.Version == 0 {
 		c.instance.Version = defaultVersion
+		c.versionFallback = true
 	}
 	if c.instance.Timeout == 0 {
 		c.instance.Timeout = defaultTimeout
@@ -121,11 +505,77 @@ func (c *ntpConfig) parse(data []byte, initData []byte, getLocalServers func() (
 	if c.instance.OffsetThreshold == 0 {
 		c.instance.OffsetThreshold = defaultOffsetThreshold
 	}
+	// Without a warning threshold, the check goes straight from OK to CRITICAL
+	if c.instance.WarningOffsetThreshold == 0 {
+		c.instance.WarningOffsetThreshold = c.instance.OffsetThreshold
+	} else if c.instance.WarningOffsetThreshold > c.instance.OffsetThreshold {
+		log.Warnf("warning_offset_threshold (%v) is higher than offset_threshold (%v), using offset_threshold", c.instance.WarningOffsetThreshold, c.instance.OffsetThreshold)
+		c.instance.WarningOffsetThreshold = c.instance.OffsetThreshold
+	}
+	if c.instance.MaxConcurrency <= 0 {
+		c.instance.MaxConcurrency = defaultMaxConcurrency
+	}
+	if c.instance.MinResponsiveHosts <= 0 {
+		c.instance.MinResponsiveHosts = 1
+	}
+	if c.instance.SuccessWindow <= 0 {
+		c.instance.SuccessWindow = defaultSuccessWindow
+	}
+	if c.instance.StartupGraceRuns < 0 {
+		c.instance.StartupGraceRuns = 0
+	}
+	if c.instance.MaxAbsoluteOffset <= 0 {
+		c.instance.MaxAbsoluteOffset = defaultMaxAbsoluteOffset
+	}
+	switch c.instance.Aggregation {
+	case aggregationMedian, aggregationMean, aggregationMinRTT, aggregationTrimmedMean, aggregationWeighted:
+	case "":
+		c.instance.Aggregation = aggregationMedian
+	default:
+		log.Warnf("Unknown aggregation method %q, using %q", c.instance.Aggregation, aggregationMedian)
+		c.instance.Aggregation = aggregationMedian
+	}
 	c.initConf = initConf
 
 	return nil
 }
 
+// checkSourceAddress returns an error if the NTP queries can't be sent from the given local address
+func checkSourceAddress(address string) error {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return fmt.Errorf("source_address %q is not a valid IP address", address)
+	}
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: ip})
+	if err != nil {
+		return fmt.Errorf("cannot bind to source_address %s: %s", address, err)
+	}
+	return conn.Close()
+}
+
+// splitNTPHostPort splits an optional port from a host of the configuration.
+// The port is 0 when there is none, IPv6 addresses with a port must be enclosed
+// in square brackets.
+func splitNTPHostPort(hostport string) (string, int, error) {
+	if strings.HasPrefix(hostport, "[") && strings.HasSuffix(hostport, "]") {
+		return hostport[1 : len(hostport)-1], 0, nil
+	}
+	// A bare IPv6 address has several colons
+	if !strings.HasPrefix(hostport, "[") && strings.Count(hostport, ":") != 1 {
+		return hostport, 0, nil
+	}
+
+	host, rawPort, err := net.SplitHostPort(hostport)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid ntp host %q: %s", hostport, err)
+	}
+	port, err := strconv.Atoi(rawPort)
+	if err != nil || port <= 0 || port > 65535 {
+		return "", 0, fmt.Errorf("invalid port in ntp host %q", hostport)
+	}
+	return host, port, nil
+}
+
 // Configure configure the data from the yaml
 func (c *NTPCheck) Configure(data integration.Data, initConfig integration.Data, source string) error {
 	cfg := new(ntpConfig)
@@ -137,12 +587,17 @@ func (c *NTPCheck) Configure(data integration.Data, initConfig integration.Data,
 
 	c.BuildID(data, initConfig)
 	c.cfg = cfg
+	c.resolver = newNTPResolver(time.Duration(cfg.instance.DNSCacheTTL) * time.Second)
+	c.startupGraceRuns = cfg.instance.StartupGraceRuns
 
 	err = c.CommonConfigure(data, source)
 	if err != nil {
 		return err
 	}
 
+	// Spread the queries of agents started at the same time
+	c.SetIntervalJitter(time.Duration(cfg.instance.CollectionJitter) * time.Second)
+
 	return nil
 }
 
@@ -155,39 +610,304 @@ func (c *NTPCheck) Run() error {
 
 	var serviceCheckStatus metrics.ServiceCheckStatus
 	serviceCheckMessage := ""
-	offsetThreshold := c.cfg.instance.OffsetThreshold
 
-	clockOffset, err := c.queryOffset()
-	if err != nil {
-		log.Info(err)
+	result, err := c.queryOffset()
+	clockOffset, hostOffsets, hostErrors := result.offset, result.hostOffsets, result.hostErrors
+	validOffsets := len(acceptedOffsets(hostOffsets))
+	succeeded, failed := false, false
+	if err != nil && len(hostOffsets) == 0 && c.hasStaleOffset() {
+		// Bridge transient failures with the last offset rather than leaving a gap. Offsets
+		// rejected by max_rtt or max_absolute_offset are not bridged, they can be a real clock jump.
+		log.Infof("%s, reusing the offset from %s", err, c.lastOffsetTime)
+		serviceCheckStatus, serviceCheckMessage = c.offsetStatus(c.lastOffset)
+		sender.Gauge("ntp.offset", c.lastOffset, "", []string{"stale:true"})
+	} else if err != nil {
+		failed = true
+		serviceCheckStatus = metrics.ServiceCheckUnknown
+		serviceCheckMessage = result.summary(err.Error())
+		log.Info(serviceCheckMessage)
+	} else if validOffsets < c.cfg.instance.MinResponsiveHosts {
+		// Don't trust the offset of too few hosts, a single wrong host could make the check OK
+		failed = true
 		serviceCheckStatus = metrics.ServiceCheckUnknown
+		serviceCheckMessage = result.summary(fmt.Sprintf("Only %d ntp hosts returned a valid offset, at least %d are required by min_responsive_hosts", validOffsets, c.cfg.instance.MinResponsiveHosts))
+		log.Info(serviceCheckMessage)
 	} else {
-		if int(math.Abs(clockOffset)) > offsetThreshold {
-			serviceCheckStatus = metrics.ServiceCheckCritical
-			serviceCheckMessage = fmt.Sprintf("Offset %v is higher than offset threshold (%v secs)", clockOffset, offsetThreshold)
-		} else {
-			serviceCheckStatus = metrics.ServiceCheckOK
+		serviceCheckStatus, serviceCheckMessage = c.offsetStatus(clockOffset)
+		if serviceCheckStatus == metrics.ServiceCheckCritical && c.lastStatus != metrics.ServiceCheckCritical {
+			sender.Event(c.offsetThresholdEvent(clockOffset, hostOffsets))
 		}
+		if c.lastRunSucceeded && !c.lastCollection.IsZero() {
+			if elapsed := time.Since(c.lastCollection).Seconds(); elapsed > 0 {
+				// seconds of offset change per second, in parts per million
+				sender.Gauge("ntp.drift_rate", (clockOffset-c.lastOffset)/elapsed*1e6, "", nil)
+			}
+		}
+		succeeded = true
+		c.lastStatus = serviceCheckStatus
+		c.lastOffset = clockOffset
+		c.lastOffsetTime = time.Now()
 
 		sender.Gauge("ntp.offset", clockOffset, "", nil)
+		if c.cfg.instance.SingleSource {
+			h := hostOffsets[0]
+			sourceTags := []string{"ntp_server:" + h.host, "reference_id:" + h.referenceID}
+			sender.Gauge("ntp.server.leap", float64(h.leap), "", sourceTags)
+			sender.Gauge("ntp.reference_time", float64(h.referenceTime.Unix()), "", sourceTags)
+		}
+		offsets := make([]float64, 0, len(hostOffsets))
+		rtts := make([]float64, 0, len(hostOffsets))
+		for _, h := range hostOffsets {
+			hostTags := []string{"ntp_server:" + h.host, "ntp_version:" + strconv.Itoa(h.version)}
+			sender.Gauge("ntp.host.offset", h.offset, "", hostTags)
+			sender.Gauge("ntp.host.rtt", h.rtt, "", hostTags)
+			if c.cfg.instance.CollectServerMetrics {
+				sender.Gauge("ntp.stratum", float64(h.stratum), "", hostTags)
+				sender.Gauge("ntp.root_delay", h.rootDelay, "", hostTags)
+				sender.Gauge("ntp.root_dispersion", h.rootDispersion, "", hostTags)
+			}
+			if c.cfg.instance.SubmitOffsetHistogram {
+				sender.Histogram("ntp.offset.histogram", h.offset, "", nil)
+			}
+			offsets = append(offsets, h.offset)
+			rtts = append(rtts, h.rtt)
+		}
+		sender.Gauge("ntp.offset.stddev", stddev(offsets), "", nil)
+		sender.Gauge("ntp.rtt", median(rtts), "", nil)
 		ntpExpVar.Set(clockOffset)
 		tlmNtpOffset.Set(clockOffset)
+		lastSync := time.Now().Unix()
+		ntpLastSyncExpVar.Set(lastSync)
+		tlmNtpLastSync.Set(float64(lastSync))
+	}
+
+	sender.Gauge("ntp.servers.responsive", float64(len(hostOffsets)), "", nil)
+	// submitted on every run, including when every offset was rejected
+	rejected := 0
+	for _, h := range hostOffsets {
+		if h.rejected {
+			rejected++
+		}
+	}
+	sender.Count("ntp.servers.rejected", float64(rejected), "", nil)
+	sender.Gauge("ntp.servers.configured", float64(len(c.cfg.instance.Hosts)), "", nil)
+
+	for _, host := range c.cfg.instance.Hosts {
+		if outcomes := c.queryOutcomes[host]; len(outcomes) > 0 {
+			sender.Gauge("ntp.host.success_ratio", successRatio(outcomes), "", []string{"ntp_server:" + host})
+		}
+	}
+
+	// The first runs after the start can fail because the network isn't ready yet,
+	// don't submit service checks alerting on it
+	suppressServiceChecks := failed && c.startupGraceRuns > 0
+	if succeeded {
+		c.startupGraceRuns = 0
+	} else if c.startupGraceRuns > 0 {
+		c.startupGraceRuns--
 	}
 
-	sender.ServiceCheck("ntp.in_sync", serviceCheckStatus, "", nil, serviceCheckMessage)
+	if suppressServiceChecks {
+		log.Infof("Not submitting the ntp service checks of a failed run during startup_grace_runs")
+	} else {
+		sender.ServiceCheck("ntp.in_sync", serviceCheckStatus, "", nil, serviceCheckMessage)
+
+		leapStatus, leapMessage := leapIndicatorStatus(hostOffsets, hostErrors)
+		sender.ServiceCheck("ntp.leap", leapStatus, "", nil, leapMessage)
+	}
+
+	for _, h := range hostOffsets {
+		if h.asymmetricDelay {
+			sender.Count("ntp.delay.asymmetric", 1, "", []string{"ntp_server:" + h.host})
+		}
+	}
+	for _, h := range hostErrors {
+		if h.kissCode != "" && !h.skipped {
+			sender.Count("ntp.server.kod", 1, "", []string{"ntp_server:" + h.host, "kiss_code:" + h.kissCode})
+		}
+		if h.proxyFailed {
+			sender.Count("ntp.proxy.errors", 1, "", []string{"ntp_server:" + h.host})
+		}
+	}
+
+	if c.cfg.instance.PerHostServiceCheck && !suppressServiceChecks {
+		for _, h := range hostOffsets {
+			status, message := c.offsetStatus(h.offset)
+			// the per host service check is only OK or CRITICAL for a host that responded
+			if status == metrics.ServiceCheckWarning {
+				status = metrics.ServiceCheckOK
+			}
+			sender.ServiceCheck("ntp.host.in_sync", status, "", []string{"ntp_server:" + h.host}, message)
+		}
+		for _, h := range hostErrors {
+			status := metrics.ServiceCheckUnknown
+			if h.invalid {
+				status = metrics.ServiceCheckCritical
+			}
+			sender.ServiceCheck("ntp.host.in_sync", status, "", []string{"ntp_server:" + h.host}, h.err.Error())
+		}
+	}
 
 	c.lastCollection = time.Now()
+	c.lastRunSucceeded = succeeded
 
 	sender.Commit()
 
 	return nil
 }
 
-func (c *NTPCheck) queryOffset() (float64, error) {
-	offsets := []float64{}
+// hasStaleOffset returns whether the last clock offset is recent enough to be reused when no host responds
+func (c *NTPCheck) hasStaleOffset() bool {
+	maxAge := time.Duration(c.cfg.instance.StaleOffsetMaxAge) * time.Second
+	return maxAge > 0 && !c.lastOffsetTime.IsZero() && time.Since(c.lastOffsetTime) <= maxAge
+}
 
-	for _, host := range c.cfg.instance.Hosts {
-		response, err := ntpQuery(host, ntp.QueryOptions{Version: c.cfg.instance.Version, Port: c.cfg.instance.Port, Timeout: time.Duration(c.cfg.instance.Timeout) * time.Second})
+// offsetThresholdEvent builds the event sent when the clock offset crosses the offset threshold
+func (c *NTPCheck) offsetThresholdEvent(clockOffset float64, hostOffsets []ntpHostOffset) metrics.Event {
+	worst := hostOffsets[0]
+	for _, h := range hostOffsets[1:] {
+		if math.Abs(h.offset) > math.Abs(worst.offset) {
+			worst = h
+		}
+	}
+
+	return metrics.Event{
+		Priority:       metrics.EventPriorityNormal,
+		AlertType:      metrics.EventAlertTypeError,
+		SourceTypeName: ntpCheckName,
+		EventType:      ntpCheckName,
+		Title:          "Clock offset is higher than the offset threshold",
+		Text: fmt.Sprintf("Offset %v is higher than offset threshold (%v secs). The highest offset (%v secs) was reported by %s.",
+			clockOffset, c.cfg.instance.OffsetThreshold, worst.offset, worst.host),
+		Tags: []string{"ntp_server:" + worst.host},
+	}
+}
+
+// offsetStatus returns the service check status and message matching a clock offset
+func (c *NTPCheck) offsetStatus(offset float64) (metrics.ServiceCheckStatus, string) {
+	offsetThreshold := c.cfg.instance.OffsetThreshold
+	warningOffsetThreshold := c.cfg.instance.WarningOffsetThreshold
+
+	if math.Abs(offset) > offsetThreshold {
+		return metrics.ServiceCheckCritical, fmt.Sprintf("Offset %v is higher than offset threshold (%v secs)", offset, offsetThreshold)
+	}
+	if math.Abs(offset) > warningOffsetThreshold {
+		return metrics.ServiceCheckWarning, fmt.Sprintf("Offset %v is higher than warning offset threshold (%v secs)", offset, warningOffsetThreshold)
+	}
+	return metrics.ServiceCheckOK, ""
+}
+
+// leapIndicatorStatus returns the service check status and message matching
+// the leap indicators of the servers that responded, valid response or not
+func leapIndicatorStatus(hostOffsets []ntpHostOffset, hostErrors []ntpHostError) (metrics.ServiceCheckStatus, string) {
+	var responded, notInSync, pendingLeap []string
+	checkLeap := func(host string, leap ntp.LeapIndicator) {
+		responded = append(responded, host)
+		switch leap {
+		case ntp.LeapAddSecond, ntp.LeapDelSecond:
+			pendingLeap = append(pendingLeap, host)
+		case ntp.LeapNotInSync:
+			notInSync = append(notInSync, host)
+		}
+	}
+
+	for _, h := range hostOffsets {
+		checkLeap(h.host, h.leap)
+	}
+	for _, h := range hostErrors {
+		if h.invalid {
+			checkLeap(h.host, h.leap)
+		}
+	}
+
+	if len(responded) == 0 {
+		return metrics.ServiceCheckUnknown, "No ntp host responded"
+	}
+	if len(notInSync) > 0 {
+		return metrics.ServiceCheckCritical, fmt.Sprintf("Ntp hosts report their clock as not synchronized: %s", strings.Join(notInSync, ", "))
+	}
+	if len(pendingLeap) > 0 {
+		return metrics.ServiceCheckWarning, fmt.Sprintf("Ntp hosts announce a pending leap second: %s", strings.Join(pendingLeap, ", "))
+	}
+	return metrics.ServiceCheckOK, ""
+}
+
+// queryOffset queries the hosts and returns their offsets and errors, with the aggregated clock
+// offset when enough hosts returned a valid one
+func (c *NTPCheck) queryOffset() (ntpOffsetResult, error) {
+	result := ntpOffsetResult{hostOffsets: []ntpHostOffset{}, hostErrors: []ntpHostError{}}
+
+	if c.kodBackoffs == nil {
+		c.kodBackoffs = make(map[string]*ntpKoDBackoff)
+	}
+	if c.versions == nil {
+		c.versions = make(map[string]int)
+	}
+	if c.queryOutcomes == nil {
+		c.queryOutcomes = make(map[string][]bool)
+	}
+	if c.lastRTTs == nil {
+		c.lastRTTs = make(map[string]float64)
+	}
+
+	// The offset of a single source is reported as is, none of the aggregation and rejection applies
+	if c.cfg.instance.SingleSource {
+		result.hostOffsets, result.hostErrors = c.queryTier(c.cfg.instance.Hosts)
+		if len(result.hostOffsets) == 0 {
+			return result, fmt.Errorf("Failed to get clock offset from the ntp host")
+		}
+		result.offset = result.hostOffsets[0].offset
+		return result, nil
+	}
+
+	// The next tier is only queried when the previous ones didn't get enough offsets,
+	// the offset is then aggregated over the first tier getting enough of them
+	for i, tier := range c.cfg.hostTiers() {
+		tierOffsets, tierErrors := c.queryTier(tier)
+		c.rejectOffsets(tierOffsets)
+		result.hostOffsets = append(result.hostOffsets, tierOffsets...)
+		result.hostErrors = append(result.hostErrors, tierErrors...)
+
+		accepted := acceptedOffsets(tierOffsets)
+		if len(accepted) > 0 && len(accepted) >= c.cfg.instance.MinResponsiveHosts {
+			result.offset = aggregateOffsets(accepted, c.cfg.instance.Aggregation)
+			return result, nil
+		}
+		if i == 0 && len(c.cfg.instance.Hosts) > len(tier) {
+			log.Infof("Only %d preferred ntp hosts returned a valid offset, querying the other ones", len(accepted))
+		}
+	}
+
+	if len(result.hostOffsets) == 0 {
+		return result, fmt.Errorf("Failed to get clock offset from any ntp host")
+	}
+	accepted := acceptedOffsets(result.hostOffsets)
+	if len(accepted) == 0 {
+		return result, fmt.Errorf("Failed to get clock offset from any ntp host with a round-trip time below max_rtt and an offset below max_absolute_offset")
+	}
+
+	result.offset = aggregateOffsets(accepted, c.cfg.instance.Aggregation)
+	return result, nil
+}
+
+// queryTier queries the given host keys, except the ones backing off after a
+// Kiss-o'-Death, and returns the offsets of the valid responses and the errors
+func (c *NTPCheck) queryTier(hosts []string) ([]ntpHostOffset, []ntpHostError) {
+	hostOffsets := []ntpHostOffset{}
+	hostErrors := []ntpHostError{}
+
+	queried := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		if backoff, ok := c.kodBackoffs[host]; ok && backoff.skipRuns > 0 {
+			backoff.skipRuns--
+			hostErrors = append(hostErrors, ntpHostError{host: host, err: fmt.Errorf("skipped after a kiss of death (%s)", backoff.kissCode), kissCode: backoff.kissCode, skipped: true})
+			continue
+		}
+		queried = append(queried, host)
+	}
+
+	for _, result := range c.queryHosts(queried) {
+		host, response, err := result.host, result.response, result.err
 		if err != nil {
 			if c.errCount >= 10 {
 				c.errCount = 0
@@ -196,32 +916,392 @@ func (c *NTPCheck) queryOffset() (float64, error) {
 				c.errCount++
 				log.Debugf("There was an error querying the ntp host %s: %s", host, err)
 			}
+			_, proxyFailed := err.(*ntpProxyError)
+			if proxyFailed {
+				log.Warnf("Couldn't query the ntp host %s through the proxy: %s", host, err)
+			}
+			c.recordQueryOutcome(host, false)
+			hostErrors = append(hostErrors, ntpHostError{host: host, err: err, proxyFailed: proxyFailed})
 			continue
 		}
 		c.errCount = 0
+		if result.version != c.versions[host] {
+			if result.version == ntpVersion3 && c.versions[host] == 0 && c.cfg.versionFallback {
+				log.Infof("The ntp host %s didn't answer NTPv4 queries, querying it with NTPv3", host)
+			}
+			c.versions[host] = result.version
+		}
+		if response.Stratum == 0 {
+			c.backOff(host, response.KissCode)
+			c.recordQueryOutcome(host, false)
+			hostErrors = append(hostErrors, ntpHostError{host: host, err: fmt.Errorf("kiss of death received: %s", response.KissCode), kissCode: response.KissCode})
+			continue
+		}
+		delete(c.kodBackoffs, host)
 		err = response.Validate()
 		if err != nil {
 			log.Infof("The ntp response is not valid for host %s: %s", host, err)
+			c.recordQueryOutcome(host, false)
+			hostErrors = append(hostErrors, ntpHostError{host: host, err: err, invalid: true, leap: response.Leap})
 			continue
 		}
-		offsets = append(offsets, response.ClockOffset.Seconds())
+		c.recordQueryOutcome(host, true)
+		rtt := response.RTT.Seconds()
+		asymmetricDelay := c.hasAsymmetricDelay(host, rtt)
+		c.lastRTTs[host] = rtt
+		hostOffsets = append(hostOffsets, ntpHostOffset{
+			host:            host,
+			offset:          response.ClockOffset.Seconds(),
+			rtt:             rtt,
+			asymmetricDelay: asymmetricDelay,
+			leap:            response.Leap,
+			version:         result.version,
+			stratum:         response.Stratum,
+			rootDelay:       response.RootDelay.Seconds(),
+			rootDispersion:  response.RootDispersion.Seconds(),
+			referenceID:     formatReferenceID(response.ReferenceID, response.Stratum),
+			referenceTime:   response.ReferenceTime,
+		})
+	}
+
+	return hostOffsets, hostErrors
+}
+
+// formatReferenceID returns the reference identifier of a server as displayed by ntpq:
+// the name of the time source of stratum 1 servers, like GPS or PPS, and the IPv4
+// address of the upstream server, or the hash of its IPv6 address, for the other ones
+func formatReferenceID(id uint32, stratum uint8) string {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], id)
+	if stratum <= 1 {
+		return string(bytes.TrimRight(b[:], "\x00"))
+	}
+	return net.IP(b[:]).String()
+}
+
+// rejectOffsets flags the offsets discarded by max_absolute_offset, max_rtt and max_offset_deviation
+func (c *NTPCheck) rejectOffsets(hostOffsets []ntpHostOffset) {
+	rejectImplausibleOffsets(hostOffsets, c.cfg.instance.MaxAbsoluteOffset)
+	if c.cfg.instance.MaxRTT > 0 {
+		rejectSlowResponses(hostOffsets, c.cfg.instance.MaxRTT)
+	}
+	if c.cfg.instance.MaxOffsetDeviation > 0 {
+		rejectOutliers(hostOffsets, c.cfg.instance.MaxOffsetDeviation)
+	}
+}
+
+// hasAsymmetricDelay returns whether the round-trip time of a host is high and changed
+// sharply since its previous response, which happens when the network routes change and
+// biases the offset as NTP assumes the upstream and downstream delays are the same
+func (c *NTPCheck) hasAsymmetricDelay(host string, rtt float64) bool {
+	lastRTT, found := c.lastRTTs[host]
+	if !found || rtt <= asymmetricDelayMinRTT || lastRTT <= 0 {
+		return false
+	}
+	if math.Abs(rtt-lastRTT)/lastRTT <= asymmetricDelayMaxChange {
+		return false
+	}
+	log.Warnf("The round-trip time of the ntp host %s changed from %vs to %vs, the network delay is likely asymmetric and its offset untrustworthy", host, lastRTT, rtt)
+	return true
+}
+
+// recordQueryOutcome adds the outcome of a query to the window of the last success_window
+// queries of the host
+func (c *NTPCheck) recordQueryOutcome(host string, success bool) {
+	outcomes := append(c.queryOutcomes[host], success)
+	if len(outcomes) > c.cfg.instance.SuccessWindow {
+		outcomes = outcomes[len(outcomes)-c.cfg.instance.SuccessWindow:]
+	}
+	c.queryOutcomes[host] = outcomes
+}
+
+// successRatio returns the ratio of successful queries, between 0 and 1
+func successRatio(outcomes []bool) float64 {
+	if len(outcomes) == 0 {
+		return .0
+	}
+
+	successes := 0
+	for _, success := range outcomes {
+		if success {
+			successes++
+		}
+	}
+	return float64(successes) / float64(len(outcomes))
+}
+
+// backOff skips a host that sent a Kiss-o'-Death for an exponentially
+// increasing number of runs, as requested by the NTP pool guidelines
+func (c *NTPCheck) backOff(host string, kissCode string) {
+	backoff, ok := c.kodBackoffs[host]
+	if !ok {
+		backoff = &ntpKoDBackoff{}
+		c.kodBackoffs[host] = backoff
+	}
+	backoff.kissCode = kissCode
+	backoff.backoffRuns *= 2
+	if backoff.backoffRuns == 0 {
+		backoff.backoffRuns = 1
+	} else if backoff.backoffRuns > maxKoDBackoffRuns {
+		backoff.backoffRuns = maxKoDBackoffRuns
+	}
+	backoff.skipRuns = backoff.backoffRuns
+	log.Warnf("Received a kiss of death with code %s from the ntp host %s, skipping it for the next %d runs", kissCode, host, backoff.skipRuns)
+}
+
+// minOffsetMAD is the lowest median absolute deviation of the offsets, in seconds,
+// used to reject outliers. It keeps servers agreeing on the same offset from
+// rejecting any other server deviating by a few microseconds.
+const minOffsetMAD = 0.001
+
+// rejectOutliers flags the offsets deviating from the median by more than
+// maxDeviation median absolute deviations, and returns the remaining ones.
+// There are not enough samples to tell outliers apart below 3 offsets.
+func rejectOutliers(hostOffsets []ntpHostOffset, maxDeviation float64) []ntpHostOffset {
+	accepted := acceptedOffsets(hostOffsets)
+	if len(accepted) < 3 {
+		return accepted
+	}
+
+	offsets := make([]float64, 0, len(accepted))
+	for _, h := range accepted {
+		offsets = append(offsets, h.offset)
+	}
+	med := median(offsets)
+
+	deviations := make([]float64, 0, len(accepted))
+	for _, h := range accepted {
+		deviations = append(deviations, math.Abs(h.offset-med))
+	}
+	mad := math.Max(median(deviations), minOffsetMAD)
+
+	for i := range hostOffsets {
+		if !hostOffsets[i].rejected && math.Abs(hostOffsets[i].offset-med) > maxDeviation*mad {
+			hostOffsets[i].rejected = true
+			log.Debugf("Rejecting offset %v of ntp host %s, too far from the median offset %v", hostOffsets[i].offset, hostOffsets[i].host, med)
+		}
+	}
+	return acceptedOffsets(hostOffsets)
+}
+
+// rejectImplausibleOffsets flags the offsets higher than maxOffset in absolute value, a
+// misconfigured or malicious server must not skew the aggregated offset
+func rejectImplausibleOffsets(hostOffsets []ntpHostOffset, maxOffset float64) {
+	for i := range hostOffsets {
+		if math.Abs(hostOffsets[i].offset) > maxOffset {
+			hostOffsets[i].rejected = true
+			log.Warnf("Rejecting offset %v of ntp host %s, it is higher than max_absolute_offset (%v secs)", hostOffsets[i].offset, hostOffsets[i].host, maxOffset)
+		}
+	}
+}
+
+// rejectSlowResponses flags the offsets of the responses with a round-trip time above maxRTT
+func rejectSlowResponses(hostOffsets []ntpHostOffset, maxRTT float64) {
+	for i := range hostOffsets {
+		if hostOffsets[i].rtt > maxRTT {
+			hostOffsets[i].rejected = true
+			log.Debugf("Rejecting offset %v of ntp host %s, its round-trip time %v is higher than max_rtt", hostOffsets[i].offset, hostOffsets[i].host, hostOffsets[i].rtt)
+		}
+	}
+}
+
+// acceptedOffsets returns the offsets not flagged as rejected
+func acceptedOffsets(hostOffsets []ntpHostOffset) []ntpHostOffset {
+	accepted := make([]ntpHostOffset, 0, len(hostOffsets))
+	for _, h := range hostOffsets {
+		if !h.rejected {
+			accepted = append(accepted, h)
+		}
+	}
+	return accepted
+}
+
+// aggregateOffsets computes a single clock offset from the offsets of several hosts
+func aggregateOffsets(hostOffsets []ntpHostOffset, method string) float64 {
+	offsets := make([]float64, 0, len(hostOffsets))
+	for _, h := range hostOffsets {
+		offsets = append(offsets, h.offset)
+	}
+
+	switch method {
+	case aggregationMean:
+		return mean(offsets)
+	case aggregationTrimmedMean:
+		sort.Float64s(offsets)
+		trimmed := int(float64(len(offsets)) * trimmedMeanRatio)
+		return mean(offsets[trimmed : len(offsets)-trimmed])
+	case aggregationMinRTT:
+		// The response with the lowest round-trip time is the least affected by network asymmetry
+		best := hostOffsets[0]
+		for _, h := range hostOffsets[1:] {
+			if h.rtt < best.rtt {
+				best = h
+			}
+		}
+		return best.offset
+	case aggregationWeighted:
+		return weightedMedian(hostOffsets)
+	default:
+		return median(offsets)
+	}
+}
+
+// weightedMedian returns the median of the offsets weighted by the inverse of
+// their round-trip time, as the uncertainty of an offset grows with the round-trip time
+func weightedMedian(hostOffsets []ntpHostOffset) float64 {
+	sorted := make([]ntpHostOffset, len(hostOffsets))
+	copy(sorted, hostOffsets)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].offset < sorted[j].offset })
+
+	weights := make([]float64, len(sorted))
+	total := .0
+	for i, h := range sorted {
+		weights[i] = 1 / math.Max(h.rtt, minWeightRTT)
+		total += weights[i]
+	}
+
+	cumulative := .0
+	for i, h := range sorted {
+		cumulative += weights[i]
+		if cumulative == total/2 && i+1 < len(sorted) {
+			return (h.offset + sorted[i+1].offset) / 2.0
+		}
+		if cumulative > total/2 {
+			return h.offset
+		}
 	}
+	return sorted[len(sorted)-1].offset
+}
 
-	if len(offsets) == 0 {
-		return .0, fmt.Errorf("Failed to get clock offset from any ntp host")
+// mean returns the arithmetic mean of the given values
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return .0
 	}
 
-	var median float64
+	sum := .0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// stddev returns the population standard deviation of the given values
+func stddev(values []float64) float64 {
+	if len(values) == 0 {
+		return .0
+	}
 
-	sort.Float64s(offsets)
-	length := len(offsets)
+	m := mean(values)
+	sum := .0
+	for _, v := range values {
+		sum += (v - m) * (v - m)
+	}
+	return math.Sqrt(sum / float64(len(values)))
+}
+
+// median returns the median of the given values, sorting them in place
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return .0
+	}
+
+	sort.Float64s(values)
+	length := len(values)
 	if length%2 == 0 {
-		median = (offsets[length/2-1] + offsets[length/2]) / 2.0
-	} else {
-		median = offsets[length/2]
+		return (values[length/2-1] + values[length/2]) / 2.0
+	}
+	return values[length/2]
+}
+
+// queryOptions returns the options to query the given host key with, the
+// instance ones unless the host has its own in the hosts list
+func (c *NTPCheck) queryOptions(host string) ntp.QueryOptions {
+	options := ntp.QueryOptions{
+		Version:      c.cfg.instance.Version,
+		Port:         c.cfg.instance.Port,
+		Timeout:      time.Duration(c.cfg.instance.Timeout) * time.Second,
+		LocalAddress: c.cfg.instance.SourceAddress,
 	}
 
-	return median, nil
+	h := c.cfg.hostsConfig[host]
+	if h.Port != 0 {
+		options.Port = h.Port
+	}
+	if h.Timeout != 0 {
+		options.Timeout = time.Duration(h.Timeout) * time.Second
+	}
+	if h.Version != 0 {
+		options.Version = h.Version
+	} else if c.cfg.versionFallback && c.versions[host] == ntpVersion3 {
+		options.Version = ntpVersion3
+	}
+	return options
+}
+
+// canFallBack returns whether the given host key can be queried again with NTPv3
+// after its NTPv4 query failed: no version is configured for the instance nor for
+// the host, and the host never answered NTPv4 queries
+func (c *NTPCheck) canFallBack(host string, options ntp.QueryOptions, err error) bool {
+	if !c.cfg.versionFallback || c.cfg.hostsConfig[host].Version != 0 || options.Version != ntpVersion4 {
+		return false
+	}
+	if _, proxyFailed := err.(*ntpProxyError); proxyFailed {
+		return false
+	}
+	return c.versions[host] != ntpVersion4
+}
+
+// queryHosts queries the given hosts using at most MaxConcurrency concurrent
+// requests, and returns the results in the order of the hosts list
+func (c *NTPCheck) queryHosts(hosts []string) []ntpQueryResult {
+	query := ntpQuery
+	if c.cfg.proxy != nil {
+		query = c.cfg.proxy.query
+	} else if c.cfg.instance.Protocol == ntpProtocolTCP {
+		query = queryTCP
+	}
+
+	workers := c.cfg.instance.MaxConcurrency
+	if workers > len(hosts) {
+		workers = len(hosts)
+	}
+
+	jobs := make(chan int)
+	resultsChan := make(chan ntpQueryResult, len(hosts))
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				address := c.resolver.resolve(c.cfg.queryHost(hosts[i]))
+				options := c.queryOptions(hosts[i])
+				response, err := query(address, options)
+				if err != nil && c.canFallBack(hosts[i], options, err) {
+					fallback := options
+					fallback.Version = ntpVersion3
+					if fallbackResponse, fallbackErr := query(address, fallback); fallbackErr == nil {
+						response, err, options = fallbackResponse, nil, fallback
+					}
+				}
+				resultsChan <- ntpQueryResult{index: i, host: hosts[i], response: response, err: err, version: options.Version}
+			}
+		}()
+	}
+
+	for i := range hosts {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	close(resultsChan)
+
+	results := make([]ntpQueryResult, len(hosts))
+	for result := range resultsChan {
+		results[result.index] = result
+	}
+	return results
 }
 
 func ntpFactory() check.Check {
@@ -10,39 +10,90 @@ package net
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 )
 
+// timesyncdConfigDropInDirs are the directories of the systemd-timesyncd drop-in configuration files
+var timesyncdConfigDropInDirs = []string{"/etc/systemd/timesyncd.conf.d", "/run/systemd/timesyncd.conf.d", "/usr/lib/systemd/timesyncd.conf.d"}
+
 func getLocalDefinedNTPServers() ([]string, error) {
-	return getNTPServersFromFiles([]string{"/etc/ntp.conf", "etc/xntp.conf"})
+	files := []string{"/etc/ntp.conf", "/etc/xntp.conf", "/etc/chrony.conf", "/etc/chrony/chrony.conf"}
+	files = append(files, getTimesyncdConfigFiles("/etc/systemd/timesyncd.conf", timesyncdConfigDropInDirs)...)
+	return getNTPServersFromFiles(files)
+}
+
+// getTimesyncdConfigFiles returns the main systemd-timesyncd configuration file and its drop-ins
+func getTimesyncdConfigFiles(mainFile string, dropInDirs []string) []string {
+	files := []string{mainFile}
+	for _, dir := range dropInDirs {
+		dropIns, err := filepath.Glob(filepath.Join(dir, "*.conf"))
+		if err == nil {
+			files = append(files, dropIns...)
+		}
+	}
+	return files
 }
 
+// getNTPServersFromFiles returns the servers defined in the given files, in the order
+// they're first defined. Missing files are ignored, but files that exist and can't be
+// read are reported as errors.
 func getNTPServersFromFiles(files []string) ([]string, error) {
-	serversMap := make(map[string]bool)
+	var servers []string
+	seen := make(map[string]bool)
 
 	for _, conf := range files {
 		content, err := ioutil.ReadFile(conf)
-		if err == nil {
-			lines := strings.Split(string(content), "\n")
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Cannot read NTP configuration file %s: %s", conf, err)
+		}
 
-			for _, line := range lines {
-				line = strings.TrimSpace(line)
-				fields := strings.Fields(line)
-				if len(fields) >= 2 && fields[0] == "server" {
-					serversMap[fields[1]] = true
+		lines := strings.Split(string(content), "\n")
+		for _, line := range lines {
+			for _, server := range parseNTPServers(line) {
+				if !seen[server] {
+					seen[server] = true
+					servers = append(servers, server)
 				}
 			}
 		}
 	}
 
-	if len(serversMap) == 0 {
-		return nil, fmt.Errorf("Cannot find NTP server in %s", strings.Join(files, ", "))
+	return servers, nil
+}
+
+// parseNTPServers returns the servers defined by a line of a ntpd, chrony or systemd-timesyncd configuration file
+func parseNTPServers(line string) []string {
+	line = strings.TrimSpace(line)
+
+	// systemd-timesyncd: NTP= and FallbackNTP= take a space-separated list of servers
+	if parts := strings.SplitN(line, "=", 2); len(parts) == 2 {
+		key := strings.TrimSpace(parts[0])
+		if key == "NTP" || key == "FallbackNTP" {
+			return strings.Fields(parts[1])
+		}
 	}
 
-	var servers []string
-	for key := range serversMap {
-		servers = append(servers, key)
+	// ntpd and chrony: server and pool directives, followed by options such as iburst
+	fields := strings.Fields(line)
+	if len(fields) < 2 || (fields[0] != "server" && fields[0] != "pool") {
+		return nil
 	}
 
-	return servers, nil
+	// ntpd accepts options such as -4 and -6 before the address
+	for _, field := range fields[1:] {
+		if strings.HasPrefix(field, "-") {
+			continue
+		}
+		// 127.127.t.u are the pseudo-addresses of the ntpd reference clock drivers
+		if strings.HasPrefix(field, "127.127.") {
+			return nil
+		}
+		return []string{field}
+	}
+	return nil
 }
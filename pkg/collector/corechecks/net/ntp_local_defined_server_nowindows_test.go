@@ -10,15 +10,25 @@ package net
 import (
 	"io/ioutil"
 	"os"
-	"sort"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestGetNTPServersFromFileNotExist(t *testing.T) {
-	_, err := getNTPServersFromFiles([]string{"file1", "file2"})
-	assert.EqualError(t, err, "Cannot find NTP server in file1, file2")
+	servers, err := getNTPServersFromFiles([]string{"file1", "file2"})
+	assert.NoError(t, err)
+	assert.Empty(t, servers)
+}
+
+func TestGetNTPServersFromFileNotReadable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ntp.conf")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = getNTPServersFromFiles([]string{dir})
+	assert.Error(t, err)
 }
 
 func createTempFile(t *testing.T, content string, callback func(filename string)) {
@@ -43,8 +53,7 @@ func TestGetNTPServersFromFile(t *testing.T) {
 	createTempFile(t, config, func(f1 string) {
 		servers, err := getNTPServersFromFiles([]string{f1})
 		assert.NoError(t, err)
-		sort.Strings(servers)
-		assert.Equal(t, []string{"127.127.1.0", "aaa.bbb.ccc.ddd"}, servers)
+		assert.Equal(t, []string{"aaa.bbb.ccc.ddd"}, servers)
 	})
 }
 
@@ -56,7 +65,11 @@ func TestGetNTPServersFromFileTwoConfigs(t *testing.T) {
 		createTempFile(t, config2, func(f2 string) {
 			servers, err := getNTPServersFromFiles([]string{f1, f2})
 			assert.NoError(t, err)
-			sort.Strings(servers)
+			assert.Equal(t, []string{"aaa.bbb.ccc.ddd", "127.0.0.1"}, servers)
+
+			// the servers are returned in the order they're first defined
+			servers, err = getNTPServersFromFiles([]string{f2, f1, f2})
+			assert.NoError(t, err)
 			assert.Equal(t, []string{"127.0.0.1", "aaa.bbb.ccc.ddd"}, servers)
 		})
 	})
@@ -77,7 +90,77 @@ server  aaa.bbb.ccc.ddd
 func TestGetNTPServersFromFileNoServer(t *testing.T) {
 	createTempFile(t, "", func(f1 string) {
 		servers, err := getNTPServersFromFiles([]string{f1})
-		assert.Error(t, err)
+		assert.NoError(t, err)
 		assert.Equal(t, []string(nil), servers)
 	})
 }
+
+func TestParseNTPConfServers(t *testing.T) {
+	for _, tc := range []struct {
+		line     string
+		expected []string
+	}{
+		{"server 0.time.dogo", []string{"0.time.dogo"}},
+		{"server 0.time.dogo iburst prefer", []string{"0.time.dogo"}},
+		{"  pool 0.pool.time.dogo iburst", []string{"0.pool.time.dogo"}},
+		{"server 192.0.2.1 minpoll 4 maxpoll 6", []string{"192.0.2.1"}},
+		{"server -4 0.time.dogo iburst", []string{"0.time.dogo"}},
+		{"server -6 2001:db8::1", []string{"2001:db8::1"}},
+		{"server -4", nil},
+		{"server 127.127.1.0", nil},
+		{"fudge 127.127.1.0 stratum 10", nil},
+		{"#server 0.time.dogo", nil},
+		{"# server 0.time.dogo", nil},
+		{"restrict default kod nomodify notrap nopeer noquery", nil},
+		{"driftfile /var/lib/ntp/drift", nil},
+		{"server", nil},
+		{"", nil},
+	} {
+		assert.Equal(t, tc.expected, parseNTPServers(tc.line), tc.line)
+	}
+}
+
+func TestGetNTPServersFromTimesyncdFile(t *testing.T) {
+	config := `
+[Time]
+NTP=0.time.dogo 1.time.dogo
+#NTP=commented.time.dogo
+FallbackNTP = 2.time.dogo
+RootDistanceMaxSec=5
+`
+	createTempFile(t, config, func(f1 string) {
+		servers, err := getNTPServersFromFiles([]string{f1})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"0.time.dogo", "1.time.dogo", "2.time.dogo"}, servers)
+	})
+}
+
+func TestGetTimesyncdConfigFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "timesyncd.conf.d")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	dropIn := filepath.Join(dir, "dogo.conf")
+	assert.NoError(t, ioutil.WriteFile(dropIn, []byte("[Time]\nNTP=3.time.dogo\n"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ignored.txt"), []byte("NTP=4.time.dogo"), 0644))
+
+	files := getTimesyncdConfigFiles("/etc/systemd/timesyncd.conf", []string{dir, "/does/not/exist"})
+	assert.Equal(t, []string{"/etc/systemd/timesyncd.conf", dropIn}, files)
+}
+
+func TestGetNTPServersFromChronyFile(t *testing.T) {
+	config := `
+# Use public servers from the pool.ntp.org project.
+pool 2.centos.pool.ntp.org iburst
+server 0.time.dogo iburst prefer
+#server commented.time.dogo iburst
+! server commented2.time.dogo
+driftfile /var/lib/chrony/drift
+makestep 1.0 3
+`
+	createTempFile(t, config, func(f1 string) {
+		servers, err := getNTPServersFromFiles([]string{f1})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2.centos.pool.ntp.org", "0.time.dogo"}, servers)
+	})
+}
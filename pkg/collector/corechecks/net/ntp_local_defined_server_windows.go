@@ -10,12 +10,33 @@ package net
 import (
 	"errors"
 	"fmt"
+	"os/exec"
 	"strings"
 
 	"golang.org/x/sys/windows/registry"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
 func getLocalDefinedNTPServers() ([]string, error) {
+	servers, regErr := getNTPServersFromRegistry()
+	if regErr == nil {
+		return servers, nil
+	}
+	log.Debugf("%s, falling back to w32tm", regErr)
+
+	output, err := exec.Command("w32tm", "/query", "/peers").Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s, and cannot query the peers with w32tm: %s", regErr, err)
+	}
+	servers, err = getNTPServersFromW32tmPeers(string(output))
+	if err != nil {
+		return nil, fmt.Errorf("%s, and cannot detect NTP server with w32tm: %s", regErr, err)
+	}
+	return servers, nil
+}
+
+func getNTPServersFromRegistry() ([]string, error) {
 	regKeyPath := `SYSTEM\CurrentControlSet\Services\W32Time\Parameters`
 	k, err := registry.OpenKey(registry.LOCAL_MACHINE, regKeyPath, registry.QUERY_VALUE)
 	if err != nil {
@@ -52,3 +73,30 @@ func getNptServersFromRegKeyValue(regKeyValue string) ([]string, error) {
 
 	return servers, nil
 }
+
+func getNTPServersFromW32tmPeers(output string) ([]string, error) {
+	// Expected format:
+	// #Peers: 2
+	//
+	// Peer: time.windows.com,0x9
+	// State: Active
+	// ...
+	var servers []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "Peer:") {
+			continue
+		}
+		peer := strings.TrimSpace(strings.TrimPrefix(line, "Peer:"))
+		server := strings.Split(peer, ",")[0]
+		if server != "" {
+			servers = append(servers, server)
+		}
+	}
+
+	if len(servers) == 0 {
+		return nil, errors.New("No NTP server found")
+	}
+
+	return servers, nil
+}
@@ -35,3 +35,24 @@ func TestGetNptServersFromRegKeyValue(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, []string(nil), servers)
 }
+
+func TestGetNTPServersFromW32tmPeers(t *testing.T) {
+	output := `#Peers: 2
+
+Peer: time.windows.com,0x9
+State: Active
+Time Remaining: 31.4579815s
+Mode: 3 (Client)
+Stratum: 0 (unspecified)
+
+Peer: dc01.dogo.local
+State: Active
+`
+	servers, err := getNTPServersFromW32tmPeers(output)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"time.windows.com", "dc01.dogo.local"}, servers)
+
+	servers, err = getNTPServersFromW32tmPeers("#Peers: 0\n")
+	assert.Error(t, err)
+	assert.Equal(t, []string(nil), servers)
+}
@@ -0,0 +1,358 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package net
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/beevik/ntp"
+)
+
+const (
+	defaultSOCKS5Port = "1080"
+
+	socks5Version          = 0x05
+	socks5AuthNone         = 0x00
+	socks5AuthPassword     = 0x02
+	socks5AuthNoAcceptable = 0xff
+	socks5CmdUDPAssociate  = 0x03
+	socks5AddrIPv4         = 0x01
+	socks5AddrDomain       = 0x03
+	socks5AddrIPv6         = 0x04
+
+	ntpPacketSize = 48
+	ntpModeClient = 3
+	ntpModeServer = 4
+	// ntpEpochOffset is the number of seconds between the NTP epoch (1900) and the Unix epoch (1970)
+	ntpEpochOffset = 2208988800
+)
+
+// ntpProxyError is returned when an NTP query failed because of the proxy
+type ntpProxyError struct {
+	err error
+}
+
+func (e *ntpProxyError) Error() string {
+	return fmt.Sprintf("ntp proxy error: %s", e.err)
+}
+
+// ntpSOCKS5Proxy sends NTP queries through a SOCKS5 proxy. The NTP client
+// library can't be given a custom connection, so the NTP exchange is done
+// here, over a UDP association set up with the proxy (RFC 1928).
+type ntpSOCKS5Proxy struct {
+	address  string
+	username string
+	password string
+}
+
+func newNTPSOCKS5Proxy(rawURL string) (*ntpSOCKS5Proxy, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy %q: %s", rawURL, err)
+	}
+	if u.Scheme != "socks5" {
+		return nil, fmt.Errorf("unsupported proxy scheme %q, only socks5 is supported", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid proxy %q: missing host", rawURL)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = defaultSOCKS5Port
+	}
+	p := &ntpSOCKS5Proxy{address: net.JoinHostPort(u.Hostname(), port)}
+	if u.User != nil {
+		p.username = u.User.Username()
+		p.password, _ = u.User.Password()
+	}
+	return p, nil
+}
+
+// query has the same signature as ntp.QueryWithOptions, only the Version,
+// Port, Timeout and LocalAddress options are supported
+func (p *ntpSOCKS5Proxy) query(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+	deadline := time.Now().Add(opt.Timeout)
+
+	var tcpDialer, udpDialer net.Dialer
+	tcpDialer.Timeout = opt.Timeout
+	if opt.LocalAddress != "" {
+		localIP := net.ParseIP(opt.LocalAddress)
+		tcpDialer.LocalAddr = &net.TCPAddr{IP: localIP}
+		udpDialer.LocalAddr = &net.UDPAddr{IP: localIP}
+	}
+
+	// The UDP association lasts as long as the control connection is open
+	ctrl, err := tcpDialer.Dial("tcp", p.address)
+	if err != nil {
+		return nil, &ntpProxyError{err}
+	}
+	defer ctrl.Close()
+	if err = ctrl.SetDeadline(deadline); err != nil {
+		return nil, &ntpProxyError{err}
+	}
+
+	relay, err := p.associate(ctrl)
+	if err != nil {
+		return nil, &ntpProxyError{err}
+	}
+
+	conn, err := udpDialer.Dial("udp", relay)
+	if err != nil {
+		return nil, &ntpProxyError{err}
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(deadline); err != nil {
+		return nil, &ntpProxyError{err}
+	}
+
+	header, err := socks5UDPHeader(host, opt.Port)
+	if err != nil {
+		return nil, err
+	}
+	request, xmitTime := newNTPRequest(opt.Version)
+	if _, err = conn.Write(append(header, request...)); err != nil {
+		return nil, &ntpProxyError{err}
+	}
+
+	buf := make([]byte, 512)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	recvTime := time.Now()
+
+	payload, err := stripSOCKS5UDPHeader(buf[:n])
+	if err != nil {
+		return nil, &ntpProxyError{err}
+	}
+	return parseNTPResponse(payload, request, xmitTime, recvTime)
+}
+
+// associate authenticates with the proxy on the control connection, and
+// returns the address of the UDP relay to send the NTP requests to
+func (p *ntpSOCKS5Proxy) associate(ctrl net.Conn) (string, error) {
+	methods := []byte{socks5AuthNone}
+	if p.username != "" {
+		methods = append(methods, socks5AuthPassword)
+	}
+	if _, err := ctrl.Write(append([]byte{socks5Version, byte(len(methods))}, methods...)); err != nil {
+		return "", err
+	}
+
+	reply := make([]byte, 2)
+	if _, err := io.ReadFull(ctrl, reply); err != nil {
+		return "", err
+	}
+	if reply[0] != socks5Version {
+		return "", fmt.Errorf("unexpected SOCKS version %d", reply[0])
+	}
+	switch reply[1] {
+	case socks5AuthNone:
+	case socks5AuthPassword:
+		if len(p.username) > 255 || len(p.password) > 255 {
+			return "", fmt.Errorf("proxy username or password is too long")
+		}
+		auth := []byte{0x01, byte(len(p.username))}
+		auth = append(auth, p.username...)
+		auth = append(auth, byte(len(p.password)))
+		auth = append(auth, p.password...)
+		if _, err := ctrl.Write(auth); err != nil {
+			return "", err
+		}
+		if _, err := io.ReadFull(ctrl, reply); err != nil {
+			return "", err
+		}
+		if reply[1] != 0x00 {
+			return "", fmt.Errorf("proxy authentication failed")
+		}
+	case socks5AuthNoAcceptable:
+		return "", fmt.Errorf("no acceptable proxy authentication method")
+	default:
+		return "", fmt.Errorf("unsupported proxy authentication method %d", reply[1])
+	}
+
+	// The address the requests will be sent from isn't known yet
+	request := []byte{socks5Version, socks5CmdUDPAssociate, 0x00, socks5AddrIPv4, 0, 0, 0, 0, 0, 0}
+	if _, err := ctrl.Write(request); err != nil {
+		return "", err
+	}
+
+	header := make([]byte, 3)
+	if _, err := io.ReadFull(ctrl, header); err != nil {
+		return "", err
+	}
+	if header[1] != 0x00 {
+		return "", fmt.Errorf("proxy refused the UDP association (code %d)", header[1])
+	}
+	host, port, err := readSOCKS5Address(ctrl)
+	if err != nil {
+		return "", err
+	}
+
+	// An unspecified address means the relay is on the proxy host
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		host, _, _ = net.SplitHostPort(p.address)
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port)), nil
+}
+
+// readSOCKS5Address reads an address in the SOCKS5 format: its type, the address and the port
+func readSOCKS5Address(r io.Reader) (string, int, error) {
+	addrType := make([]byte, 1)
+	if _, err := io.ReadFull(r, addrType); err != nil {
+		return "", 0, err
+	}
+
+	var addr []byte
+	switch addrType[0] {
+	case socks5AddrIPv4:
+		addr = make([]byte, net.IPv4len)
+	case socks5AddrIPv6:
+		addr = make([]byte, net.IPv6len)
+	case socks5AddrDomain:
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(r, length); err != nil {
+			return "", 0, err
+		}
+		addr = make([]byte, length[0])
+	default:
+		return "", 0, fmt.Errorf("unknown SOCKS address type %d", addrType[0])
+	}
+	if _, err := io.ReadFull(r, addr); err != nil {
+		return "", 0, err
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(r, port); err != nil {
+		return "", 0, err
+	}
+
+	host := string(addr)
+	if addrType[0] != socks5AddrDomain {
+		host = net.IP(addr).String()
+	}
+	return host, int(binary.BigEndian.Uint16(port)), nil
+}
+
+// socks5UDPHeader returns the header prepended to the datagrams sent to the UDP relay
+func socks5UDPHeader(host string, port int) ([]byte, error) {
+	// Reserved bytes and fragment number, fragmentation isn't used
+	header := []byte{0, 0, 0}
+	if ip := net.ParseIP(host); ip != nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			header = append(header, socks5AddrIPv4)
+			header = append(header, ip4...)
+		} else {
+			header = append(header, socks5AddrIPv6)
+			header = append(header, ip.To16()...)
+		}
+	} else {
+		if len(host) > 255 {
+			return nil, fmt.Errorf("host name %s is too long", host)
+		}
+		header = append(header, socks5AddrDomain, byte(len(host)))
+		header = append(header, host...)
+	}
+	return append(header, byte(port>>8), byte(port)), nil
+}
+
+// stripSOCKS5UDPHeader returns the payload of a datagram received from the UDP relay
+func stripSOCKS5UDPHeader(datagram []byte) ([]byte, error) {
+	if len(datagram) < 4 {
+		return nil, fmt.Errorf("datagram too short")
+	}
+	if datagram[2] != 0 {
+		return nil, fmt.Errorf("fragmented datagrams are not supported")
+	}
+
+	r := bytes.NewReader(datagram[3:])
+	if _, _, err := readSOCKS5Address(r); err != nil {
+		return nil, err
+	}
+	return datagram[len(datagram)-r.Len():], nil
+}
+
+// newNTPRequest returns a client request, and the time it's sent at
+func newNTPRequest(version int) ([]byte, time.Time) {
+	request := make([]byte, ntpPacketSize)
+	request[0] = byte(version<<3) | ntpModeClient
+	now := time.Now()
+	binary.BigEndian.PutUint64(request[40:], toNTPTime(now))
+	return request, now
+}
+
+// parseNTPResponse computes the clock offset and the round-trip time from the
+// response of the server, as done by the NTP client library
+func parseNTPResponse(response, request []byte, xmitTime, recvTime time.Time) (*ntp.Response, error) {
+	if len(response) < ntpPacketSize {
+		return nil, fmt.Errorf("invalid ntp response size %d", len(response))
+	}
+	if mode := response[0] & 0x07; mode != ntpModeServer {
+		return nil, fmt.Errorf("invalid mode in response: %d", mode)
+	}
+	// The originate timestamp of the response must be the transmit timestamp of the request
+	if !bytes.Equal(response[24:32], request[40:48]) {
+		return nil, fmt.Errorf("server response mismatch")
+	}
+
+	stratum := response[1]
+	serverRecvTime := fromNTPTime(binary.BigEndian.Uint64(response[32:]))
+	serverXmitTime := fromNTPTime(binary.BigEndian.Uint64(response[40:]))
+
+	rtt := recvTime.Sub(xmitTime) - serverXmitTime.Sub(serverRecvTime)
+	if rtt < 0 {
+		rtt = 0
+	}
+
+	kissCode := ""
+	if stratum == 0 {
+		kissCode = string(bytes.TrimRight(response[12:16], "\x00"))
+	}
+
+	return &ntp.Response{
+		Time:           serverXmitTime,
+		ClockOffset:    (serverRecvTime.Sub(xmitTime) + serverXmitTime.Sub(recvTime)) / 2,
+		RTT:            rtt,
+		Precision:      time.Duration(math.Pow(2, float64(int8(response[3]))) * float64(time.Second)),
+		Stratum:        stratum,
+		ReferenceID:    binary.BigEndian.Uint32(response[12:]),
+		ReferenceTime:  fromNTPTime(binary.BigEndian.Uint64(response[16:])),
+		RootDelay:      fromNTPShort(binary.BigEndian.Uint32(response[4:])),
+		RootDispersion: fromNTPShort(binary.BigEndian.Uint32(response[8:])),
+		Leap:           ntp.LeapIndicator(response[0] >> 6),
+		KissCode:       kissCode,
+	}, nil
+}
+
+// toNTPTime converts a time to the NTP 64-bit timestamp format
+func toNTPTime(t time.Time) uint64 {
+	seconds := uint64(t.Unix() + ntpEpochOffset)
+	fraction := (uint64(t.Nanosecond()) << 32) / uint64(time.Second)
+	return seconds<<32 | fraction
+}
+
+// fromNTPTime converts an NTP 64-bit timestamp to a time
+func fromNTPTime(timestamp uint64) time.Time {
+	seconds := int64(timestamp>>32) - ntpEpochOffset
+	nanoseconds := ((timestamp & 0xffffffff) * uint64(time.Second)) >> 32
+	return time.Unix(seconds, int64(nanoseconds))
+}
+
+// fromNTPShort converts an NTP 32-bit short format value to a duration
+func fromNTPShort(value uint32) time.Duration {
+	seconds := value >> 16
+	fraction := value & 0xffff
+	return time.Duration(seconds)*time.Second + time.Duration((uint64(fraction)*uint64(time.Second))>>16)
+}
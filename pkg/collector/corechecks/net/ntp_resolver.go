@@ -0,0 +1,85 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package net
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// for testing purpose
+var ntpLookupHost = net.LookupHost
+
+type ntpResolverEntry struct {
+	addresses []string
+	// next is the index of the next address to query
+	next      int
+	expiresAt time.Time
+}
+
+// nextAddress returns the next address of the entry, rotating through all of
+// them so that a single unreachable address doesn't fail every run
+func (e *ntpResolverEntry) nextAddress() string {
+	address := e.addresses[e.next%len(e.addresses)]
+	e.next = (e.next + 1) % len(e.addresses)
+	return address
+}
+
+// ntpResolver caches the resolution of the NTP hosts across runs, so that
+// the check measures the clock offset rather than the health of the DNS.
+// It is safe for concurrent use.
+type ntpResolver struct {
+	m       sync.Mutex
+	ttl     time.Duration
+	entries map[string]*ntpResolverEntry
+}
+
+func newNTPResolver(ttl time.Duration) *ntpResolver {
+	return &ntpResolver{
+		ttl:     ttl,
+		entries: make(map[string]*ntpResolverEntry),
+	}
+}
+
+// resolve returns the address to query for the given host, the resolved
+// addresses of a host being used in turn. If the host can't be resolved, its
+// last known addresses are used. If there are none, the host is returned as
+// is and resolved by the NTP client. The cache is disabled when the TTL is 0.
+func (r *ntpResolver) resolve(host string) string {
+	if r.ttl <= 0 {
+		return host
+	}
+
+	r.m.Lock()
+	entry, found := r.entries[host]
+	if found && time.Now().Before(entry.expiresAt) {
+		address := entry.nextAddress()
+		r.m.Unlock()
+		return address
+	}
+	r.m.Unlock()
+
+	addresses, err := ntpLookupHost(host)
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if err != nil || len(addresses) == 0 {
+		if found {
+			address := entry.nextAddress()
+			log.Debugf("Cannot resolve the ntp host %s, using its last known address %s: %v", host, address, err)
+			return address
+		}
+		return host
+	}
+
+	entry = &ntpResolverEntry{addresses: addresses, expiresAt: time.Now().Add(r.ttl)}
+	r.entries[host] = entry
+	return entry.nextAddress()
+}
@@ -0,0 +1,77 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package net
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNTPResolverCache(t *testing.T) {
+	lookups := 0
+	defer func(lookupHost func(string) ([]string, error)) { ntpLookupHost = lookupHost }(ntpLookupHost)
+	ntpLookupHost = func(host string) ([]string, error) {
+		lookups++
+		return []string{fmt.Sprintf("192.0.2.%d", lookups)}, nil
+	}
+
+	resolver := newNTPResolver(time.Hour)
+	assert.Equal(t, "192.0.2.1", resolver.resolve("time.dogo"))
+	assert.Equal(t, "192.0.2.1", resolver.resolve("time.dogo"))
+	assert.Equal(t, 1, lookups)
+
+	// Expired entries are resolved again
+	resolver.entries["time.dogo"].expiresAt = time.Now().Add(-time.Second)
+	assert.Equal(t, "192.0.2.2", resolver.resolve("time.dogo"))
+	assert.Equal(t, 2, lookups)
+}
+
+func TestNTPResolverRotation(t *testing.T) {
+	lookups := 0
+	defer func(lookupHost func(string) ([]string, error)) { ntpLookupHost = lookupHost }(ntpLookupHost)
+	ntpLookupHost = func(host string) ([]string, error) {
+		lookups++
+		return []string{"192.0.2.1", "2001:db8::1", "192.0.2.2"}, nil
+	}
+
+	resolver := newNTPResolver(time.Hour)
+	assert.Equal(t, "192.0.2.1", resolver.resolve("time.dogo"))
+	assert.Equal(t, "2001:db8::1", resolver.resolve("time.dogo"))
+	assert.Equal(t, "192.0.2.2", resolver.resolve("time.dogo"))
+	assert.Equal(t, "192.0.2.1", resolver.resolve("time.dogo"))
+	assert.Equal(t, 1, lookups)
+}
+
+func TestNTPResolverFallback(t *testing.T) {
+	defer func(lookupHost func(string) ([]string, error)) { ntpLookupHost = lookupHost }(ntpLookupHost)
+	ntpLookupHost = func(host string) ([]string, error) {
+		return nil, fmt.Errorf("test DNS error")
+	}
+
+	resolver := newNTPResolver(time.Hour)
+
+	// Without any previous resolution, the host is queried as is
+	assert.Equal(t, "time.dogo", resolver.resolve("time.dogo"))
+
+	// The last known address is used when the resolution fails
+	resolver.entries["time.dogo"] = &ntpResolverEntry{addresses: []string{"192.0.2.1", "192.0.2.2"}, expiresAt: time.Now().Add(-time.Second)}
+	assert.Equal(t, "192.0.2.1", resolver.resolve("time.dogo"))
+	assert.Equal(t, "192.0.2.2", resolver.resolve("time.dogo"))
+}
+
+func TestNTPResolverDisabled(t *testing.T) {
+	defer func(lookupHost func(string) ([]string, error)) { ntpLookupHost = lookupHost }(ntpLookupHost)
+	ntpLookupHost = func(host string) ([]string, error) {
+		t.Fatal("unexpected lookup")
+		return nil, nil
+	}
+
+	resolver := newNTPResolver(0)
+	assert.Equal(t, "time.dogo", resolver.resolve("time.dogo"))
+}
@@ -0,0 +1,72 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package net
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/beevik/ntp"
+)
+
+// Transport protocols the NTP queries can be sent over
+const (
+	ntpProtocolUDP = "udp"
+	ntpProtocolTCP = "tcp"
+)
+
+// maxNTPTCPMessageSize is the largest response accepted over TCP, an NTP packet
+// with a few extension fields
+const maxNTPTCPMessageSize = 1024
+
+// queryTCP has the same signature as ntp.QueryWithOptions and sends the NTP
+// request over a TCP connection, for the networks blocking UDP. NTP doesn't
+// define a TCP transport: as done by the time gateways supporting it, each
+// packet is prefixed by its length as a 2 bytes big endian integer, like DNS
+// over TCP. Only the Version, Port, Timeout and LocalAddress options are supported.
+func queryTCP(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+	dialer := net.Dialer{Timeout: opt.Timeout}
+	if opt.LocalAddress != "" {
+		dialer.LocalAddr = &net.TCPAddr{IP: net.ParseIP(opt.LocalAddress)}
+	}
+
+	conn, err := dialer.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(opt.Port)))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(opt.Timeout)); err != nil {
+		return nil, err
+	}
+
+	request, xmitTime := newNTPRequest(opt.Version)
+	message := make([]byte, 2+len(request))
+	binary.BigEndian.PutUint16(message, uint16(len(request)))
+	copy(message[2:], request)
+	if _, err = conn.Write(message); err != nil {
+		return nil, err
+	}
+
+	var length [2]byte
+	if _, err = io.ReadFull(conn, length[:]); err != nil {
+		return nil, fmt.Errorf("no ntp response over tcp, the server may not support it: %s", err)
+	}
+	size := binary.BigEndian.Uint16(length[:])
+	if size > maxNTPTCPMessageSize {
+		return nil, fmt.Errorf("invalid ntp response size %d", size)
+	}
+	response := make([]byte, size)
+	if _, err = io.ReadFull(conn, response); err != nil {
+		return nil, err
+	}
+	recvTime := time.Now()
+
+	return parseNTPResponse(response, request, xmitTime, recvTime)
+}
@@ -0,0 +1,132 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package net
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/beevik/ntp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/aggregator/mocksender"
+	"github.com/DataDog/datadog-agent/pkg/metrics"
+)
+
+// startTestNTPTCPServer starts an NTP server answering over TCP with a clock
+// ahead by clockOffset, and returns its port
+func startTestNTPTCPServer(t *testing.T, clockOffset time.Duration) (int, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				var length [2]byte
+				if _, err := io.ReadFull(conn, length[:]); err != nil {
+					return
+				}
+				request := make([]byte, binary.BigEndian.Uint16(length[:]))
+				if _, err := io.ReadFull(conn, request); err != nil {
+					return
+				}
+
+				now := time.Now().Add(clockOffset)
+				response := make([]byte, 2+ntpPacketSize)
+				binary.BigEndian.PutUint16(response, ntpPacketSize)
+				response[2] = 4<<3 | ntpModeServer
+				response[3] = 2
+				copy(response[26:34], request[40:48])
+				binary.BigEndian.PutUint64(response[18:], toNTPTime(now))
+				binary.BigEndian.PutUint64(response[34:], toNTPTime(now))
+				binary.BigEndian.PutUint64(response[42:], toNTPTime(now))
+				conn.Write(response)
+			}(conn)
+		}
+	}()
+
+	return listener.Addr().(*net.TCPAddr).Port, func() { listener.Close() }
+}
+
+func TestNTPQueryTCP(t *testing.T) {
+	port, stop := startTestNTPTCPServer(t, 10*time.Second)
+	defer stop()
+
+	response, err := queryTCP("127.0.0.1", ntp.QueryOptions{Version: 4, Port: port, Timeout: time.Second})
+	require.NoError(t, err)
+	assert.InDelta(t, 10, response.ClockOffset.Seconds(), 0.5)
+	assert.Equal(t, uint8(2), response.Stratum)
+	assert.NoError(t, response.Validate())
+}
+
+func TestNTPTCPProtocol(t *testing.T) {
+	port, stop := startTestNTPTCPServer(t, 10*time.Second)
+	defer stop()
+
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte(fmt.Sprintf("protocol: tcp\nport: %d\nhosts: [127.0.0.1]", port)), []byte(""), "test")
+	require.NoError(t, err)
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Gauge", "ntp.servers.responsive", float64(1), "", []string(nil))
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckOK, "", []string(nil), "")
+}
+
+func TestNTPTCPProtocolUnsupported(t *testing.T) {
+	// The server accepts the connection but closes it without answering
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	ntpCheck := new(NTPCheck)
+	err = ntpCheck.Configure([]byte(fmt.Sprintf("protocol: tcp\nversion: 4\ntimeout: 1\nstartup_grace_runs: 0\nport: %d\nhosts: [127.0.0.1]", listener.Addr().(*net.TCPAddr).Port)), []byte(""), "test")
+	require.NoError(t, err)
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Gauge", "ntp.servers.responsive", float64(0), "", []string(nil))
+	mockSender.AssertCalled(t, "Gauge", "ntp.host.success_ratio", float64(0), "", []string{"ntp_server:127.0.0.1"})
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckUnknown, "", []string(nil), "Failed to get clock offset from any ntp host. 127.0.0.1: error")
+}
+
+func TestNTPProtocolConfig(t *testing.T) {
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte("hosts: [0.time.dogo]"), []byte(""), "test")
+	require.NoError(t, err)
+	assert.Equal(t, ntpProtocolUDP, ntpCheck.cfg.instance.Protocol)
+
+	ntpCheck = new(NTPCheck)
+	err = ntpCheck.Configure([]byte("protocol: sctp"), []byte(""), "test")
+	assert.EqualError(t, err, `unsupported protocol "sctp", only udp and tcp are supported`)
+
+	ntpCheck = new(NTPCheck)
+	err = ntpCheck.Configure([]byte("protocol: tcp\nproxy: socks5://proxy.dogo"), []byte(""), "test")
+	assert.EqualError(t, err, "proxy is only supported with the udp protocol")
+}
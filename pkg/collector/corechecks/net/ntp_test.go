@@ -7,7 +7,11 @@ package net
 
 import (
 	"fmt"
+	"net"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -25,10 +29,18 @@ offset_threshold: 60
 port: 123
 version: 3
 timeout: 5
+startup_grace_runs: 0
 `
 	offset = 10
 )
 
+func init() {
+	// Hosts used in tests don't resolve, query them as is
+	ntpLookupHost = func(host string) ([]string, error) {
+		return []string{host}, nil
+	}
+}
+
 func testNTPQueryError(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
 	return nil, fmt.Errorf("test error from NTP")
 }
@@ -61,19 +73,38 @@ func TestNTPOK(t *testing.T) {
 	mockSender := mocksender.NewMockSender(ntpCheck.ID())
 
 	mockSender.On("Gauge", "ntp.offset", float64(21), "", []string(nil)).Return().Times(1)
+	for _, host := range defaultHosts {
+		mockSender.On("Gauge", "ntp.host.offset", float64(21), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.host.rtt", float64(0), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.stratum", float64(1), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.root_delay", float64(0), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.root_dispersion", float64(0), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+	}
+	mockSender.On("Gauge", "ntp.rtt", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.offset.stddev", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Count", "ntp.servers.rejected", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.responsive", float64(4), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.configured", float64(4), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.success_ratio", float64(1), "", mock.AnythingOfType("[]string")).Return().Times(4)
 	mockSender.On("ServiceCheck",
 		"ntp.in_sync",
 		metrics.ServiceCheckOK,
 		"",
 		[]string(nil),
 		"").Return().Times(1)
+	mockSender.On("ServiceCheck",
+		"ntp.leap",
+		metrics.ServiceCheckOK,
+		"",
+		[]string(nil),
+		"").Return().Times(1)
 
 	mockSender.On("Commit").Return().Times(1)
 	ntpCheck.Run()
 
 	mockSender.AssertExpectations(t)
-	mockSender.AssertNumberOfCalls(t, "Gauge", 1)
-	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 1)
+	mockSender.AssertNumberOfCalls(t, "Gauge", 29)
+	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 2)
 	mockSender.AssertNumberOfCalls(t, "Commit", 1)
 }
 
@@ -91,22 +122,180 @@ func TestNTPCritical(t *testing.T) {
 	mockSender := mocksender.NewMockSender(ntpCheck.ID())
 
 	mockSender.On("Gauge", "ntp.offset", float64(100), "", []string(nil)).Return().Times(1)
+	for _, host := range defaultHosts {
+		mockSender.On("Gauge", "ntp.host.offset", float64(100), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.host.rtt", float64(0), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.stratum", float64(1), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.root_delay", float64(0), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.root_dispersion", float64(0), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+	}
+	mockSender.On("Gauge", "ntp.rtt", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.offset.stddev", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Count", "ntp.servers.rejected", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.responsive", float64(4), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.configured", float64(4), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.success_ratio", float64(1), "", mock.AnythingOfType("[]string")).Return().Times(4)
 	mockSender.On("ServiceCheck",
 		"ntp.in_sync",
 		metrics.ServiceCheckCritical,
 		"",
 		[]string(nil),
 		"Offset 100 is higher than offset threshold (60 secs)").Return().Times(1)
+	mockSender.On("ServiceCheck",
+		"ntp.leap",
+		metrics.ServiceCheckOK,
+		"",
+		[]string(nil),
+		"").Return().Times(1)
 
+	mockSender.On("Event", mock.AnythingOfType("metrics.Event")).Return().Times(1)
 	mockSender.On("Commit").Return().Times(1)
 	ntpCheck.Run()
 
 	mockSender.AssertExpectations(t)
-	mockSender.AssertNumberOfCalls(t, "Gauge", 1)
-	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 1)
+	mockSender.AssertNumberOfCalls(t, "Gauge", 29)
+	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 2)
 	mockSender.AssertNumberOfCalls(t, "Commit", 1)
 }
 
+func TestNTPWarning(t *testing.T) {
+	var ntpCfg = []byte(`
+offset_threshold: 60
+warning_offset_threshold: 30
+`)
+	var ntpInitCfg = []byte("")
+
+	offset = 40
+	ntpQuery = testNTPQuery
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, ntpInitCfg, "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Gauge", "ntp.offset", float64(40), "", []string(nil))
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckWarning, "", []string(nil), "Offset 40 is higher than warning offset threshold (30 secs)")
+}
+
+func TestNTPWarningThresholdConfig(t *testing.T) {
+	testedConfigs := []struct {
+		config   string
+		expected float64
+	}{
+		{"offset_threshold: 60", 60},
+		{"offset_threshold: 60\nwarning_offset_threshold: 20", 20},
+		{"offset_threshold: 60\nwarning_offset_threshold: 90", 60},
+	}
+
+	for _, tc := range testedConfigs {
+		cfg := ntpConfig{}
+		err := cfg.parse([]byte(tc.config), nil, getLocalDefinedNTPServers)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, cfg.instance.WarningOffsetThreshold)
+	}
+}
+
+func TestNTPFractionalThreshold(t *testing.T) {
+	var ntpCfg = []byte(`
+offset_threshold: 0.25
+hosts:
+  - 0.time.dogo
+`)
+	var ntpInitCfg = []byte("")
+
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		return &ntp.Response{
+			ClockOffset: 300 * time.Millisecond,
+			Stratum:     1,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure(ntpCfg, ntpInitCfg, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.25, ntpCheck.cfg.instance.OffsetThreshold)
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckCritical, "", []string(nil), "Offset 0.3 is higher than offset threshold (0.25 secs)")
+}
+
+func TestNTPCustomTags(t *testing.T) {
+	var ntpCfg = []byte(`
+tags:
+  - env:test
+  - region:dogo
+`)
+	var ntpInitCfg = []byte("")
+
+	ntpQuery = testNTPQuery
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	// Custom tags are set on the sender by CommonConfigure, and appended
+	// by the sender to every metric and service check of the check
+	ntpCheck := new(NTPCheck)
+	ntpCheck.BuildID(ntpCfg, ntpInitCfg)
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+
+	err := ntpCheck.Configure(ntpCfg, ntpInitCfg, "test")
+	assert.NoError(t, err)
+
+	mockSender.AssertCalled(t, "SetCheckCustomTags", []string{"env:test", "region:dogo"})
+}
+
+func TestNTPOffsetThresholdEvent(t *testing.T) {
+	var ntpCfg = []byte(`
+hosts:
+  - 1
+  - 100
+  - 120
+`)
+	var ntpInitCfg = []byte("")
+
+	offsets := map[string]int{"1": 1, "100": 100, "120": 120}
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		return &ntp.Response{
+			ClockOffset: time.Duration(offsets[host]) * time.Second,
+			Stratum:     1,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, ntpInitCfg, "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	mockSender.On("Event", mock.AnythingOfType("metrics.Event")).Return()
+
+	// The event is only sent when the offset crosses the threshold
+	ntpCheck.Run()
+	ntpCheck.Run()
+	mockSender.AssertNumberOfCalls(t, "Event", 1)
+	mockSender.AssertCalled(t, "Event", metrics.Event{
+		Priority:       metrics.EventPriorityNormal,
+		AlertType:      metrics.EventAlertTypeError,
+		SourceTypeName: "ntp",
+		EventType:      "ntp",
+		Title:          "Clock offset is higher than the offset threshold",
+		Text:           "Offset 100 is higher than offset threshold (60 secs). The highest offset (120 secs) was reported by 120.",
+		Tags:           []string{"ntp_server:120"},
+	})
+
+	offsets = map[string]int{"1": 1, "100": 1, "120": 1}
+	ntpCheck.Run()
+	offsets = map[string]int{"1": 1, "100": 100, "120": 120}
+	ntpCheck.Run()
+	mockSender.AssertNumberOfCalls(t, "Event", 2)
+}
+
 func TestNTPError(t *testing.T) {
 	var ntpCfg = []byte(ntpCfgString)
 	var ntpInitCfg = []byte("")
@@ -119,22 +308,55 @@ func TestNTPError(t *testing.T) {
 
 	mockSender := mocksender.NewMockSender(ntpCheck.ID())
 
+	mockSender.On("Gauge", "ntp.servers.responsive", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Count", "ntp.servers.rejected", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.configured", float64(4), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.success_ratio", float64(0), "", mock.AnythingOfType("[]string")).Return().Times(4)
 	mockSender.On("ServiceCheck",
 		"ntp.in_sync",
 		metrics.ServiceCheckUnknown,
 		"",
 		[]string(nil),
 		mock.AnythingOfType("string")).Return().Times(1)
+	mockSender.On("ServiceCheck",
+		"ntp.leap",
+		metrics.ServiceCheckUnknown,
+		"",
+		[]string(nil),
+		"No ntp host responded").Return().Times(1)
 
 	mockSender.On("Commit").Return().Times(1)
 	ntpCheck.Run()
 
 	mockSender.AssertExpectations(t)
-	mockSender.AssertNumberOfCalls(t, "Gauge", 0)
-	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 1)
+	mockSender.AssertNumberOfCalls(t, "Gauge", 6)
+	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 2)
 	mockSender.AssertNumberOfCalls(t, "Commit", 1)
 }
 
+func TestNTPLastSync(t *testing.T) {
+	var ntpCfg = []byte(ntpCfgString)
+	var ntpInitCfg = []byte("")
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, ntpInitCfg, "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+
+	ntpLastSyncExpVar.Set(0)
+
+	ntpQuery = testNTPQueryError
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+	ntpCheck.Run()
+	assert.Equal(t, int64(0), ntpLastSyncExpVar.Value())
+
+	ntpQuery = testNTPQuery
+	before := time.Now().Unix()
+	ntpCheck.Run()
+	assert.True(t, ntpLastSyncExpVar.Value() >= before)
+}
+
 func TestNTPInvalid(t *testing.T) {
 	var ntpCfg = []byte(ntpCfgString)
 	var ntpInitCfg = []byte("")
@@ -147,19 +369,29 @@ func TestNTPInvalid(t *testing.T) {
 
 	mockSender := mocksender.NewMockSender(ntpCheck.ID())
 
+	mockSender.On("Gauge", "ntp.servers.responsive", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Count", "ntp.servers.rejected", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.configured", float64(4), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.success_ratio", float64(0), "", mock.AnythingOfType("[]string")).Return().Times(4)
 	mockSender.On("ServiceCheck",
 		"ntp.in_sync",
 		metrics.ServiceCheckUnknown,
 		"",
 		[]string(nil),
 		mock.AnythingOfType("string")).Return().Times(1)
+	mockSender.On("ServiceCheck",
+		"ntp.leap",
+		metrics.ServiceCheckOK,
+		"",
+		[]string(nil),
+		"").Return().Times(1)
 
 	mockSender.On("Commit").Return().Times(1)
 	ntpCheck.Run()
 
 	mockSender.AssertExpectations(t)
-	mockSender.AssertNumberOfCalls(t, "Gauge", 0)
-	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 1)
+	mockSender.AssertNumberOfCalls(t, "Gauge", 6)
+	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 2)
 	mockSender.AssertNumberOfCalls(t, "Commit", 1)
 }
 
@@ -177,19 +409,39 @@ func TestNTPNegativeOffsetCritical(t *testing.T) {
 	mockSender := mocksender.NewMockSender(ntpCheck.ID())
 
 	mockSender.On("Gauge", "ntp.offset", float64(-100), "", []string(nil)).Return().Times(1)
+	for _, host := range defaultHosts {
+		mockSender.On("Gauge", "ntp.host.offset", float64(-100), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.host.rtt", float64(0), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.stratum", float64(1), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.root_delay", float64(0), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+		mockSender.On("Gauge", "ntp.root_dispersion", float64(0), "", []string{"ntp_server:" + host, "ntp_version:3"}).Return().Times(1)
+	}
+	mockSender.On("Gauge", "ntp.rtt", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.offset.stddev", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Count", "ntp.servers.rejected", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.responsive", float64(4), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.configured", float64(4), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.success_ratio", float64(1), "", mock.AnythingOfType("[]string")).Return().Times(4)
 	mockSender.On("ServiceCheck",
 		"ntp.in_sync",
 		metrics.ServiceCheckCritical,
 		"",
 		[]string(nil),
 		"Offset -100 is higher than offset threshold (60 secs)").Return().Times(1)
+	mockSender.On("ServiceCheck",
+		"ntp.leap",
+		metrics.ServiceCheckOK,
+		"",
+		[]string(nil),
+		"").Return().Times(1)
 
+	mockSender.On("Event", mock.AnythingOfType("metrics.Event")).Return().Times(1)
 	mockSender.On("Commit").Return().Times(1)
 	ntpCheck.Run()
 
 	mockSender.AssertExpectations(t)
-	mockSender.AssertNumberOfCalls(t, "Gauge", 1)
-	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 1)
+	mockSender.AssertNumberOfCalls(t, "Gauge", 29)
+	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 2)
 	mockSender.AssertNumberOfCalls(t, "Commit", 1)
 }
 
@@ -218,19 +470,38 @@ hosts:
 	mockSender := mocksender.NewMockSender(ntpCheck.ID())
 
 	mockSender.On("Gauge", "ntp.offset", float64(2), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.offset", float64(1), "", []string{"ntp_server:1", "ntp_version:4"}).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.offset", float64(400), "", []string{"ntp_server:400", "ntp_version:4"}).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.offset", float64(2), "", []string{"ntp_server:2", "ntp_version:4"}).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.rtt", float64(0), "", mock.AnythingOfType("[]string")).Return().Times(3)
+	mockSender.On("Gauge", "ntp.stratum", float64(15), "", mock.AnythingOfType("[]string")).Return().Times(3)
+	mockSender.On("Gauge", "ntp.root_delay", float64(0), "", mock.AnythingOfType("[]string")).Return().Times(3)
+	mockSender.On("Gauge", "ntp.root_dispersion", float64(0), "", mock.AnythingOfType("[]string")).Return().Times(3)
+	mockSender.On("Gauge", "ntp.rtt", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.offset.stddev", mock.AnythingOfType("float64"), "", []string(nil)).Return().Times(1)
+	mockSender.On("Count", "ntp.servers.rejected", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.responsive", float64(3), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.configured", float64(3), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.success_ratio", float64(1), "", mock.AnythingOfType("[]string")).Return().Times(3)
 	mockSender.On("ServiceCheck",
 		"ntp.in_sync",
 		metrics.ServiceCheckOK,
 		"",
 		[]string(nil),
 		"").Return().Times(1)
+	mockSender.On("ServiceCheck",
+		"ntp.leap",
+		metrics.ServiceCheckOK,
+		"",
+		[]string(nil),
+		"").Return().Times(1)
 
 	mockSender.On("Commit").Return().Times(1)
 	ntpCheck.Run()
 
 	mockSender.AssertExpectations(t)
-	mockSender.AssertNumberOfCalls(t, "Gauge", 1)
-	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 1)
+	mockSender.AssertNumberOfCalls(t, "Gauge", 23)
+	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 2)
 	mockSender.AssertNumberOfCalls(t, "Commit", 1)
 }
 
@@ -259,19 +530,38 @@ hosts:
 	mockSender := mocksender.NewMockSender(ntpCheck.ID())
 
 	mockSender.On("Gauge", "ntp.offset", float64(400), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.offset", float64(1), "", []string{"ntp_server:1", "ntp_version:4"}).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.offset", float64(400), "", []string{"ntp_server:400", "ntp_version:4"}).Return().Times(2)
+	mockSender.On("Gauge", "ntp.host.rtt", float64(0), "", mock.AnythingOfType("[]string")).Return().Times(3)
+	mockSender.On("Gauge", "ntp.stratum", float64(15), "", mock.AnythingOfType("[]string")).Return().Times(3)
+	mockSender.On("Gauge", "ntp.root_delay", float64(0), "", mock.AnythingOfType("[]string")).Return().Times(3)
+	mockSender.On("Gauge", "ntp.root_dispersion", float64(0), "", mock.AnythingOfType("[]string")).Return().Times(3)
+	mockSender.On("Gauge", "ntp.rtt", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.offset.stddev", mock.AnythingOfType("float64"), "", []string(nil)).Return().Times(1)
+	mockSender.On("Count", "ntp.servers.rejected", float64(0), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.responsive", float64(3), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.servers.configured", float64(3), "", []string(nil)).Return().Times(1)
+	mockSender.On("Gauge", "ntp.host.success_ratio", float64(1), "", mock.AnythingOfType("[]string")).Return().Times(3)
 	mockSender.On("ServiceCheck",
 		"ntp.in_sync",
 		metrics.ServiceCheckCritical,
 		"",
 		[]string(nil),
 		"Offset 400 is higher than offset threshold (60 secs)").Return().Times(1)
+	mockSender.On("ServiceCheck",
+		"ntp.leap",
+		metrics.ServiceCheckOK,
+		"",
+		[]string(nil),
+		"").Return().Times(1)
 
+	mockSender.On("Event", mock.AnythingOfType("metrics.Event")).Return().Times(1)
 	mockSender.On("Commit").Return().Times(1)
 	ntpCheck.Run()
 
 	mockSender.AssertExpectations(t)
-	mockSender.AssertNumberOfCalls(t, "Gauge", 1)
-	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 1)
+	mockSender.AssertNumberOfCalls(t, "Gauge", 23)
+	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 2)
 	mockSender.AssertNumberOfCalls(t, "Commit", 1)
 }
 
@@ -334,67 +624,1273 @@ hosts:
 	assert.Equal(t, expectedHosts, ntpCheck.cfg.instance.Hosts)
 }
 
-func TestDefaultHostConfig(t *testing.T) {
-	expectedHosts := []string{"0.datadog.pool.ntp.org", "1.datadog.pool.ntp.org", "2.datadog.pool.ntp.org", "3.datadog.pool.ntp.org"}
-	testedConfig := []byte(``)
+func TestStructuredHostsConfig(t *testing.T) {
+	testedConfig := []byte(`
+port: 1230
+timeout: 5
+hosts:
+  - 0.time.dogo
+  - host: 1.time.dogo
+    timeout: 1
+  - host: 2.time.dogo
+    port: 123
+    timeout: 10
+    version: 3
+`)
 
 	ntpCheck := new(NTPCheck)
-	ntpCheck.Configure(testedConfig, []byte(""), "test")
+	err := ntpCheck.Configure(testedConfig, []byte(""), "test")
+	assert.NoError(t, err)
 
-	assert.Equal(t, expectedHosts, ntpCheck.cfg.instance.Hosts)
-}
+	assert.Equal(t, []string{"0.time.dogo", "1.time.dogo", "2.time.dogo:123"}, ntpCheck.cfg.instance.Hosts)
+	assert.Equal(t, ntp.QueryOptions{Version: 4, Port: 1230, Timeout: 5 * time.Second}, ntpCheck.queryOptions("0.time.dogo"))
+	assert.Equal(t, ntp.QueryOptions{Version: 4, Port: 1230, Timeout: 1 * time.Second}, ntpCheck.queryOptions("1.time.dogo"))
+	assert.Equal(t, ntp.QueryOptions{Version: 3, Port: 123, Timeout: 10 * time.Second}, ntpCheck.queryOptions("2.time.dogo:123"))
 
-func TestNTPPortConfig(t *testing.T) {
-	var detectedPorts []int
+	ntpCheck = new(NTPCheck)
+	err = ntpCheck.Configure([]byte("hosts: [{port: 123}]"), []byte(""), "test")
+	assert.Error(t, err)
+}
 
+func TestNTPVersionFallback(t *testing.T) {
+	var lock sync.Mutex
+	queries := map[string][]int{}
 	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
-		detectedPorts = append(detectedPorts, opt.Port)
-		return testNTPQuery(host, opt)
+		lock.Lock()
+		queries[host] = append(queries[host], opt.Version)
+		lock.Unlock()
+		if host != "v4.time.dogo" && opt.Version == 4 {
+			return nil, fmt.Errorf("test timeout from NTP")
+		}
+		return &ntp.Response{ClockOffset: time.Second, Stratum: 1}, nil
 	}
 	defer func() { ntpQuery = ntp.QueryWithOptions }()
 
 	ntpCheck := new(NTPCheck)
-	const expectedPort = 42
-	ntpCfg := []byte(fmt.Sprintf(`
-offset_threshold: 60
-port: %d
-`, expectedPort))
-	err := ntpCheck.Configure(ntpCfg, []byte(""), "test")
-	assert.Nil(t, err)
+	err := ntpCheck.Configure([]byte(`
+hosts:
+  - v3.time.dogo
+  - v4.time.dogo
+  - host: pinned.time.dogo
+    version: 4
+`), []byte(""), "test")
+	assert.NoError(t, err)
 
 	mockSender := mocksender.NewMockSender(ntpCheck.ID())
 	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Gauge", "ntp.host.offset", float64(1), "", []string{"ntp_server:v3.time.dogo", "ntp_version:3"})
+	mockSender.AssertCalled(t, "Gauge", "ntp.host.offset", float64(1), "", []string{"ntp_server:v4.time.dogo", "ntp_version:4"})
+	assert.Equal(t, map[string][]int{
+		"v3.time.dogo":     {4, 3},
+		"v4.time.dogo":     {4},
+		"pinned.time.dogo": {4},
+	}, queries)
+
+	// The negotiated version is used by the next runs
+	queries = map[string][]int{}
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
 
+	assert.Equal(t, map[string][]int{
+		"v3.time.dogo":     {3},
+		"v4.time.dogo":     {4},
+		"pinned.time.dogo": {4},
+	}, queries)
+
+	// No fallback when the instance version is configured
+	queries = map[string][]int{}
+	ntpCheck = new(NTPCheck)
+	err = ntpCheck.Configure([]byte("version: 4\nstartup_grace_runs: 0\nhosts: [v3.time.dogo]"), []byte(""), "test")
+	assert.NoError(t, err)
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
 	ntpCheck.Run()
 
-	for _, port := range detectedPorts {
-		assert.Equal(t, expectedPort, port)
+	assert.Equal(t, map[string][]int{"v3.time.dogo": {4}}, queries)
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckUnknown, "", nil, "Failed to get clock offset from any ntp host. v3.time.dogo: error")
+}
+
+func TestNTPSuccessRatio(t *testing.T) {
+	outcomes := []bool{true, false, true, true, false, true}
+	run := 0
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		if !outcomes[run] {
+			return nil, fmt.Errorf("test error from NTP")
+		}
+		return &ntp.Response{ClockOffset: time.Second, Stratum: 1}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte("version: 3\nsuccess_window: 4\nhosts: [0.time.dogo]"), []byte(""), "test")
+	assert.NoError(t, err)
+
+	tags := []string{"ntp_server:0.time.dogo"}
+	for ; run < len(outcomes); run++ {
+		mockSender := mocksender.NewMockSender(ntpCheck.ID())
+		mockSender.SetupAcceptAll()
+		ntpCheck.Run()
+
+		switch run {
+		case 1:
+			mockSender.AssertCalled(t, "Gauge", "ntp.host.success_ratio", 0.5, "", tags)
+		case 5:
+			// Only the last 4 runs are taken into account
+			mockSender.AssertCalled(t, "Gauge", "ntp.host.success_ratio", 0.75, "", tags)
+		}
 	}
 }
 
-func TestNTPPortNotInt(t *testing.T) {
+func TestNTPAsymmetricDelay(t *testing.T) {
+	rtts := []time.Duration{200 * time.Millisecond, 500 * time.Millisecond, 520 * time.Millisecond, 10 * time.Millisecond, 50 * time.Millisecond}
+	run := 0
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		return &ntp.Response{ClockOffset: time.Second, RTT: rtts[run], Stratum: 1}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
 	ntpCheck := new(NTPCheck)
-	ntpCfg := []byte(`
-offset_threshold: 60
-port: ntp`)
+	err := ntpCheck.Configure([]byte("hosts: [0.time.dogo]"), []byte(""), "test")
+	assert.NoError(t, err)
 
-	err := ntpCheck.Configure(ntpCfg, []byte(""), "test")
-	assert.EqualError(t, err, "yaml: unmarshal errors:\n  line 3: cannot unmarshal !!str `ntp` into int")
+	for ; run < len(rtts); run++ {
+		mockSender := mocksender.NewMockSender(ntpCheck.ID())
+		mockSender.SetupAcceptAll()
+		ntpCheck.Run()
+
+		// Only the jump from 200ms to 500ms is reported, the round-trip times of the last runs are too low
+		if run == 1 {
+			mockSender.AssertCalled(t, "Count", "ntp.delay.asymmetric", float64(1), "", []string{"ntp_server:0.time.dogo"})
+		} else {
+			mockSender.AssertNotCalled(t, "Count", "ntp.delay.asymmetric", mock.Anything, "", mock.Anything)
+		}
+	}
 }
 
-func TestNTPUseLocalDefinedServers(t *testing.T) {
-	const localNtpServerTest = "local NTP server"
-	getLocalServers := func() ([]string, error) { return []string{localNtpServerTest}, nil }
+func TestNTPMaxAbsoluteOffset(t *testing.T) {
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		o, _ := strconv.Atoi(host)
+		return &ntp.Response{
+			ClockOffset: time.Duration(o) * time.Second,
+			Stratum:     1,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
 
-	configUseLocalServer := ntpConfig{}
-	err := configUseLocalServer.parse([]byte("use_local_defined_servers: true"), nil, getLocalServers)
+	// A 10 hours offset is discarded by default
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte("hosts: [1, 2, 3, 36000]"), []byte(""), "test")
 	assert.NoError(t, err)
-	assert.True(t, configUseLocalServer.instance.UseLocalDefinedServers)
-	assert.Equal(t, []string{localNtpServerTest}, configUseLocalServer.instance.Hosts)
+	assert.Equal(t, 3600.0, ntpCheck.cfg.instance.MaxAbsoluteOffset)
 
-	defaultConfig := ntpConfig{}
-	err = defaultConfig.parse([]byte("use_local_defined_servers: false"), nil, getLocalServers)
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Gauge", "ntp.offset", float64(2), "", []string(nil))
+	mockSender.AssertCalled(t, "Count", "ntp.servers.rejected", float64(1), "", []string(nil))
+
+	ntpCheck = new(NTPCheck)
+	err = ntpCheck.Configure([]byte("max_absolute_offset: 2.5\nhosts: [1, 2, 3, 36000]"), []byte(""), "test")
 	assert.NoError(t, err)
-	assert.False(t, defaultConfig.instance.UseLocalDefinedServers)
-	assert.NotEqual(t, configUseLocalServer.instance.Hosts, defaultConfig.instance.Hosts)
+
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Gauge", "ntp.offset", 1.5, "", []string(nil))
+	mockSender.AssertCalled(t, "Count", "ntp.servers.rejected", float64(2), "", []string(nil))
+}
+
+func TestNTPPreferredHosts(t *testing.T) {
+	var lock sync.Mutex
+	queried := []string{}
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		lock.Lock()
+		queried = append(queried, host)
+		lock.Unlock()
+		if host == "fail" {
+			return nil, fmt.Errorf("test error from NTP")
+		}
+		o, _ := strconv.Atoi(host)
+		return &ntp.Response{
+			ClockOffset: time.Duration(o) * time.Second,
+			Stratum:     1,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	// The preferred hosts get enough offsets, the other ones aren't queried
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte(`
+version: 3
+min_responsive_hosts: 2
+hosts:
+  - "400"
+  - host: "1"
+    prefer: true
+  - "401"
+  - host: "2"
+    prefer: true
+`), []byte(""), "test")
+	assert.NoError(t, err)
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	assert.ElementsMatch(t, []string{"1", "2"}, queried)
+	mockSender.AssertCalled(t, "Gauge", "ntp.offset", 1.5, "", []string(nil))
+	mockSender.AssertCalled(t, "Gauge", "ntp.servers.responsive", float64(2), "", []string(nil))
+
+	// Too few preferred hosts respond, the offset is aggregated over the other ones
+	queried = []string{}
+	ntpCheck = new(NTPCheck)
+	err = ntpCheck.Configure([]byte(`
+version: 3
+min_responsive_hosts: 2
+hosts:
+  - "400"
+  - host: "1"
+    prefer: true
+  - "401"
+  - host: fail
+    prefer: true
+`), []byte(""), "test")
+	assert.NoError(t, err)
+
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	assert.ElementsMatch(t, []string{"1", "fail", "400", "401"}, queried)
+	mockSender.AssertCalled(t, "Gauge", "ntp.offset", 400.5, "", []string(nil))
+	mockSender.AssertCalled(t, "Gauge", "ntp.servers.responsive", float64(3), "", []string(nil))
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckCritical, "", []string(nil), "Offset 400.5 is higher than offset threshold (60 secs)")
+}
+
+func TestNTPDriftRate(t *testing.T) {
+	offsets := []time.Duration{time.Second, time.Second + time.Millisecond, 0, time.Second}
+	run := 0
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		if offsets[run] == 0 {
+			return nil, fmt.Errorf("test error from NTP")
+		}
+		return &ntp.Response{ClockOffset: offsets[run], Stratum: 1}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte("version: 3\nhosts: [0.time.dogo]"), []byte(""), "test")
+	assert.NoError(t, err)
+
+	for ; run < len(offsets); run++ {
+		mockSender := mocksender.NewMockSender(ntpCheck.ID())
+		mockSender.SetupAcceptAll()
+		ntpCheck.lastCollection = ntpCheck.lastCollection.Add(-100 * time.Second)
+		ntpCheck.Run()
+
+		// The drift rate is neither submitted on the first run nor after a failed run
+		if run == 1 {
+			mockSender.AssertCalled(t, "Gauge", "ntp.drift_rate", mock.MatchedBy(func(ppm float64) bool {
+				return ppm > 9.9 && ppm <= 10
+			}), "", []string(nil))
+		} else {
+			mockSender.AssertNotCalled(t, "Gauge", "ntp.drift_rate", mock.Anything, "", mock.Anything)
+		}
+	}
+}
+
+func TestNTPOffsetHistogram(t *testing.T) {
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		o, _ := strconv.Atoi(host)
+		return &ntp.Response{
+			ClockOffset: time.Duration(o) * time.Second,
+			Stratum:     1,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte("submit_offset_histogram: true\nhosts: [1, 2, 3]"), []byte(""), "test")
+	assert.NoError(t, err)
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Histogram", "ntp.offset.histogram", float64(1), "", []string(nil))
+	mockSender.AssertCalled(t, "Histogram", "ntp.offset.histogram", float64(2), "", []string(nil))
+	mockSender.AssertCalled(t, "Histogram", "ntp.offset.histogram", float64(3), "", []string(nil))
+
+	// The histogram is disabled by default
+	ntpCheck = new(NTPCheck)
+	err = ntpCheck.Configure([]byte("hosts: [1, 2, 3]"), []byte(""), "test")
+	assert.NoError(t, err)
+
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertNotCalled(t, "Histogram", "ntp.offset.histogram", mock.Anything, "", mock.Anything)
+}
+
+func TestNTPOffsetResultSummary(t *testing.T) {
+	result := ntpOffsetResult{
+		hostOffsets: []ntpHostOffset{
+			{host: "0.time.dogo", offset: 0.5},
+			{host: "1.time.dogo", offset: 3000, rejected: true},
+		},
+		hostErrors: []ntpHostError{
+			{host: "2.time.dogo", err: &net.DNSError{Err: "i/o timeout", IsTimeout: true}},
+			{host: "3.time.dogo", err: fmt.Errorf("invalid stratum"), invalid: true},
+			{host: "4.time.dogo", err: fmt.Errorf("kiss of death received: RATE"), kissCode: "RATE"},
+			{host: "5.time.dogo", err: fmt.Errorf("skipped after a kiss of death (DENY)"), kissCode: "DENY", skipped: true},
+			{host: "6.time.dogo", err: fmt.Errorf("test error from NTP")},
+		},
+	}
+
+	assert.Equal(t, []ntpHostDiagnostic{
+		{host: "0.time.dogo", status: ntpHostStatusOK, offset: 0.5},
+		{host: "1.time.dogo", status: ntpHostStatusRejected, offset: 3000},
+		{host: "2.time.dogo", status: ntpHostStatusTimeout},
+		{host: "3.time.dogo", status: ntpHostStatusInvalid},
+		{host: "4.time.dogo", status: ntpHostStatusKoD, kissCode: "RATE"},
+		{host: "5.time.dogo", status: ntpHostStatusSkipped, kissCode: "DENY"},
+		{host: "6.time.dogo", status: ntpHostStatusError},
+	}, result.diagnostics())
+	assert.Equal(t, "Failed. 0.time.dogo: ok (offset 0.5s), 1.time.dogo: rejected (offset 3000s), 2.time.dogo: timeout, "+
+		"3.time.dogo: invalid, 4.time.dogo: kod (RATE), 5.time.dogo: skipped (DENY), 6.time.dogo: error", result.summary("Failed"))
+	assert.Equal(t, "Failed", ntpOffsetResult{}.summary("Failed"))
+
+	// The hosts not fitting in the message are only counted
+	result = ntpOffsetResult{}
+	for i := 0; i < 100; i++ {
+		result.hostErrors = append(result.hostErrors, ntpHostError{host: fmt.Sprintf("%d.time.dogo", i), err: fmt.Errorf("test error from NTP")})
+	}
+	summary := result.summary("Failed")
+	assert.True(t, len(summary) <= maxServiceCheckMessageLength+len(" and 100 more hosts"))
+	assert.True(t, strings.HasPrefix(summary, "Failed. 0.time.dogo: error, 1.time.dogo: error"))
+	assert.Regexp(t, `error and \d+ more hosts$`, summary)
+}
+
+func TestNTPSingleSource(t *testing.T) {
+	referenceTime := time.Unix(1600000000, 0)
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		return &ntp.Response{
+			ClockOffset:   5 * time.Second,
+			Stratum:       1,
+			Leap:          ntp.LeapAddSecond,
+			ReferenceID:   0x47505300,
+			ReferenceTime: referenceTime,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte("single_source: true\nmax_absolute_offset: 1\nhosts: [gps.time.dogo]"), []byte(""), "test")
+	assert.NoError(t, err)
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	// The offset isn't rejected by max_absolute_offset
+	mockSender.AssertCalled(t, "Gauge", "ntp.offset", float64(5), "", []string(nil))
+	mockSender.AssertCalled(t, "Count", "ntp.servers.rejected", float64(0), "", []string(nil))
+	sourceTags := []string{"ntp_server:gps.time.dogo", "reference_id:GPS"}
+	mockSender.AssertCalled(t, "Gauge", "ntp.server.leap", float64(ntp.LeapAddSecond), "", sourceTags)
+	mockSender.AssertCalled(t, "Gauge", "ntp.reference_time", float64(1600000000), "", sourceTags)
+
+	// Without single_source, none of these metrics are submitted
+	ntpCheck = new(NTPCheck)
+	err = ntpCheck.Configure([]byte("hosts: [gps.time.dogo]"), []byte(""), "test")
+	assert.NoError(t, err)
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertNotCalled(t, "Gauge", "ntp.server.leap", mock.Anything, "", mock.Anything)
+	mockSender.AssertNotCalled(t, "Gauge", "ntp.reference_time", mock.Anything, "", mock.Anything)
+
+	// A single source is a single host
+	for _, config := range []string{
+		"single_source: true",
+		"single_source: true\nhosts: [0.time.dogo, 1.time.dogo]",
+	} {
+		ntpCheck = new(NTPCheck)
+		err = ntpCheck.Configure([]byte(config), []byte(""), "test")
+		assert.Error(t, err, config)
+	}
+}
+
+func TestFormatReferenceID(t *testing.T) {
+	assert.Equal(t, "GPS", formatReferenceID(0x47505300, 1))
+	assert.Equal(t, "PPS", formatReferenceID(0x50505300, 1))
+	assert.Equal(t, "192.0.2.1", formatReferenceID(0xc0000201, 2))
+}
+
+func TestSplitNTPHostPort(t *testing.T) {
+	for _, tc := range []struct {
+		hostport string
+		host     string
+		port     int
+	}{
+		{"time.dogo", "time.dogo", 0},
+		{"time.dogo:1230", "time.dogo", 1230},
+		{"10.0.0.1", "10.0.0.1", 0},
+		{"10.0.0.1:1230", "10.0.0.1", 1230},
+		{"::1", "::1", 0},
+		{"2001:db8::123", "2001:db8::123", 0},
+		{"[::1]", "::1", 0},
+		{"[::1]:1230", "::1", 1230},
+	} {
+		host, port, err := splitNTPHostPort(tc.hostport)
+		assert.NoError(t, err, tc.hostport)
+		assert.Equal(t, tc.host, host, tc.hostport)
+		assert.Equal(t, tc.port, port, tc.hostport)
+	}
+
+	for _, hostport := range []string{"time.dogo:ntp", "time.dogo:70000", "[::1]:"} {
+		_, _, err := splitNTPHostPort(hostport)
+		assert.Error(t, err, hostport)
+	}
+}
+
+func TestHostPortConfig(t *testing.T) {
+	testedConfig := []byte(`
+host: 0.time.dogo:1230
+hosts:
+  - 1.time.dogo
+  - 10.0.0.1:1231
+  - "[::1]:1232"
+  - host: 2.time.dogo:1233
+    timeout: 1
+`)
+
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure(testedConfig, []byte(""), "test")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"0.time.dogo:1230", "1.time.dogo", "10.0.0.1:1231", "[::1]:1232", "2.time.dogo:1233"}, ntpCheck.cfg.instance.Hosts)
+	for host, port := range map[string]int{"0.time.dogo:1230": 1230, "1.time.dogo": 123, "10.0.0.1:1231": 1231, "[::1]:1232": 1232, "2.time.dogo:1233": 1233} {
+		assert.Equal(t, port, ntpCheck.queryOptions(host).Port, host)
+	}
+}
+
+func TestHostPortTags(t *testing.T) {
+	var queriedHosts []string
+	var m sync.Mutex
+
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		m.Lock()
+		defer m.Unlock()
+		queriedHosts = append(queriedHosts, fmt.Sprintf("%s/%d", host, opt.Port))
+		return testNTPQuery(host, opt)
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	// The same server queried on two ports is reported once per port
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte("hosts: [10.0.0.1, 10.0.0.1:1230]"), []byte(""), "test")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.1:1230"}, ntpCheck.cfg.instance.Hosts)
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+
+	ntpCheck.Run()
+
+	assert.ElementsMatch(t, []string{"10.0.0.1/123", "10.0.0.1/1230"}, queriedHosts)
+	mockSender.AssertCalled(t, "Gauge", "ntp.host.offset", mock.Anything, "", []string{"ntp_server:10.0.0.1:1230", "ntp_version:4"})
+	mockSender.AssertCalled(t, "Gauge", "ntp.host.offset", mock.Anything, "", []string{"ntp_server:10.0.0.1", "ntp_version:4"})
+}
+
+func TestSourceAddressConfig(t *testing.T) {
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte("source_address: 127.0.0.1\nhosts: [0.time.dogo]"), []byte(""), "test")
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1", ntpCheck.queryOptions("0.time.dogo").LocalAddress)
+
+	ntpCheck = new(NTPCheck)
+	err = ntpCheck.Configure([]byte("source_address: eth0"), []byte(""), "test")
+	assert.EqualError(t, err, `source_address "eth0" is not a valid IP address`)
+
+	// Documentation address, not assigned to any interface
+	ntpCheck = new(NTPCheck)
+	err = ntpCheck.Configure([]byte("source_address: 192.0.2.1"), []byte(""), "test")
+	assert.Error(t, err)
+}
+
+func TestDefaultHostConfig(t *testing.T) {
+	expectedHosts := []string{"0.datadog.pool.ntp.org", "1.datadog.pool.ntp.org", "2.datadog.pool.ntp.org", "3.datadog.pool.ntp.org"}
+	testedConfig := []byte(``)
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(testedConfig, []byte(""), "test")
+
+	assert.Equal(t, expectedHosts, ntpCheck.cfg.instance.Hosts)
+}
+
+func TestNTPPortConfig(t *testing.T) {
+	var detectedPorts []int
+	var m sync.Mutex
+
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		m.Lock()
+		defer m.Unlock()
+		detectedPorts = append(detectedPorts, opt.Port)
+		return testNTPQuery(host, opt)
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	const expectedPort = 42
+	ntpCfg := []byte(fmt.Sprintf(`
+offset_threshold: 60
+port: %d
+`, expectedPort))
+	err := ntpCheck.Configure(ntpCfg, []byte(""), "test")
+	assert.Nil(t, err)
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+
+	ntpCheck.Run()
+
+	for _, port := range detectedPorts {
+		assert.Equal(t, expectedPort, port)
+	}
+}
+
+func TestNTPRTT(t *testing.T) {
+	var ntpCfg = []byte(`
+hosts:
+  - 10
+  - 30
+  - 20
+`)
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		rtt, _ := strconv.Atoi(host)
+		return &ntp.Response{
+			ClockOffset: time.Second,
+			RTT:         time.Duration(rtt) * time.Millisecond,
+			Stratum:     1,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, []byte(""), "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Gauge", "ntp.host.rtt", 0.01, "", []string{"ntp_server:10", "ntp_version:4"})
+	mockSender.AssertCalled(t, "Gauge", "ntp.host.rtt", 0.03, "", []string{"ntp_server:30", "ntp_version:4"})
+	mockSender.AssertCalled(t, "Gauge", "ntp.host.rtt", 0.02, "", []string{"ntp_server:20", "ntp_version:4"})
+	mockSender.AssertCalled(t, "Gauge", "ntp.rtt", 0.02, "", []string(nil))
+}
+
+func TestNTPAggregation(t *testing.T) {
+	hostOffsets := []ntpHostOffset{
+		{host: "a", offset: 1, rtt: 0.05},
+		{host: "b", offset: 2, rtt: 0.01},
+		{host: "c", offset: 3, rtt: 0.02},
+		{host: "d", offset: 4, rtt: 0.04},
+		{host: "e", offset: 40, rtt: 0.03},
+	}
+
+	for _, tc := range []struct {
+		method   string
+		expected float64
+	}{
+		{aggregationMedian, 3},
+		{aggregationMean, 10},
+		{aggregationMinRTT, 2},
+		{aggregationTrimmedMean, 3},
+		{aggregationWeighted, 2},
+	} {
+		t.Run(tc.method, func(t *testing.T) {
+			assert.Equal(t, tc.expected, aggregateOffsets(hostOffsets, tc.method))
+		})
+	}
+}
+
+func TestNTPWeightedMedian(t *testing.T) {
+	// Same round-trip times, same as the median
+	assert.Equal(t, 2.5, weightedMedian([]ntpHostOffset{
+		{offset: 4, rtt: 0.01},
+		{offset: 1, rtt: 0.01},
+		{offset: 3, rtt: 0.01},
+		{offset: 2, rtt: 0.01},
+	}))
+
+	// The fastest response outweighs the others
+	assert.Equal(t, 10.0, weightedMedian([]ntpHostOffset{
+		{offset: 1, rtt: 0.8},
+		{offset: 2, rtt: 0.5},
+		{offset: 10, rtt: 0.005},
+	}))
+
+	// Null round-trip times don't get an infinite weight
+	assert.Equal(t, 2.0, weightedMedian([]ntpHostOffset{
+		{offset: 1, rtt: 0},
+		{offset: 2, rtt: 0},
+		{offset: 3, rtt: 0},
+	}))
+}
+
+func TestNTPMaxRTT(t *testing.T) {
+	var ntpCfg = []byte(`
+aggregation: weighted
+max_rtt: 0.5
+hosts:
+  - 10
+  - 20
+  - 800
+`)
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		rtt, _ := strconv.Atoi(host)
+		return &ntp.Response{
+			ClockOffset: time.Duration(rtt) * time.Second,
+			RTT:         time.Duration(rtt) * time.Millisecond,
+			Stratum:     1,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, []byte(""), "test")
+
+	result, err := ntpCheck.queryOffset()
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, result.offset)
+	assert.False(t, result.hostOffsets[0].rejected)
+	assert.False(t, result.hostOffsets[1].rejected)
+	assert.True(t, result.hostOffsets[2].rejected)
+
+	ntpCheck.cfg.instance.MaxRTT = 0.001
+	_, err = ntpCheck.queryOffset()
+	assert.Error(t, err)
+}
+
+func TestNTPAggregationConfig(t *testing.T) {
+	for _, tc := range []struct {
+		config   string
+		expected string
+	}{
+		{"", aggregationMedian},
+		{"aggregation: mean", aggregationMean},
+		{"aggregation: min_rtt", aggregationMinRTT},
+		{"aggregation: trimmed_mean", aggregationTrimmedMean},
+		{"aggregation: weighted", aggregationWeighted},
+		{"aggregation: unknown", aggregationMedian},
+	} {
+		cfg := ntpConfig{}
+		err := cfg.parse([]byte(tc.config), nil, getLocalDefinedNTPServers)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, cfg.instance.Aggregation)
+	}
+}
+
+func TestNTPStddev(t *testing.T) {
+	assert.Equal(t, 0.0, stddev([]float64{}))
+	assert.Equal(t, 0.0, stddev([]float64{42}))
+	assert.Equal(t, 2.0, stddev([]float64{2, 4, 4, 4, 5, 5, 7, 9}))
+}
+
+func TestNTPRejectOutliers(t *testing.T) {
+	hostOffsets := []ntpHostOffset{
+		{host: "a", offset: 1},
+		{host: "b", offset: 2},
+		{host: "c", offset: 3},
+		{host: "d", offset: 400},
+	}
+
+	accepted := rejectOutliers(hostOffsets, 3)
+	assert.Len(t, accepted, 3)
+	assert.False(t, hostOffsets[0].rejected)
+	assert.False(t, hostOffsets[1].rejected)
+	assert.False(t, hostOffsets[2].rejected)
+	assert.True(t, hostOffsets[3].rejected)
+
+	// Not enough samples
+	hostOffsets = []ntpHostOffset{
+		{host: "a", offset: 1},
+		{host: "b", offset: 400},
+	}
+	accepted = rejectOutliers(hostOffsets, 3)
+	assert.Len(t, accepted, 2)
+
+	// Most servers agree on the same offset
+	hostOffsets = []ntpHostOffset{
+		{host: "a", offset: 0.001},
+		{host: "b", offset: 0.001},
+		{host: "c", offset: 0.0011},
+	}
+	accepted = rejectOutliers(hostOffsets, 3)
+	assert.Len(t, accepted, 3)
+
+	hostOffsets = []ntpHostOffset{
+		{host: "a", offset: 0.001},
+		{host: "b", offset: 0.001},
+		{host: "c", offset: 0.001},
+		{host: "d", offset: 0.5},
+	}
+	accepted = rejectOutliers(hostOffsets, 3)
+	assert.Len(t, accepted, 3)
+	assert.True(t, hostOffsets[3].rejected)
+}
+
+func TestNTPOutlierRejection(t *testing.T) {
+	var ntpCfg = []byte(`
+max_offset_deviation: 3
+hosts:
+  - 1
+  - 2
+  - 3
+  - 400
+`)
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		o, _ := strconv.Atoi(host)
+		return &ntp.Response{
+			ClockOffset: time.Duration(o) * time.Second,
+			Stratum:     1,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, []byte(""), "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Gauge", "ntp.offset", float64(2), "", []string(nil))
+	mockSender.AssertCalled(t, "Gauge", "ntp.host.offset", float64(400), "", []string{"ntp_server:400", "ntp_version:4"})
+	mockSender.AssertCalled(t, "Count", "ntp.servers.rejected", float64(1), "", []string(nil))
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckOK, "", []string(nil), "")
+}
+
+func TestNTPRejectedSubmittedWhenAllRejected(t *testing.T) {
+	var ntpCfg = []byte(`
+max_rtt: 0.001
+startup_grace_runs: 0
+hosts:
+  - 10
+  - 20
+`)
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		rtt, _ := strconv.Atoi(host)
+		return &ntp.Response{
+			RTT:     time.Duration(rtt) * time.Millisecond,
+			Stratum: 1,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, []byte(""), "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Count", "ntp.servers.rejected", float64(2), "", []string(nil))
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckUnknown, "", []string(nil),
+		"Failed to get clock offset from any ntp host with a round-trip time below max_rtt and an offset below max_absolute_offset. "+
+			"10: rejected (offset 0s), 20: rejected (offset 0s)")
+}
+
+func TestNTPPerHostServiceCheck(t *testing.T) {
+	var ntpCfg = []byte(`
+per_host_service_check: true
+warning_offset_threshold: 30
+hosts:
+  - 1
+  - 40
+  - 400
+  - invalid
+  - unreachable
+`)
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		switch host {
+		case "unreachable":
+			return testNTPQueryError(host, opt)
+		case "invalid":
+			return testNTPQueryInvalid(host, opt)
+		}
+		o, _ := strconv.Atoi(host)
+		return &ntp.Response{
+			ClockOffset: time.Duration(o) * time.Second,
+			Stratum:     1,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, []byte(""), "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertServiceCheck(t, "ntp.host.in_sync", metrics.ServiceCheckOK, "", []string{"ntp_server:1"}, "")
+	// a host above the warning threshold is still in sync
+	mockSender.AssertServiceCheck(t, "ntp.host.in_sync", metrics.ServiceCheckOK, "", []string{"ntp_server:40"}, "Offset 40 is higher than warning offset threshold (30 secs)")
+	mockSender.AssertServiceCheck(t, "ntp.host.in_sync", metrics.ServiceCheckCritical, "", []string{"ntp_server:400"}, "Offset 400 is higher than offset threshold (60 secs)")
+	mockSender.AssertCalled(t, "ServiceCheck", "ntp.host.in_sync", metrics.ServiceCheckCritical, "", []string{"ntp_server:invalid"}, mock.AnythingOfType("string"))
+	mockSender.AssertServiceCheck(t, "ntp.host.in_sync", metrics.ServiceCheckUnknown, "", []string{"ntp_server:unreachable"}, "test error from NTP")
+	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 7)
+}
+
+func TestNTPLeapIndicator(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		hostOffsets []ntpHostOffset
+		hostErrors  []ntpHostError
+		status      metrics.ServiceCheckStatus
+		message     string
+	}{
+		{
+			name:        "no warning",
+			hostOffsets: []ntpHostOffset{{host: "a", leap: ntp.LeapNoWarning}, {host: "b", leap: ntp.LeapNoWarning}},
+			status:      metrics.ServiceCheckOK,
+		},
+		{
+			name:        "pending leap second",
+			hostOffsets: []ntpHostOffset{{host: "a", leap: ntp.LeapAddSecond}, {host: "b", leap: ntp.LeapNoWarning}},
+			status:      metrics.ServiceCheckWarning,
+			message:     "Ntp hosts announce a pending leap second: a",
+		},
+		{
+			name:        "not in sync",
+			hostOffsets: []ntpHostOffset{{host: "a", leap: ntp.LeapDelSecond}},
+			hostErrors:  []ntpHostError{{host: "b", invalid: true, leap: ntp.LeapNotInSync}},
+			status:      metrics.ServiceCheckCritical,
+			message:     "Ntp hosts report their clock as not synchronized: b",
+		},
+		{
+			name:       "no response",
+			hostErrors: []ntpHostError{{host: "a", leap: ntp.LeapNotInSync}},
+			status:     metrics.ServiceCheckUnknown,
+			message:    "No ntp host responded",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			status, message := leapIndicatorStatus(tc.hostOffsets, tc.hostErrors)
+			assert.Equal(t, tc.status, status)
+			assert.Equal(t, tc.message, message)
+		})
+	}
+}
+
+func TestNTPKissOfDeathBackoff(t *testing.T) {
+	var ntpCfg = []byte(`
+hosts:
+  - 0.time.dogo
+  - kod.time.dogo
+`)
+	var m sync.Mutex
+	queries := map[string]int{}
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		m.Lock()
+		queries[host]++
+		m.Unlock()
+		if host == "kod.time.dogo" {
+			return &ntp.Response{
+				Stratum:  0,
+				KissCode: "RATE",
+			}, nil
+		}
+		return testNTPQuery(host, opt)
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, []byte(""), "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+
+	// The host is skipped for 1 run after the first KoD, then 2 runs after the second one
+	expectedQueries := []int{1, 1, 2, 2, 2, 3}
+	for i, expected := range expectedQueries {
+		ntpCheck.Run()
+		assert.Equal(t, i+1, queries["0.time.dogo"])
+		assert.Equal(t, expected, queries["kod.time.dogo"], "run %d", i+1)
+	}
+
+	mockSender.AssertCalled(t, "Count", "ntp.server.kod", float64(1), "", []string{"ntp_server:kod.time.dogo", "kiss_code:RATE"})
+	// 3 Kiss-o'-Death, and ntp.servers.rejected on every run
+	mockSender.AssertNumberOfCalls(t, "Count", 3+len(expectedQueries))
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckOK, "", []string(nil), "")
+}
+
+func TestNTPServersCount(t *testing.T) {
+	var ntpCfg = []byte(`
+hosts:
+  - 0.time.dogo
+  - 1.time.dogo
+  - unreachable
+`)
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		if host == "unreachable" {
+			return testNTPQueryError(host, opt)
+		}
+		return testNTPQuery(host, opt)
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, []byte(""), "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Gauge", "ntp.servers.responsive", float64(2), "", []string(nil))
+	mockSender.AssertCalled(t, "Gauge", "ntp.servers.configured", float64(3), "", []string(nil))
+}
+
+func TestNTPMinResponsiveHosts(t *testing.T) {
+	var ntpCfg = []byte(`
+min_responsive_hosts: 3
+startup_grace_runs: 0
+hosts:
+  - 0.time.dogo
+  - 1.time.dogo
+  - unreachable
+`)
+	offset = 1
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		if host == "unreachable" {
+			return testNTPQueryError(host, opt)
+		}
+		return testNTPQuery(host, opt)
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, []byte(""), "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckUnknown, "", nil,
+		"Only 2 ntp hosts returned a valid offset, at least 3 are required by min_responsive_hosts. 0.time.dogo: ok (offset 1s), 1.time.dogo: ok (offset 1s), unreachable: error")
+	mockSender.AssertNotCalled(t, "Gauge", "ntp.offset", mock.Anything, "", []string(nil))
+
+	ntpCheck.cfg.instance.MinResponsiveHosts = 2
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckOK, "", nil, "")
+}
+
+func TestNTPDefaultMinResponsiveHosts(t *testing.T) {
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure([]byte("hosts: [0.time.dogo]"), []byte(""), "test")
+
+	assert.Equal(t, 1, ntpCheck.cfg.instance.MinResponsiveHosts)
+}
+
+func TestNTPStaleOffset(t *testing.T) {
+	var ntpCfg = []byte(`
+stale_offset_max_age: 60
+warning_offset_threshold: 10
+hosts:
+  - 0.time.dogo
+`)
+	offset = 21
+	ntpQuery = testNTPQuery
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, []byte(""), "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	// The last offset is reused when all the hosts fail
+	ntpQuery = testNTPQueryError
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertCalled(t, "Gauge", "ntp.offset", float64(21), "", []string{"stale:true"})
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckWarning, "", nil,
+		"Offset 21 is higher than warning offset threshold (10 secs)")
+
+	// Until it's too old
+	ntpCheck.lastOffsetTime = time.Now().Add(-2 * time.Minute)
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertNotCalled(t, "Gauge", "ntp.offset", mock.Anything, "", mock.Anything)
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckUnknown, "", nil, "Failed to get clock offset from any ntp host. 0.time.dogo: error")
+}
+
+func TestNTPStaleOffsetNotReusedWhenRejected(t *testing.T) {
+	var ntpCfg = []byte(`
+stale_offset_max_age: 60
+max_absolute_offset: 60
+hosts:
+  - 0.time.dogo
+`)
+	offset = 1
+	ntpQuery = testNTPQuery
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure(ntpCfg, []byte(""), "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	// The host responded, its offset is rejected rather than bridged with the last one
+	offset = 3600
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertNotCalled(t, "Gauge", "ntp.offset", mock.Anything, "", mock.Anything)
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckUnknown, "", nil,
+		"Failed to get clock offset from any ntp host with a round-trip time below max_rtt and an offset below max_absolute_offset. "+
+			"0.time.dogo: rejected (offset 3600s)")
+}
+
+func TestNTPServerMetrics(t *testing.T) {
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		return &ntp.Response{
+			Stratum:        2,
+			RootDelay:      20 * time.Millisecond,
+			RootDispersion: 500 * time.Millisecond,
+		}, nil
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCheck.Configure([]byte("hosts: [0.time.dogo]"), []byte(""), "test")
+
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	tags := []string{"ntp_server:0.time.dogo", "ntp_version:4"}
+	mockSender.AssertCalled(t, "Gauge", "ntp.stratum", float64(2), "", tags)
+	mockSender.AssertCalled(t, "Gauge", "ntp.root_delay", 0.02, "", tags)
+	mockSender.AssertCalled(t, "Gauge", "ntp.root_dispersion", 0.5, "", tags)
+
+	// Server metrics can be disabled
+	ntpCheck = new(NTPCheck)
+	ntpCheck.Configure([]byte("collect_server_metrics: false\nhosts: [0.time.dogo]"), []byte(""), "test")
+
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertNotCalled(t, "Gauge", "ntp.stratum", mock.Anything, "", mock.Anything)
+	mockSender.AssertNotCalled(t, "Gauge", "ntp.root_delay", mock.Anything, "", mock.Anything)
+	mockSender.AssertNotCalled(t, "Gauge", "ntp.root_dispersion", mock.Anything, "", mock.Anything)
+}
+
+func TestNTPMaxConcurrency(t *testing.T) {
+	var running, maxRunning int32
+
+	ntpQuery = func(host string, opt ntp.QueryOptions) (*ntp.Response, error) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return testNTPQuery(host, opt)
+	}
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	ntpCfg := []byte(`
+max_concurrency: 2
+hosts:
+  - 0.time.dogo
+  - 1.time.dogo
+  - 2.time.dogo
+  - 3.time.dogo
+  - 4.time.dogo
+`)
+	err := ntpCheck.Configure(ntpCfg, []byte(""), "test")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, ntpCheck.cfg.instance.MaxConcurrency)
+
+	result, err := ntpCheck.queryOffset()
+	assert.Nil(t, err)
+	assert.Len(t, result.hostOffsets, 5)
+	for i, h := range result.hostOffsets {
+		assert.Equal(t, ntpCheck.cfg.instance.Hosts[i], h.host)
+	}
+	assert.True(t, maxRunning <= 2)
+}
+
+func TestNTPDefaultMaxConcurrency(t *testing.T) {
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte(""), []byte(""), "test")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, ntpCheck.cfg.instance.MaxConcurrency)
+}
+
+func TestNTPDNSCacheTTLConfig(t *testing.T) {
+	for _, tc := range []struct {
+		config   string
+		expected int
+	}{
+		{"", 0},
+		{"dns_cache_ttl: 60", 60},
+		{"dns_cache_ttl: 0", 0},
+	} {
+		ntpCheck := new(NTPCheck)
+		err := ntpCheck.Configure([]byte(tc.config), []byte(""), "test")
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, ntpCheck.cfg.instance.DNSCacheTTL, tc.config)
+	}
+}
+
+func TestNTPUseNTSNotSupported(t *testing.T) {
+	for _, ntpCfg := range []string{
+		"use_nts: true",
+		"nts_ke_server: nts.time.dogo",
+		"use_nts: true\nnts_ke_server: nts.time.dogo",
+	} {
+		ntpCheck := new(NTPCheck)
+		err := ntpCheck.Configure([]byte(ntpCfg), []byte(""), "test")
+		assert.EqualError(t, err, "use_nts or nts_ke_server is set but Network Time Security is not supported by the ntp check", ntpCfg)
+	}
+}
+
+func TestNTPCollectionJitter(t *testing.T) {
+	ntpCheck := ntpFactory().(*NTPCheck)
+	err := ntpCheck.Configure([]byte(""), []byte(""), "test")
+	assert.NoError(t, err)
+	assert.Equal(t, 900*time.Second, ntpCheck.Interval())
+
+	ntpCheck = ntpFactory().(*NTPCheck)
+	err = ntpCheck.Configure([]byte("collection_jitter: 60"), []byte(""), "test")
+	assert.NoError(t, err)
+	interval := ntpCheck.Interval()
+	assert.True(t, interval >= 900*time.Second)
+	assert.True(t, interval <= 960*time.Second)
+
+	// The jitter is stable across reloads
+	ntpCheck = ntpFactory().(*NTPCheck)
+	err = ntpCheck.Configure([]byte("collection_jitter: 60"), []byte(""), "test")
+	assert.NoError(t, err)
+	assert.Equal(t, interval, ntpCheck.Interval())
+}
+
+func TestNTPPortNotInt(t *testing.T) {
+	ntpCheck := new(NTPCheck)
+	ntpCfg := []byte(`
+offset_threshold: 60
+port: ntp`)
+
+	err := ntpCheck.Configure(ntpCfg, []byte(""), "test")
+	assert.EqualError(t, err, "yaml: unmarshal errors:\n  line 3: cannot unmarshal !!str `ntp` into int")
+}
+
+func TestNTPUseLocalDefinedServers(t *testing.T) {
+	const localNtpServerTest = "local NTP server"
+	getLocalServers := func() ([]string, error) { return []string{localNtpServerTest}, nil }
+
+	configUseLocalServer := ntpConfig{}
+	err := configUseLocalServer.parse([]byte("use_local_defined_servers: true"), nil, getLocalServers)
+	assert.NoError(t, err)
+	assert.True(t, configUseLocalServer.instance.UseLocalDefinedServers)
+	assert.Equal(t, []string{localNtpServerTest}, configUseLocalServer.instance.Hosts)
+
+	defaultConfig := ntpConfig{}
+	err = defaultConfig.parse([]byte("use_local_defined_servers: false"), nil, getLocalServers)
+	assert.NoError(t, err)
+	assert.False(t, defaultConfig.instance.UseLocalDefinedServers)
+	assert.NotEqual(t, configUseLocalServer.instance.Hosts, defaultConfig.instance.Hosts)
+}
+
+func TestNTPNoServers(t *testing.T) {
+	noLocalServers := func() ([]string, error) { return nil, nil }
+
+	cfg := ntpConfig{}
+	err := cfg.parse([]byte("hosts: []"), nil, noLocalServers)
+	assert.EqualError(t, err, "no ntp server to query: hosts is empty")
+
+	cfg = ntpConfig{}
+	err = cfg.parse([]byte("use_local_defined_servers: true\nhosts: []"), nil, noLocalServers)
+	assert.EqualError(t, err, "no ntp server to query: no server is defined locally and hosts is empty")
+
+	// The default servers are used when hosts isn't set
+	cfg = ntpConfig{}
+	err = cfg.parse([]byte("use_local_defined_servers: true"), nil, noLocalServers)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultHosts, cfg.instance.Hosts)
+
+	// A host alone is enough
+	cfg = ntpConfig{}
+	err = cfg.parse([]byte("host: 0.time.dogo\nhosts: []"), nil, noLocalServers)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0.time.dogo"}, cfg.instance.Hosts)
+}
+
+func TestNTPStartupGraceRuns(t *testing.T) {
+	ntpQuery = testNTPQueryError
+	defer func() { ntpQuery = ntp.QueryWithOptions }()
+
+	ntpCheck := new(NTPCheck)
+	err := ntpCheck.Configure([]byte("hosts: [0.time.dogo]"), []byte(""), "test")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, ntpCheck.cfg.instance.StartupGraceRuns)
+
+	// The failure of the first run doesn't submit any service check
+	mockSender := mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertNotCalled(t, "ServiceCheck", "ntp.in_sync", mock.Anything, "", mock.Anything, mock.Anything)
+	mockSender.AssertNotCalled(t, "ServiceCheck", "ntp.leap", mock.Anything, "", mock.Anything, mock.Anything)
+	mockSender.AssertCalled(t, "Gauge", "ntp.servers.responsive", float64(0), "", []string(nil))
+
+	// The next ones do
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckUnknown, "", nil, "Failed to get clock offset from any ntp host. 0.time.dogo: error")
+
+	// The grace period ends with the first successful run
+	ntpCheck = new(NTPCheck)
+	err = ntpCheck.Configure([]byte("startup_grace_runs: 3\nhosts: [0.time.dogo]"), []byte(""), "test")
+	assert.NoError(t, err)
+
+	ntpQuery = testNTPQuery
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	ntpQuery = testNTPQueryError
+	mockSender = mocksender.NewMockSender(ntpCheck.ID())
+	mockSender.SetupAcceptAll()
+	ntpCheck.Run()
+
+	mockSender.AssertServiceCheck(t, "ntp.in_sync", metrics.ServiceCheckUnknown, "", nil, "Failed to get clock offset from any ntp host. 0.time.dogo: error")
 }
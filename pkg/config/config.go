@@ -215,10 +215,18 @@ func InitConfig(config Config) {
 	config.BindEnvAndSetDefault("disable_cluster_name_tag_key", false)
 
 	// secrets backend
+	config.BindEnvAndSetDefault("secret_backend_type", "")
 	config.BindEnvAndSetDefault("secret_backend_command", "")
 	config.BindEnvAndSetDefault("secret_backend_arguments", []string{})
 	config.BindEnvAndSetDefault("secret_backend_output_max_size", secrets.SecretBackendOutputMaxSize)
-	config.BindEnvAndSetDefault("secret_backend_timeout", 5)
+	config.BindEnvAndSetDefault("secret_backend_timeout", 30)
+	config.BindEnvAndSetDefault("secret_refresh_interval", 0)
+	config.BindEnvAndSetDefault("secret_audit_log_file", "")
+	config.BindEnvAndSetDefault("secret_backend_max_concurrency", 1)
+	config.BindEnvAndSetDefault("secret_backend_retries", 0)
+	config.BindEnvAndSetDefault("secret_backend_retry_base_delay", 1)
+	config.BindEnvAndSetDefault("secret_backend_decode", "")
+	config.BindEnvAndSetDefault("secret_backend_socket", "")
 
 	// Use to output logs in JSON format
 	config.BindEnvAndSetDefault("log_format_json", false)
@@ -729,6 +737,8 @@ func InitConfig(config Config) {
 	config.BindEnvAndSetDefault("runtime_security_config.run_path", defaultRunPath)
 	config.BindEnvAndSetDefault("runtime_security_config.event_server.burst", 40)
 	config.BindEnvAndSetDefault("runtime_security_config.event_server.rate", 10)
+	config.BindEnvAndSetDefault("runtime_security_config.exec_env_allowlist", []string{"LD_PRELOAD", "LD_LIBRARY_PATH", "PATH"})
+	config.BindEnvAndSetDefault("runtime_security_config.disabled_hook_points", []string{})
 
 	// command line options
 	config.SetKnown("cmd.check.fullsketches")
@@ -901,14 +911,9 @@ func load(config Config, origin string, loadSecret bool) (*Warnings, error) {
 func ResolveSecrets(config Config, origin string) error {
 	// We have to init the secrets package before we can use it to decrypt
 	// anything.
-	secrets.Init(
-		config.GetString("secret_backend_command"),
-		config.GetStringSlice("secret_backend_arguments"),
-		config.GetInt("secret_backend_timeout"),
-		config.GetInt("secret_backend_output_max_size"),
-	)
-
-	if config.GetString("secret_backend_command") != "" {
+	InitSecrets(config)
+
+	if secretBackendEnabled(config) {
 		// Viper doesn't expose the final location of the file it
 		// loads. Since we are searching for 'datadog.yaml' in multiple
 		// locations we let viper determine the one to use before
@@ -930,6 +935,36 @@ func ResolveSecrets(config Config, origin string) error {
 	return nil
 }
 
+// secretBackendEnabled returns whether config sets a secret backend
+func secretBackendEnabled(config Config) bool {
+	switch config.GetString("secret_backend_type") {
+	case "file":
+		return true
+	case "socket":
+		return config.GetString("secret_backend_socket") != ""
+	default:
+		return config.GetString("secret_backend_command") != ""
+	}
+}
+
+// InitSecrets initializes the secrets package with the secret backend settings of config
+func InitSecrets(config Config) {
+	secrets.Init(secrets.Options{
+		BackendType:     config.GetString("secret_backend_type"),
+		Socket:          config.GetString("secret_backend_socket"),
+		Command:         config.GetString("secret_backend_command"),
+		Arguments:       config.GetStringSlice("secret_backend_arguments"),
+		Timeout:         config.GetInt("secret_backend_timeout"),
+		OutputMaxSize:   config.GetInt("secret_backend_output_max_size"),
+		RefreshInterval: time.Duration(config.GetInt("secret_refresh_interval")) * time.Second,
+		AuditLogFile:    config.GetString("secret_audit_log_file"),
+		MaxConcurrency:  config.GetInt("secret_backend_max_concurrency"),
+		Retries:         config.GetInt("secret_backend_retries"),
+		RetryBaseDelay:  time.Duration(config.GetInt("secret_backend_retry_base_delay")) * time.Second,
+		Decode:          config.GetString("secret_backend_decode"),
+	})
+}
+
 // SanitizeAPIKeyConfig strips newlines and other control characters from a given key.
 func SanitizeAPIKeyConfig(config Config, key string) {
 	config.Set(key, SanitizeAPIKey(config.GetString(key)))
@@ -0,0 +1,91 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets
+
+package secrets
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+var (
+	// secretAuditLogFile is the file the audit events are appended to, they're written to the agent log when empty
+	secretAuditLogFile string
+	auditLock          sync.Mutex
+)
+
+// auditEvent records the resolution of a secret handle. It never contains the value of the secret.
+type auditEvent struct {
+	Timestamp string `json:"timestamp"`
+	Handle    string `json:"handle"`
+	Origin    string `json:"origin"`
+	Backend   string `json:"backend"`
+	Cached    bool   `json:"cached"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+// auditSecret records that a secret handle referenced by origin was resolved, either from the cache or from the
+// secret_backend_command
+func auditSecret(handle string, origin string, cached bool, err error) {
+	event := auditEvent{
+		Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+		Handle:    handle,
+		Origin:    origin,
+		Backend:   backendName(),
+		Cached:    cached,
+		Success:   err == nil,
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Errorf("Could not serialize secret audit event for '%s': %s", handle, err)
+		return
+	}
+
+	if secretAuditLogFile == "" {
+		log.Infof("Secret audit: %s", payload)
+		return
+	}
+
+	auditLock.Lock()
+	defer auditLock.Unlock()
+
+	f, err := os.OpenFile(secretAuditLogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		log.Errorf("Could not open secret audit log file '%s': %s", secretAuditLogFile, err)
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(payload, '\n')); err != nil {
+		log.Errorf("Could not write to secret audit log file '%s': %s", secretAuditLogFile, err)
+	}
+}
+
+// auditSecrets records the resolution of several secret handles with the same outcome
+func auditSecrets(handles []string, origin string, err error) {
+	for _, handle := range handles {
+		auditSecret(handle, origin, false, err)
+	}
+}
+
+// secretOrigins returns the configurations a secret handle was found in, secretLock must be held
+func secretOrigins(handle string) string {
+	origins := secretOrigin[handle].GetAll()
+	sort.Strings(origins)
+	return strings.Join(origins, ",")
+}
@@ -0,0 +1,107 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets
+
+package secrets
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/util/common"
+)
+
+func readAuditEvents(t *testing.T, path string) []auditEvent {
+	content, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	events := []auditEvent{}
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		event := auditEvent{}
+		require.NoError(t, json.Unmarshal([]byte(line), &event))
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestAuditFetchSecret(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	secretBackendCommand = "some_command"
+	secretAuditLogFile = filepath.Join(dir, "audit.log")
+	defer func() {
+		secretBackendCommand = ""
+		secretAuditLogFile = ""
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+		runCommand = execCommand
+	}()
+
+	runCommand = func(string) ([]byte, error) {
+		return []byte("{\"handle1\":{\"value\":\"password1\"},\"handle2\":{\"error\":\"some error\"}}"), nil
+	}
+	_, err = fetchSecret([]string{"handle1", "handle2"}, "test")
+	require.NotNil(t, err)
+
+	content, err := ioutil.ReadFile(secretAuditLogFile)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "password1")
+
+	events := readAuditEvents(t, secretAuditLogFile)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, "handle1", events[0].Handle)
+	assert.Equal(t, "test", events[0].Origin)
+	assert.Equal(t, "some_command", events[0].Backend)
+	assert.False(t, events[0].Cached)
+	assert.True(t, events[0].Success)
+	assert.Empty(t, events[0].Error)
+	assert.NotEmpty(t, events[0].Timestamp)
+
+	assert.Equal(t, "handle2", events[1].Handle)
+	assert.False(t, events[1].Success)
+	assert.Equal(t, "an error occurred while decrypting 'handle2': some error", events[1].Error)
+}
+
+func TestAuditDecryptFromCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	secretBackendCommand = "some_command"
+	secretAuditLogFile = filepath.Join(dir, "audit.log")
+	defer func() {
+		secretBackendCommand = ""
+		secretAuditLogFile = ""
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+	}()
+
+	secretCache["pass1"] = "password1"
+	secretCache["pass2"] = "password2"
+	secretOrigin["pass1"] = common.NewStringSet("previous_test")
+	secretOrigin["pass2"] = common.NewStringSet("previous_test")
+
+	_, err = Decrypt(testConf, "test")
+	require.NoError(t, err)
+
+	events := readAuditEvents(t, secretAuditLogFile)
+	require.Len(t, events, 2)
+	for _, event := range events {
+		assert.Equal(t, "test", event.Origin)
+		assert.True(t, event.Cached)
+		assert.True(t, event.Success)
+	}
+}
@@ -0,0 +1,42 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets
+
+package secrets
+
+import (
+	"fmt"
+)
+
+// rightsError is returned by checkRights, on every platform, when the secret_backend_command could be modified or
+// run by someone else than the agent user. Its reason names the offending mode bits on Unix, and the offending ACE on
+// Windows.
+type rightsError struct {
+	path   string
+	reason string
+}
+
+func newRightsError(path string, format string, args ...interface{}) error {
+	return &rightsError{
+		path:   path,
+		reason: fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *rightsError) Error() string {
+	return fmt.Sprintf("invalid executable '%s': %s", e.path, e.reason)
+}
+
+func (info *SecretInfo) populateRights() {
+	err := checkRights(info.ExecutablePath)
+	if err != nil {
+		info.Rights = fmt.Sprintf("Error: %s", err)
+	} else {
+		info.Rights = fmt.Sprintf("OK, the executable has the correct rights")
+	}
+
+	info.populateRightDetails()
+}
@@ -10,23 +10,48 @@ package secrets
 import (
 	"fmt"
 	"os/user"
+	"strings"
 	"syscall"
 )
 
+// modeBits names the group and others permission bits that aren't allowed on the secret_backend_command
+var modeBits = []struct {
+	bit  uint32
+	name string
+}{
+	{0040, "group read"},
+	{0020, "group write"},
+	{0010, "group execute"},
+	{0004, "others read"},
+	{0002, "others write"},
+	{0001, "others execute"},
+}
+
+// describeModeBits returns the names of the group and others permission bits set in mode
+func describeModeBits(mode uint32) string {
+	names := []string{}
+	for _, b := range modeBits {
+		if mode&b.bit != 0 {
+			names = append(names, b.name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
 func checkRights(path string) error {
 	var stat syscall.Stat_t
 	if err := syscall.Stat(path, &stat); err != nil {
-		return fmt.Errorf("invalid executable '%s': can't stat it: %s", path, err)
+		return newRightsError(path, "can't stat it: %s", err)
 	}
 
 	// checking that group and others don't have any rights
-	if stat.Mode&(syscall.S_IRWXG|syscall.S_IRWXO) != 0 {
-		return fmt.Errorf("invalid executable '%s', 'groups' or 'others' have rights on it", path)
+	if mode := uint32(stat.Mode); mode&(syscall.S_IRWXG|syscall.S_IRWXO) != 0 {
+		return newRightsError(path, "'groups' or 'others' have rights on it: %s (mode %04o)", describeModeBits(mode), mode&07777)
 	}
 
 	// checking that the owner have exec rights
 	if stat.Mode&syscall.S_IXUSR == 0 {
-		return fmt.Errorf("invalid executable: '%s' is not executable", path)
+		return newRightsError(path, "it is not executable, owner execute bit is not set (mode %04o)", uint32(stat.Mode)&07777)
 	}
 
 	// checking that we own the executable
@@ -39,8 +64,21 @@ func checkRights(path string) error {
 	// to execute it if not, but it gives a better error message to the
 	// user.
 	if fmt.Sprintf("%d", stat.Uid) != usr.Uid {
-		return fmt.Errorf("invalid executable: '%s' isn't owned by the user running the agent: name '%s', UID %s. We can't execute it", path, usr.Username, usr.Uid)
+		return newRightsError(path, "it isn't owned by the user running the agent: name '%s', UID %s, it's owned by UID %d. We can't execute it", usr.Username, usr.Uid, stat.Uid)
 	}
 
 	return nil
 }
+
+// checkFileRights checks that others don't have any rights on a file read by the file secret backend
+func checkFileRights(path string) error {
+	var stat syscall.Stat_t
+	if err := syscall.Stat(path, &stat); err != nil {
+		return fmt.Errorf("invalid secret file '%s': can't stat it: %s", path, err)
+	}
+
+	if stat.Mode&syscall.S_IRWXO != 0 {
+		return fmt.Errorf("invalid secret file '%s', 'others' have rights on it", path)
+	}
+	return nil
+}
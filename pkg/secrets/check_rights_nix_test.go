@@ -12,6 +12,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -50,3 +51,19 @@ func TestGroupOtherRights(t *testing.T) {
 	require.Nil(t, os.Chmod(tmpfile.Name(), 0701))
 	require.NotNil(t, checkRights(tmpfile.Name()))
 }
+
+func TestCheckRightsErrorMessages(t *testing.T) {
+	tmpfile, err := ioutil.TempFile("", "agent-collector-test")
+	require.Nil(t, err)
+	defer os.Remove(tmpfile.Name())
+
+	require.Nil(t, os.Chmod(tmpfile.Name(), 0752))
+	err = checkRights(tmpfile.Name())
+	require.IsType(t, &rightsError{}, err)
+	assert.Equal(t, "invalid executable '"+tmpfile.Name()+"': 'groups' or 'others' have rights on it: group read, group execute, others write (mode 0752)", err.Error())
+
+	require.Nil(t, os.Chmod(tmpfile.Name(), 0600))
+	err = checkRights(tmpfile.Name())
+	require.IsType(t, &rightsError{}, err)
+	assert.Equal(t, "invalid executable '"+tmpfile.Name()+"': it is not executable, owner execute bit is not set (mode 0600)", err.Error())
+}
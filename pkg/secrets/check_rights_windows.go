@@ -22,12 +22,21 @@ var (
 	username = "ddagentuser"
 )
 
+// sidString returns the string representation of a SID for error messages
+func sidString(sid *windows.SID) string {
+	str, err := (*syscall.SID)(unsafe.Pointer(sid)).String()
+	if err != nil {
+		return "unknown SID"
+	}
+	return str
+}
+
 // checkRights check that the given filename has access controls set only for
 // Administrator, Local System and the datadog user.
 func checkRights(filename string) error {
 	if _, err := os.Stat(filename); err != nil {
 		if os.IsNotExist(err) {
-			return fmt.Errorf("secretBackendCommand %s does not exist", filename)
+			return newRightsError(filename, "it does not exist")
 		}
 	}
 
@@ -136,13 +145,13 @@ func checkRights(filename string) error {
 			// if we're denying access to local system or administrators,
 			// it's wrong. Otherwise, any explicit access denied is OK
 			if compareIsLocalSystem || compareIsAdministrators || compareIsSecretUser {
-				return fmt.Errorf("Invalid executable '%s': Can't deny access LOCAL_SYSTEM, Administrators or %s", filename, username)
+				return newRightsError(filename, "ACE %d denies access to %s: can't deny access to LOCAL_SYSTEM, Administrators or %s", i, sidString(compareSid), username)
 			}
 			// otherwise, it's fine; deny access to whomever
 		}
 		if pAce.AceType == winutil.ACCESS_ALLOWED_ACE_TYPE {
 			if !(compareIsLocalSystem || compareIsAdministrators || compareIsSecretUser) {
-				return fmt.Errorf("Invalid executable '%s': other users/groups than LOCAL_SYSTEM, Administrators or %s have rights on it", filename, username)
+				return newRightsError(filename, "ACE %d allows access to %s: other users/groups than LOCAL_SYSTEM, Administrators or %s have rights on it", i, sidString(compareSid), username)
 			}
 			if compareIsSecretUser {
 				bSecretUserExplicitlyAllowed = true
@@ -151,7 +160,13 @@ func checkRights(filename string) error {
 	}
 	if !bSecretUserExplicitlyAllowed {
 		// there was never an ACE explicitly allowing the secret user, so we can't use it
-		return fmt.Errorf("'%s' user is not allowed to execute secretBackendCommand '%s'", username, filename)
+		return newRightsError(filename, "no ACE allows the '%s' user to execute it", username)
 	}
 	return nil
 }
+
+// checkFileRights checks that a file read by the file secret backend has the same access controls as the
+// secret_backend_command: only Administrator, Local System and the datadog user have rights on it.
+func checkFileRights(path string) error {
+	return checkRights(path)
+}
@@ -9,13 +9,14 @@ package secrets
 
 import (
 	"bytes"
-	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/telemetry"
@@ -28,6 +29,12 @@ const PayloadVersion = "1.0"
 
 var (
 	tlmSecretBackendElapsed = telemetry.NewGauge("secret_backend", "elapsed_ms", []string{"command", "exit_code"}, "Elapsed time of secret backend invocation")
+	tlmSecretFetchDuration  = telemetry.NewHistogram("secret_backend", "fetch_duration_seconds", []string{"backend"},
+		"Distribution of the time taken to fetch secrets from the secret backend", []float64{.01, .05, .1, .5, 1, 5, 10, 30})
+	tlmSecretFetchSuccess = telemetry.NewCounter("secret_backend", "fetch_success", []string{"backend"}, "Number of successful secret backend fetches")
+	tlmSecretFetchErrors  = telemetry.NewCounter("secret_backend", "fetch_errors", []string{"backend"}, "Number of failed secret backend fetches")
+	tlmSecretCacheHits    = telemetry.NewCounter("secret_backend", "cache_hits", nil, "Number of secret handles resolved from the cache")
+	tlmSecretCacheMisses  = telemetry.NewCounter("secret_backend", "cache_misses", nil, "Number of secret handles missing or expired from the cache")
 )
 
 type limitBuffer struct {
@@ -42,17 +49,18 @@ func (b *limitBuffer) Write(p []byte) (n int, err error) {
 	return b.buf.Write(p)
 }
 
-func execCommand(inputPayload string) ([]byte, error) {
-	ctx, cancel := context.WithTimeout(context.Background(),
-		time.Duration(secretBackendTimeout)*time.Second)
-	defer cancel()
+// terminateGracePeriod is the time left to the secret backend command to exit once it was asked to terminate,
+// before its process group is killed
+var terminateGracePeriod = 2 * time.Second
 
-	cmd := exec.CommandContext(ctx, secretBackendCommand, secretBackendArguments...)
+func execCommand(inputPayload string) ([]byte, error) {
+	cmd := exec.Command(secretBackendCommand, secretBackendArguments...)
 	if err := checkRights(cmd.Path); err != nil {
 		return nil, err
 	}
 
 	cmd.Stdin = strings.NewReader(inputPayload)
+	setProcessGroup(cmd)
 
 	stdout := limitBuffer{
 		buf: &bytes.Buffer{},
@@ -66,10 +74,18 @@ func execCommand(inputPayload string) ([]byte, error) {
 	cmd.Stderr = &stderr
 
 	start := time.Now()
-	err := cmd.Run()
+	timeout := time.Duration(secretBackendTimeout) * time.Second
+	timedOut, err := runWithTimeout(cmd, timeout)
 	elapsed := time.Since(start)
 	log.Debugf("secret_backend_command '%s' completed in %s", secretBackendCommand, elapsed)
 
+	// a backend handling sigterm may exit cleanly once terminated, its output is incomplete all the same
+	if timedOut {
+		log.Errorf("secret_backend_command stderr: %s", stderr.buf.String())
+		tlmSecretBackendElapsed.Add(float64(elapsed.Milliseconds()), secretBackendCommand, "timeout")
+		return nil, fmt.Errorf("error while running '%s': command timeout after %s", secretBackendCommand, timeout)
+	}
+
 	if err != nil {
 		log.Errorf("secret_backend_command stderr: %s", stderr.buf.String())
 
@@ -77,33 +93,173 @@ func execCommand(inputPayload string) ([]byte, error) {
 		var e *exec.ExitError
 		if errors.As(err, &e) {
 			exitCode = strconv.Itoa(e.ExitCode())
-		} else if ctx.Err() == context.DeadlineExceeded {
-			exitCode = "timeout"
 		}
 		tlmSecretBackendElapsed.Add(float64(elapsed.Milliseconds()), secretBackendCommand, exitCode)
-
-		if ctx.Err() == context.DeadlineExceeded {
-			return nil, fmt.Errorf("error while running '%s': command timeout", secretBackendCommand)
-		}
-		return nil, fmt.Errorf("error while running '%s': %s", secretBackendCommand, err)
+		return nil, fmt.Errorf("error while running '%s': %w", secretBackendCommand, err)
 	}
 	tlmSecretBackendElapsed.Add(float64(elapsed.Milliseconds()), secretBackendCommand, "0")
 	return stdout.buf.Bytes(), nil
 }
 
+// runWithTimeout runs the command and terminates its process group if it didn't complete within the given
+// timeout. It returns whether the command timed out, along with the error returned by the command.
+func runWithTimeout(cmd *exec.Cmd, timeout time.Duration) (bool, error) {
+	if err := cmd.Start(); err != nil {
+		return false, err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		return false, err
+	case <-time.After(timeout):
+	}
+
+	if _, err := terminateProcess(cmd); err != nil {
+		log.Warnf("failed to terminate secret_backend_command '%s': %s", secretBackendCommand, err)
+	}
+
+	select {
+	case err := <-done:
+		return true, err
+	case <-time.After(terminateGracePeriod):
+	}
+
+	log.Warnf("secret_backend_command '%s' did not exit within %s, killing it", secretBackendCommand, terminateGracePeriod)
+	if err := killProcess(cmd); err != nil {
+		log.Warnf("failed to kill secret_backend_command '%s': %s", secretBackendCommand, err)
+	}
+
+	// don't block forever on a process that can't be reaped, its output is discarded anyway
+	select {
+	case err := <-done:
+		return true, err
+	case <-time.After(terminateGracePeriod):
+		return true, fmt.Errorf("process did not exit after being killed")
+	}
+}
+
 // Secret defines the structure for secrets in JSON output
 type Secret struct {
 	Value    string `json:"value,omitempty"`
 	ErrorMsg string `json:"error,omitempty"`
+	// Decode overrides secret_backend_decode for this secret
+	Decode string `json:"decode,omitempty"`
 }
 
 // for testing purpose
-var runCommand = execCommand
+var (
+	runCommand = execCommand
+	retrySleep = time.Sleep
+)
 
-// fetchSecret receives a list of secrets name to fetch, exec a custom
-// executable to fetch the actual secrets and returns them. Origin should be
-// the name of the configuration where the secret was referenced.
-func fetchSecret(secretsHandle []string, origin string) (map[string]string, error) {
+// isTransientError returns whether an error of the secret_backend_command is worth retrying: a non-zero exit code
+// without a structured per-handle error
+func isTransientError(err error) bool {
+	var e *exec.ExitError
+	return errors.As(err, &e)
+}
+
+// runCommandWithRetries runs the secret_backend_command, retrying up to secretBackendRetries times with an
+// exponential backoff when it fails with a transient error
+func runCommandWithRetries(inputPayload string) ([]byte, error) {
+	delay := secretBackendRetryBaseDelay
+	for attempt := 1; ; attempt++ {
+		output, err := runCommand(inputPayload)
+		if err == nil || attempt > secretBackendRetries || !isTransientError(err) {
+			return output, err
+		}
+
+		log.Debugf("secret_backend_command failed, retrying in %s (attempt %d/%d): %s", delay, attempt, secretBackendRetries, err)
+		retrySleep(delay)
+		delay *= 2
+	}
+}
+
+// runBackend fetches a list of secrets from the secret backend and reports the duration
+// and the outcome of the fetch in the telemetry
+func runBackend(secretsHandle []string) (map[string]Secret, error) {
+	start := time.Now()
+	secrets, err := fetchFromBackend(secretsHandle)
+	tlmSecretFetchDuration.Observe(time.Since(start).Seconds(), backendName())
+	if err != nil {
+		tlmSecretFetchErrors.Inc(backendName())
+	} else {
+		tlmSecretFetchSuccess.Inc(backendName())
+	}
+	return secrets, err
+}
+
+// fetchFromBackend sends a list of secrets name to the secret_backend_command and returns its
+// parsed output. The handles are split between up to secret_backend_max_concurrency
+// invocations of the command running in parallel. The file backend reads them instead, and the
+// socket backend sends them to secret_backend_socket.
+func fetchFromBackend(secretsHandle []string) (map[string]Secret, error) {
+	if secretBackendType == fileBackendType {
+		return readSecretFiles(secretsHandle), nil
+	}
+
+	if secretBackendType != socketBackendType {
+		if err := checkArguments(secretsHandle); err != nil {
+			return nil, err
+		}
+	}
+
+	batches := splitHandles(secretsHandle, secretBackendMaxConcurrency)
+	if len(batches) <= 1 {
+		return runBackendBatch(secretsHandle)
+	}
+
+	results := make([]map[string]Secret, len(batches))
+	errs := make([]error, len(batches))
+	var wg sync.WaitGroup
+	for idx, batch := range batches {
+		wg.Add(1)
+		go func(idx int, batch []string) {
+			defer wg.Done()
+			results[idx], errs[idx] = runBackendBatch(batch)
+		}(idx, batch)
+	}
+	wg.Wait()
+
+	// results are merged in the order of the batches so that the outcome doesn't depend on
+	// which invocation completed first
+	secrets := map[string]Secret{}
+	for idx := range batches {
+		if errs[idx] != nil {
+			return nil, errs[idx]
+		}
+		for handle, secret := range results[idx] {
+			secrets[handle] = secret
+		}
+	}
+	return secrets, nil
+}
+
+// splitHandles splits the handles into at most maxBatches contiguous batches of similar size
+func splitHandles(secretsHandle []string, maxBatches int) [][]string {
+	count := maxBatches
+	if count > len(secretsHandle) {
+		count = len(secretsHandle)
+	}
+	if count <= 1 {
+		return [][]string{secretsHandle}
+	}
+
+	batches := make([][]string, 0, count)
+	for idx := 0; idx < count; idx++ {
+		batches = append(batches, secretsHandle[idx*len(secretsHandle)/count:(idx+1)*len(secretsHandle)/count])
+	}
+	return batches
+}
+
+// runBackendBatch sends a list of secrets name to a single invocation of the
+// secret_backend_command and returns its parsed output
+func runBackendBatch(secretsHandle []string) (map[string]Secret, error) {
 	payload := map[string]interface{}{
 		"version": PayloadVersion,
 		"secrets": secretsHandle,
@@ -112,37 +268,149 @@ func fetchSecret(secretsHandle []string, origin string) (map[string]string, erro
 	if err != nil {
 		return nil, fmt.Errorf("could not serialize secrets IDs to fetch password: %s", err)
 	}
-	log.Debugf("calling secret_backend_command with payload: '%s'", jsonPayload)
-	output, err := runCommand(string(jsonPayload))
+
+	var output []byte
+	if secretBackendType == socketBackendType {
+		log.Debugf("calling secret_backend_socket with payload: '%s'", jsonPayload)
+		output, err = runSocket(jsonPayload)
+	} else {
+		log.Debugf("calling secret_backend_command with payload: '%s'", jsonPayload)
+		output, err = runCommandWithRetries(string(jsonPayload))
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	secrets := map[string]Secret{}
-	err = json.Unmarshal(output, &secrets)
+	return parseBackendOutput(output)
+}
+
+// parseBackendOutput validates that the secret_backend_command output is a JSON object mapping each handle to a
+// Secret, rejecting any other shape
+func parseBackendOutput(output []byte) (map[string]Secret, error) {
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	decoder.DisallowUnknownFields()
+
+	parsed := map[string]*Secret{}
+	if err := decoder.Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("could not unmarshal 'secret_backend_command' output, expected "+
+			"'{\"<handle>\": {\"value\": \"<value>\", \"error\": \"<error>\"}}': %s", err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("could not unmarshal 'secret_backend_command' output: unexpected data after the JSON object")
+	}
+
+	secrets := make(map[string]Secret, len(parsed))
+	for handle, secret := range parsed {
+		if secret == nil {
+			return nil, fmt.Errorf("invalid 'secret_backend_command' output for '%s': expected an object with a 'value' or an 'error'", handle)
+		}
+		if secret.Value != "" && secret.ErrorMsg != "" {
+			return nil, fmt.Errorf("invalid 'secret_backend_command' output for '%s': 'value' and 'error' are both set", handle)
+		}
+		secrets[handle] = *secret
+	}
+	return secrets, nil
+}
+
+// checkArguments checks that the secret_backend_arguments don't contain any of the handles to fetch, handles are only
+// sent to the secret_backend_command on its stdin so that they don't show up in the process list
+func checkArguments(secretsHandle []string) error {
+	for _, arg := range secretBackendArguments {
+		for _, handle := range secretsHandle {
+			if strings.Contains(arg, handle) {
+				return fmt.Errorf("secret_backend_arguments must not contain the secret handle '%s', handles are sent to the secret_backend_command on its stdin", handle)
+			}
+		}
+	}
+	return nil
+}
+
+// checkSecret returns the value of a handle in the secret_backend_command output
+func checkSecret(secrets map[string]Secret, handle string) (string, error) {
+	v, ok := secrets[handle]
+	if ok == false {
+		return "", fmt.Errorf("secret handle '%s' was not decrypted by the secret_backend_command", handle)
+	}
+
+	if v.ErrorMsg != "" {
+		return "", fmt.Errorf("an error occurred while decrypting '%s': %s", handle, v.ErrorMsg)
+	}
+	if v.Value == "" {
+		return "", fmt.Errorf("decrypted secret for '%s' is empty", handle)
+	}
+	return decodeSecret(handle, v)
+}
+
+// decodeSecret decodes the value returned by the secret_backend_command with the decoding of the secret, or
+// secret_backend_decode if it doesn't have any
+func decodeSecret(handle string, secret Secret) (string, error) {
+	decode := secret.Decode
+	if decode == "" {
+		decode = secretBackendDecode
+	}
+
+	switch decode {
+	case "":
+		return secret.Value, nil
+	case "base64":
+		value, err := base64.StdEncoding.DecodeString(secret.Value)
+		if err != nil {
+			return "", fmt.Errorf("could not base64 decode the secret '%s': %s", handle, err)
+		}
+		return string(value), nil
+	default:
+		return "", fmt.Errorf("unknown decoding '%s' for the secret '%s', only 'base64' is supported", decode, handle)
+	}
+}
+
+// fetchSecret receives a list of secrets name to fetch, exec a custom
+// executable to fetch the actual secrets and returns them. Origin should be
+// the name of the configuration where the secret was referenced. When some
+// handles can't be fetched, the other ones are still cached and returned
+// along with an error listing the failed handles.
+func fetchSecret(secretsHandle []string, origin string) (map[string]string, error) {
+	secrets, err := runBackend(secretsHandle)
 	if err != nil {
-		return nil, fmt.Errorf("could not unmarshal 'secret_backend_command' output: %s", err)
+		auditSecrets(secretsHandle, origin, err)
+		return nil, err
 	}
 
+	secretLock.Lock()
+	defer secretLock.Unlock()
+
 	res := map[string]string{}
+	failures := []error{}
 	for _, sec := range secretsHandle {
-		v, ok := secrets[sec]
-		if ok == false {
-			return nil, fmt.Errorf("secret handle '%s' was not decrypted by the secret_backend_command", sec)
+		value, err := checkSecret(secrets, sec)
+		auditSecret(sec, origin, false, err)
+		if err != nil {
+			// the other handles are still cached, so that only the configurations referencing this one fail
+			failures = append(failures, err)
+			continue
 		}
 
-		if v.ErrorMsg != "" {
-			return nil, fmt.Errorf("an error occurred while decrypting '%s': %s", sec, v.ErrorMsg)
-		}
-		if v.Value == "" {
-			return nil, fmt.Errorf("decrypted secret for '%s' is empty", sec)
+		// add it to the cache and make sure it never shows up in the logs or flares
+		secretCache[sec] = value
+		log.AddSecretValues(value)
+		secretFetchTime[sec] = time.Now()
+		// keep track of place where a handle was found
+		if _, ok := secretOrigin[sec]; !ok {
+			secretOrigin[sec] = common.NewStringSet()
 		}
+		secretOrigin[sec].Add(origin)
+		res[sec] = value
+	}
 
-		// add it to the cache
-		secretCache[sec] = v.Value
-		// keep track of place where a handle was found
-		secretOrigin[sec] = common.NewStringSet(origin)
-		res[sec] = v.Value
+	switch len(failures) {
+	case 0:
+		return res, nil
+	case 1:
+		return res, failures[0]
+	default:
+		msgs := make([]string, 0, len(failures))
+		for _, err := range failures {
+			msgs = append(msgs, err.Error())
+		}
+		return res, fmt.Errorf("%d of %d secrets could not be fetched: %s", len(failures), len(secretsHandle), strings.Join(msgs, "; "))
 	}
-	return res, nil
 }
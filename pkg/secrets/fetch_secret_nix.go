@@ -0,0 +1,30 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets,!windows
+
+package secrets
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup runs the secret backend command in its own process group, so that the processes it spawned are
+// terminated with it
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// terminateProcess sends sigterm to the process group of the secret backend command. It returns whether the command
+// was asked to terminate, it always is.
+func terminateProcess(cmd *exec.Cmd) (bool, error) {
+	return true, syscall.Kill(-cmd.Process.Pid, syscall.SIGTERM)
+}
+
+// killProcess kills the process group of the secret backend command
+func killProcess(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
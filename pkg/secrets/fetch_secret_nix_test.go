@@ -0,0 +1,46 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets,!windows
+
+package secrets
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The CTRL_BREAK_EVENT sent on Windows needs a console, without one the command is killed right away, so the
+// termination is only tested on the other platforms.
+func TestExecCommandTimeoutTerminates(t *testing.T) {
+	defer func() {
+		secretBackendCommand = ""
+		secretBackendArguments = []string{}
+		secretBackendTimeout = 0
+	}()
+
+	dir, err := ioutil.TempDir("", "secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	signalFile := filepath.Join(dir, "signal")
+
+	secretBackendCommand = "./test/sigterm/sigterm"
+	setCorrectRight(secretBackendCommand)
+	secretBackendArguments = []string{signalFile}
+	secretBackendTimeout = 1
+
+	_, err = execCommand("{}")
+	require.EqualError(t, err, "error while running './test/sigterm/sigterm': command timeout after 1s")
+
+	// the backend only exits once it was asked to terminate
+	signal, err := ioutil.ReadFile(signalFile)
+	require.NoError(t, err)
+	require.Equal(t, syscall.SIGTERM.String(), string(signal))
+}
@@ -9,16 +9,21 @@ package secrets
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"runtime"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/DataDog/datadog-agent/pkg/util/common"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
 var (
@@ -42,8 +47,10 @@ func TestMain(m *testing.M) {
 	// tool we're sure to have on Windows, OSX and Linux.
 	build(m, "./test/argument/argument", "./test/argument")
 	build(m, "./test/error/error", "./test/error")
+	build(m, "./test/ignore_sigterm/ignore_sigterm", "./test/ignore_sigterm")
 	build(m, "./test/input/input", "./test/input")
 	build(m, "./test/response_too_long/response_too_long", "./test/response_too_long")
+	build(m, "./test/sigterm/sigterm", "./test/sigterm")
 	build(m, "./test/simple/simple", "./test/simple")
 	build(m, "./test/timeout/timeout", "./test/timeout")
 
@@ -51,14 +58,36 @@ func TestMain(m *testing.M) {
 
 	os.Remove("test/argument/argument" + binExtension)
 	os.Remove("test/error/error" + binExtension)
+	os.Remove("test/ignore_sigterm/ignore_sigterm" + binExtension)
 	os.Remove("test/input/input" + binExtension)
 	os.Remove("test/response_too_long/response_too_long" + binExtension)
+	os.Remove("test/sigterm/sigterm" + binExtension)
 	os.Remove("test/simple/simple" + binExtension)
 	os.Remove("test/timeout/timeout" + binExtension)
 
 	os.Exit(res)
 }
 
+func TestExecCommandTimeoutKillsAfterGracePeriod(t *testing.T) {
+	defer func() {
+		secretBackendCommand = ""
+		secretBackendArguments = []string{}
+		secretBackendTimeout = 0
+		terminateGracePeriod = 2 * time.Second
+	}()
+
+	secretBackendCommand = "./test/ignore_sigterm/ignore_sigterm"
+	setCorrectRight(secretBackendCommand)
+	secretBackendTimeout = 1
+	terminateGracePeriod = 500 * time.Millisecond
+
+	start := time.Now()
+	_, err := execCommand("{}")
+	require.EqualError(t, err, "error while running './test/ignore_sigterm/ignore_sigterm': command timeout after 1s")
+	// the backend ignores the request to terminate, it's only stopped by the kill sent after the grace period
+	require.True(t, time.Since(start) < 5*time.Second)
+}
+
 func TestLimitBuffer(t *testing.T) {
 	lb := limitBuffer{
 		buf: &bytes.Buffer{},
@@ -86,6 +115,7 @@ func TestExecCommandError(t *testing.T) {
 		secretBackendCommand = ""
 		secretBackendArguments = []string{}
 		secretBackendTimeout = 0
+		SecretBackendOutputMaxSize = 1024 * 1024
 	}()
 
 	inputPayload := "{\"version\": \"" + PayloadVersion + "\" , \"secrets\": [\"sec1\", \"sec2\"]}"
@@ -101,7 +131,7 @@ func TestExecCommandError(t *testing.T) {
 	secretBackendTimeout = 2
 	_, err = execCommand(inputPayload)
 	require.NotNil(t, err)
-	require.Equal(t, "error while running './test/timeout/timeout"+binExtension+"': command timeout", err.Error())
+	require.Equal(t, "error while running './test/timeout/timeout"+binExtension+"': command timeout after 2s", err.Error())
 
 	// test simple (no error)
 	secretBackendCommand = "./test/simple/simple" + binExtension
@@ -165,6 +195,27 @@ func TestFetchSecretUnmarshalError(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestFetchSecretInvalidSchema(t *testing.T) {
+	defer func() {
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+	}()
+
+	for _, output := range []string{
+		"[\"p1\"]",
+		"{\"handle1\":\"p1\"}",
+		"{\"handle1\":null}",
+		"{\"handle1\":{\"value\":\"p1\",\"unknown\":\"field\"}}",
+		"{\"handle1\":{\"value\":\"p1\",\"error\":\"some error\"}}",
+		"{\"handle1\":{\"value\":\"p1\"}} {}",
+	} {
+		runCommand = func(string) ([]byte, error) { return []byte(output), nil }
+		_, err := fetchSecret([]string{"handle1"}, "test")
+		assert.NotNil(t, err, output)
+		assert.Empty(t, secretCache, output)
+	}
+}
+
 func TestFetchSecretMissingSecret(t *testing.T) {
 	defer func() {
 		secretCache = map[string]string{}
@@ -176,7 +227,8 @@ func TestFetchSecretMissingSecret(t *testing.T) {
 	runCommand = func(string) ([]byte, error) { return []byte("{}"), nil }
 	_, err := fetchSecret(secrets, "test")
 	assert.NotNil(t, err)
-	assert.Equal(t, "secret handle 'handle1' was not decrypted by the secret_backend_command", err.Error())
+	assert.Equal(t, "2 of 2 secrets could not be fetched: secret handle 'handle1' was not decrypted by the secret_backend_command; "+
+		"secret handle 'handle2' was not decrypted by the secret_backend_command", err.Error())
 }
 
 func TestFetchSecretErrorForHandle(t *testing.T) {
@@ -243,3 +295,280 @@ func TestFetchSecret(t *testing.T) {
 	}, secretCache)
 	assert.Equal(t, map[string]common.StringSet{"handle1": common.NewStringSet("test"), "handle2": common.NewStringSet("test")}, secretOrigin)
 }
+
+func TestFetchSecretScrubsValues(t *testing.T) {
+	defer func() {
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+		runCommand = execCommand
+	}()
+
+	runCommand = func(string) ([]byte, error) {
+		return []byte("{\"handle1\":{\"value\":\"scrubbed_password\"}}"), nil
+	}
+	_, err := fetchSecret([]string{"handle1"}, "test")
+	require.NoError(t, err)
+
+	scrubbed, err := log.CredentialsCleanerBytes([]byte("dsn: host=localhost auth=scrubbed_password"))
+	require.NoError(t, err)
+	assert.Equal(t, "dsn: host=localhost auth=********", string(scrubbed))
+}
+
+func TestFetchSecretDecode(t *testing.T) {
+	defer func() {
+		secretBackendDecode = ""
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+		runCommand = execCommand
+	}()
+
+	// "cDE=" is "p1" base64 encoded
+	runCommand = func(string) ([]byte, error) {
+		return []byte("{\"handle1\":{\"value\":\"cDE=\",\"decode\":\"base64\"},\"handle2\":{\"value\":\"cDE=\"}}"), nil
+	}
+	resp, err := fetchSecret([]string{"handle1", "handle2"}, "test")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"handle1": "p1", "handle2": "cDE="}, resp)
+
+	secretBackendDecode = "base64"
+	resp, err = fetchSecret([]string{"handle1", "handle2"}, "test")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"handle1": "p1", "handle2": "p1"}, resp)
+}
+
+func TestFetchSecretDecodeError(t *testing.T) {
+	defer func() {
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+		runCommand = execCommand
+	}()
+
+	runCommand = func(string) ([]byte, error) {
+		return []byte("{\"handle1\":{\"value\":\"not base64!\",\"decode\":\"base64\"}}"), nil
+	}
+	_, err := fetchSecret([]string{"handle1"}, "test")
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "could not base64 decode the secret 'handle1'")
+
+	runCommand = func(string) ([]byte, error) {
+		return []byte("{\"handle1\":{\"value\":\"p1\",\"decode\":\"hex\"}}"), nil
+	}
+	_, err = fetchSecret([]string{"handle1"}, "test")
+	require.EqualError(t, err, "unknown decoding 'hex' for the secret 'handle1', only 'base64' is supported")
+}
+
+func TestFetchSecretHandleInArguments(t *testing.T) {
+	defer func() {
+		secretBackendArguments = []string{}
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+		runCommand = execCommand
+	}()
+
+	runCommand = func(string) ([]byte, error) {
+		return []byte("{\"handle1\":{\"value\":\"p1\"}}"), nil
+	}
+
+	secretBackendArguments = []string{"--env", "prod"}
+	_, err := fetchSecret([]string{"handle1"}, "test")
+	require.NoError(t, err)
+
+	secretBackendArguments = []string{"--env", "prod", "--secret=handle1"}
+	_, err = fetchSecret([]string{"handle1"}, "test")
+	require.EqualError(t, err, "secret_backend_arguments must not contain the secret handle 'handle1', handles are sent to the secret_backend_command on its stdin")
+}
+
+func TestFetchSecretPartialFailure(t *testing.T) {
+	defer func() {
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+		runCommand = execCommand
+	}()
+
+	runCommand = func(string) ([]byte, error) {
+		return []byte("{\"handle1\":{\"value\":\"p1\"},\"handle2\":{\"error\":\"some error\"},\"handle3\":{\"value\":\"p3\"}}"), nil
+	}
+	resp, err := fetchSecret([]string{"handle1", "handle2", "handle3"}, "test")
+	require.EqualError(t, err, "an error occurred while decrypting 'handle2': some error")
+	assert.Equal(t, map[string]string{"handle1": "p1", "handle3": "p3"}, resp)
+	assert.Equal(t, map[string]string{"handle1": "p1", "handle3": "p3"}, secretCache)
+}
+
+func TestSplitHandles(t *testing.T) {
+	handles := []string{"handle1", "handle2", "handle3", "handle4", "handle5"}
+
+	assert.Equal(t, [][]string{handles}, splitHandles(handles, 0))
+	assert.Equal(t, [][]string{handles}, splitHandles(handles, 1))
+	assert.Equal(t, [][]string{{"handle1", "handle2"}, {"handle3", "handle4", "handle5"}}, splitHandles(handles, 2))
+	assert.Equal(t, [][]string{{"handle1"}, {"handle2"}, {"handle3"}, {"handle4"}, {"handle5"}}, splitHandles(handles, 10))
+}
+
+// fakeSequentialBackend answers every handle of the payload after waiting delay for each of them
+func fakeSequentialBackend(t testing.TB, delay time.Duration) func(string) ([]byte, error) {
+	return func(payload string) ([]byte, error) {
+		var input struct {
+			Secrets []string `json:"secrets"`
+		}
+		require.Nil(t, json.Unmarshal([]byte(payload), &input))
+
+		output := map[string]Secret{}
+		for _, handle := range input.Secrets {
+			time.Sleep(delay)
+			output[handle] = Secret{Value: "value_" + handle}
+		}
+		return json.Marshal(output)
+	}
+}
+
+func TestRunBackendConcurrency(t *testing.T) {
+	defer func() {
+		secretBackendMaxConcurrency = 1
+		runCommand = execCommand
+	}()
+
+	handles := []string{}
+	expected := map[string]Secret{}
+	for idx := 0; idx < 20; idx++ {
+		handle := fmt.Sprintf("handle%d", idx)
+		handles = append(handles, handle)
+		expected[handle] = Secret{Value: "value_" + handle}
+	}
+
+	calls := int32(0)
+	backend := fakeSequentialBackend(t, time.Millisecond)
+	runCommand = func(payload string) ([]byte, error) {
+		atomic.AddInt32(&calls, 1)
+		return backend(payload)
+	}
+
+	secretBackendMaxConcurrency = 4
+	secrets, err := runBackend(handles)
+	require.Nil(t, err)
+	assert.Equal(t, expected, secrets)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+}
+
+func TestRunBackendConcurrencyError(t *testing.T) {
+	defer func() {
+		secretBackendMaxConcurrency = 1
+		runCommand = execCommand
+	}()
+
+	// the error of the first failing batch is returned whatever the completion order
+	runCommand = func(payload string) ([]byte, error) {
+		if strings.Contains(payload, "handle1") {
+			time.Sleep(10 * time.Millisecond)
+			return nil, fmt.Errorf("error for handle1")
+		}
+		return nil, fmt.Errorf("error for handle2")
+	}
+
+	secretBackendMaxConcurrency = 2
+	_, err := runBackend([]string{"handle1", "handle2"})
+	require.NotNil(t, err)
+	assert.Equal(t, "error for handle1", err.Error())
+}
+
+// BenchmarkRunBackend measures fetching 50 secrets from a backend taking 10ms per secret
+func BenchmarkRunBackend(b *testing.B) {
+	defer func() {
+		secretBackendMaxConcurrency = 1
+		runCommand = execCommand
+	}()
+
+	handles := []string{}
+	for idx := 0; idx < 50; idx++ {
+		handles = append(handles, fmt.Sprintf("handle%d", idx))
+	}
+	runCommand = fakeSequentialBackend(b, 10*time.Millisecond)
+
+	for _, concurrency := range []int{1, 4, 10} {
+		b.Run(fmt.Sprintf("concurrency_%d", concurrency), func(b *testing.B) {
+			secretBackendMaxConcurrency = concurrency
+			for n := 0; n < b.N; n++ {
+				if _, err := runBackend(handles); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchSecretRetries(t *testing.T) {
+	secretBackendRetries = 3
+	defer func() {
+		secretBackendRetries = 0
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+		runCommand = execCommand
+		retrySleep = time.Sleep
+	}()
+
+	delays := []time.Duration{}
+	retrySleep = func(d time.Duration) { delays = append(delays, d) }
+
+	calls := 0
+	runCommand = func(string) ([]byte, error) {
+		calls++
+		if calls < 3 {
+			return nil, fmt.Errorf("error while running 'some_command': %w", &exec.ExitError{})
+		}
+		return []byte("{\"handle1\":{\"value\":\"p1\"}}"), nil
+	}
+
+	resp, err := fetchSecret([]string{"handle1"}, "test")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"handle1": "p1"}, resp)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, delays)
+}
+
+func TestFetchSecretRetriesExhausted(t *testing.T) {
+	secretBackendRetries = 2
+	defer func() {
+		secretBackendRetries = 0
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+		runCommand = execCommand
+		retrySleep = time.Sleep
+	}()
+
+	retrySleep = func(time.Duration) {}
+
+	calls := 0
+	runCommand = func(string) ([]byte, error) {
+		calls++
+		return nil, fmt.Errorf("error while running 'some_command': %w", &exec.ExitError{})
+	}
+
+	_, err := fetchSecret([]string{"handle1"}, "test")
+	require.NotNil(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestFetchSecretNoRetry(t *testing.T) {
+	secretBackendRetries = 2
+	defer func() {
+		secretBackendRetries = 0
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+		runCommand = execCommand
+		retrySleep = time.Sleep
+	}()
+
+	retrySleep = func(time.Duration) {
+		require.Fail(t, "the secret_backend_command should not be retried")
+	}
+
+	// an error that isn't a non-zero exit code, like a timeout, isn't retried
+	runCommand = func(string) ([]byte, error) { return nil, fmt.Errorf("command timeout") }
+	_, err := fetchSecret([]string{"handle1"}, "test")
+	require.NotNil(t, err)
+
+	// per-handle errors aren't retried
+	runCommand = func(string) ([]byte, error) {
+		return []byte("{\"handle1\":{\"error\":\"some error\"}}"), nil
+	}
+	_, err = fetchSecret([]string{"handle1"}, "test")
+	require.EqualError(t, err, "an error occurred while decrypting 'handle1': some error")
+}
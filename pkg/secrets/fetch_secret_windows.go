@@ -0,0 +1,39 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets,windows
+
+package secrets
+
+import (
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// setProcessGroup runs the secret backend command in its own process group, so that a console control event can
+// be sent to it without reaching the agent
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// terminateProcess sends a CTRL_BREAK_EVENT to the process group of the secret backend command. The event can only
+// be sent when the agent is attached to a console, the command is killed right away otherwise, e.g. when the agent
+// runs as a service. It returns whether the command was asked to terminate rather than killed.
+func terminateProcess(cmd *exec.Cmd) (bool, error) {
+	if err := windows.GenerateConsoleCtrlEvent(windows.CTRL_BREAK_EVENT, uint32(cmd.Process.Pid)); err != nil {
+		log.Debugf("could not send CTRL_BREAK_EVENT to secret_backend_command '%s', killing it: %s", secretBackendCommand, err)
+		return false, cmd.Process.Kill()
+	}
+	return true, nil
+}
+
+// killProcess kills the secret backend command
+func killProcess(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
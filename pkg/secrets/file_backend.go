@@ -0,0 +1,53 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets
+
+package secrets
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+const (
+	// fileBackendType is the secret_backend_type reading each secret from the file named by its handle
+	fileBackendType = "file"
+	// fileHandlePrefix prefixes the handles resolved by the file backend
+	fileHandlePrefix = "file://"
+)
+
+// readSecretFiles resolves handles of the form 'file:///path/to/secret' with the trimmed content of the file. Errors
+// are reported per handle, like a secret_backend_command would.
+func readSecretFiles(secretsHandle []string) map[string]Secret {
+	secrets := make(map[string]Secret, len(secretsHandle))
+	for _, handle := range secretsHandle {
+		value, err := readSecretFile(handle)
+		if err != nil {
+			secrets[handle] = Secret{ErrorMsg: err.Error()}
+			continue
+		}
+		secrets[handle] = Secret{Value: value}
+	}
+	return secrets
+}
+
+func readSecretFile(handle string) (string, error) {
+	if !strings.HasPrefix(handle, fileHandlePrefix) {
+		return "", fmt.Errorf("handle must be of the form '%s/path/to/secret' with the file secret backend", fileHandlePrefix)
+	}
+	path := strings.TrimPrefix(handle, fileHandlePrefix)
+
+	if err := checkFileRights(path); err != nil {
+		return "", err
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("could not read secret file '%s': %s", path, err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
@@ -0,0 +1,64 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets,!windows
+
+package secrets
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/util/common"
+)
+
+func TestReadSecretFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	valid := filepath.Join(dir, "valid")
+	require.NoError(t, ioutil.WriteFile(valid, []byte("  password1\n"), 0600))
+	worldReadable := filepath.Join(dir, "world_readable")
+	require.NoError(t, ioutil.WriteFile(worldReadable, []byte("password2"), 0600))
+	require.NoError(t, os.Chmod(worldReadable, 0644))
+
+	secrets := readSecretFiles([]string{
+		"file://" + valid,
+		"file://" + worldReadable,
+		"file://" + filepath.Join(dir, "missing"),
+		valid,
+	})
+
+	assert.Equal(t, Secret{Value: "password1"}, secrets["file://"+valid])
+	assert.Equal(t, "invalid secret file '"+worldReadable+"', 'others' have rights on it", secrets["file://"+worldReadable].ErrorMsg)
+	assert.Contains(t, secrets["file://"+filepath.Join(dir, "missing")].ErrorMsg, "can't stat it")
+	assert.Equal(t, "handle must be of the form 'file:///path/to/secret' with the file secret backend", secrets[valid].ErrorMsg)
+}
+
+func TestDecryptFileBackend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "pass1")
+	require.NoError(t, ioutil.WriteFile(path, []byte("password1\n"), 0600))
+
+	secretBackendType = fileBackendType
+	defer func() {
+		secretBackendType = ""
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+	}()
+
+	newConf, err := Decrypt([]byte("password: ENC[file://"+path+"]\n"), "test")
+	require.NoError(t, err)
+	assert.Equal(t, "password: password1\n", string(newConf))
+}
@@ -44,3 +44,24 @@ func (si *SecretInfo) Print(w io.Writer) {
 		fmt.Fprintf(w, "- %s: from %s\n", handle, strings.Join(origins, ", "))
 	}
 }
+
+// SelfCheckResult is the outcome of one aspect of the secret backend self check. A Warning doesn't fail it.
+type SelfCheckResult struct {
+	Name    string
+	Error   string
+	Warning string
+}
+
+// PrintSelfCheck outputs the results of the secret backend self check to a io.Writer
+func PrintSelfCheck(w io.Writer, results []SelfCheckResult) {
+	fmt.Fprintf(w, "=== Checking the secret backend ===\n")
+	for _, result := range results {
+		if result.Error != "" {
+			fmt.Fprintf(w, "[FAIL] %s: %s\n", result.Name, result.Error)
+		} else if result.Warning != "" {
+			fmt.Fprintf(w, "[WARN] %s: %s\n", result.Name, result.Warning)
+		} else {
+			fmt.Fprintf(w, "[PASS] %s\n", result.Name)
+		}
+	}
+}
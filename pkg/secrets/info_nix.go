@@ -14,14 +14,7 @@ import (
 	"syscall"
 )
 
-func (info *SecretInfo) populateRights() {
-	err := checkRights(info.ExecutablePath)
-	if err != nil {
-		info.Rights = fmt.Sprintf("Error: %s", err)
-	} else {
-		info.Rights = fmt.Sprintf("OK, the executable has the correct rights")
-	}
-
+func (info *SecretInfo) populateRightDetails() {
 	var stat syscall.Stat_t
 	if err := syscall.Stat(secretBackendCommand, &stat); err != nil {
 		info.RightDetails = fmt.Sprintf("Could not stat %s: %s", secretBackendCommand, err)
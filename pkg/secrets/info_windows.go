@@ -13,14 +13,7 @@ import (
 	"os/exec"
 )
 
-func (info *SecretInfo) populateRights() {
-	err := checkRights(info.ExecutablePath)
-	if err != nil {
-		info.Rights = fmt.Sprintf("Error: %s", err)
-	} else {
-		info.Rights = fmt.Sprintf("OK, the executable has the correct rights")
-	}
-
+func (info *SecretInfo) populateRightDetails() {
 	ps, err := exec.LookPath("powershell.exe")
 	if err != nil {
 		info.RightDetails = fmt.Sprintf("Could not find executable powershell.exe: %s", err)
@@ -0,0 +1,48 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets
+
+package secrets
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// InvalidateHandle removes a secret handle from the cache, so that it's fetched again from the secret backend the
+// next time a configuration referencing it is decrypted. It returns false if the handle wasn't cached.
+//
+// The fetches in progress complete before the handle is removed. Every invalidated handle costs a call to the secret
+// backend the next time it's used, invalidating secrets too often can overload it.
+func InvalidateHandle(handle string) bool {
+	secretLock.Lock()
+	defer secretLock.Unlock()
+
+	if _, ok := secretCache[handle]; !ok {
+		return false
+	}
+	delete(secretCache, handle)
+	delete(secretFetchTime, handle)
+	log.Infof("Secret '%s' was invalidated, it will be fetched again from the secret backend", handle)
+	return true
+}
+
+// InvalidateAll removes every secret handle from the cache, so that they are fetched again from the secret backend
+// the next time a configuration referencing them is decrypted. It returns the number of handles removed.
+//
+// The fetches in progress complete before the cache is cleared. Every invalidated handle costs a call to the secret
+// backend the next time it's used, invalidating secrets too often can overload it.
+func InvalidateAll() int {
+	secretLock.Lock()
+	defer secretLock.Unlock()
+
+	count := len(secretCache)
+	for handle := range secretCache {
+		delete(secretCache, handle)
+		delete(secretFetchTime, handle)
+	}
+	log.Infof("%d secrets were invalidated, they will be fetched again from the secret backend", count)
+	return count
+}
@@ -17,14 +17,44 @@ import (
 var SecretBackendOutputMaxSize = 1024 * 1024
 
 // Init placeholder when compiled without the 'secrets' build tag
-func Init(command string, arguments []string, timeout int, maxSize int) {}
+func Init(opts Options) {
+}
 
 // Decrypt encrypted secrets are not available on windows
 func Decrypt(data []byte, origin string) ([]byte, error) {
 	return data, nil
 }
 
+// CollectHandles placeholder when compiled without the 'secrets' build tag
+func CollectHandles(data []byte) ([]string, error) {
+	return nil, fmt.Errorf("Secret feature is not available in this version of the agent")
+}
+
+// SelfCheck placeholder when compiled without the 'secrets' build tag
+func SelfCheck() ([]SelfCheckResult, error) {
+	return nil, fmt.Errorf("Secret feature is not available in this version of the agent")
+}
+
 // GetDebugInfo exposes debug informations about secrets to be included in a flare
 func GetDebugInfo() (*SecretInfo, error) {
 	return nil, fmt.Errorf("Secret feature is not available in this version of the agent")
 }
+
+// SecretChangeCallback is called with the new value of a secret handle when a
+// refresh changed it
+type SecretChangeCallback func(handle string, value string)
+
+// Subscribe placeholder when compiled without the 'secrets' build tag
+func Subscribe(callback SecretChangeCallback) {}
+
+// StartRefresh placeholder when compiled without the 'secrets' build tag
+func StartRefresh() {}
+
+// StopRefresh placeholder when compiled without the 'secrets' build tag
+func StopRefresh() {}
+
+// InvalidateHandle placeholder when compiled without the 'secrets' build tag
+func InvalidateHandle(handle string) bool { return false }
+
+// InvalidateAll placeholder when compiled without the 'secrets' build tag
+func InvalidateAll() int { return 0 }
@@ -0,0 +1,35 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package secrets
+
+import (
+	"time"
+)
+
+// Options holds the settings of the secret backend, they are filled from the agent configuration by
+// config.InitSecrets
+type Options struct {
+	// BackendType is "file" to read the secrets from the files named by their handles, "socket" to fetch them from
+	// a daemon listening on Socket, or empty to run Command
+	BackendType string
+	Socket      string
+	Command     string
+	Arguments   []string
+	// Timeout is the number of seconds after which Command is terminated
+	Timeout       int
+	OutputMaxSize int
+	// RefreshInterval is the duration after which a cached secret is fetched again, 0 to never fetch it again
+	RefreshInterval time.Duration
+	AuditLogFile    string
+	// MaxConcurrency is the maximum number of Command invocations run in parallel
+	MaxConcurrency int
+	// Retries is the number of times a Command failing with a transient error is retried, waiting RetryBaseDelay
+	// before the first retry and twice as long before each following one
+	Retries        int
+	RetryBaseDelay time.Duration
+	// Decode is the decoding applied to the values returned by Command, "base64" or none when empty
+	Decode string
+}
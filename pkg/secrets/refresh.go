@@ -0,0 +1,159 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets
+
+package secrets
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// SecretChangeCallback is called with the new value of a secret handle when a
+// refresh changed it
+type SecretChangeCallback func(handle string, value string)
+
+var (
+	subscribersLock sync.Mutex
+	subscribers     []SecretChangeCallback
+
+	refreshLock sync.Mutex
+	refreshStop chan struct{}
+	refreshDone chan struct{}
+)
+
+// Subscribe registers a callback notified every time a secret value is updated by
+// the background refresh, so that users of the secret can reconnect
+func Subscribe(callback SecretChangeCallback) {
+	subscribersLock.Lock()
+	defer subscribersLock.Unlock()
+	subscribers = append(subscribers, callback)
+}
+
+// StartRefresh starts fetching every known secret again from the secret_backend_command
+// every secret_refresh_interval. It does nothing if the interval is 0 or if the refresh
+// is already running.
+func StartRefresh() {
+	if !isEnabled() || secretRefreshInterval <= 0 {
+		return
+	}
+
+	refreshLock.Lock()
+	defer refreshLock.Unlock()
+	if refreshStop != nil {
+		return
+	}
+	refreshStop = make(chan struct{})
+	refreshDone = make(chan struct{})
+
+	go func(interval time.Duration, stop chan struct{}, done chan struct{}) {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				refreshSecrets()
+			case <-stop:
+				return
+			}
+		}
+	}(secretRefreshInterval, refreshStop, refreshDone)
+	log.Infof("Refreshing secrets every %s", secretRefreshInterval)
+}
+
+// StopRefresh stops the background refresh started by StartRefresh
+func StopRefresh() {
+	refreshLock.Lock()
+	defer refreshLock.Unlock()
+	if refreshStop == nil {
+		return
+	}
+	close(refreshStop)
+	<-refreshDone
+	refreshStop = nil
+	refreshDone = nil
+}
+
+// refreshSecrets fetches every cached secret again and notifies the subscribers of the
+// ones whose value changed. A secret that can't be fetched keeps its previous value.
+func refreshSecrets() {
+	updated := refreshCache()
+	if len(updated) == 0 {
+		return
+	}
+
+	handles := make([]string, 0, len(updated))
+	for handle := range updated {
+		handles = append(handles, handle)
+	}
+	sort.Strings(handles)
+
+	subscribersLock.Lock()
+	callbacks := append([]SecretChangeCallback{}, subscribers...)
+	subscribersLock.Unlock()
+
+	for _, handle := range handles {
+		log.Infof("Secret '%s' was updated by the secret_backend_command", handle)
+		for _, callback := range callbacks {
+			callback(handle, updated[handle])
+		}
+	}
+}
+
+// refreshCache fetches every cached secret again and returns the ones whose value changed.
+// The backend runs without holding secretLock so that configurations can still be
+// decrypted from the cache in the meantime.
+func refreshCache() map[string]string {
+	secretLock.Lock()
+	handles := make([]string, 0, len(secretCache))
+	origins := make(map[string]string, len(secretCache))
+	for handle := range secretCache {
+		handles = append(handles, handle)
+		origins[handle] = secretOrigins(handle)
+	}
+	secretLock.Unlock()
+
+	if len(handles) == 0 {
+		return nil
+	}
+	sort.Strings(handles)
+
+	secrets, err := runBackend(handles)
+	if err != nil {
+		for _, handle := range handles {
+			auditSecret(handle, origins[handle], false, err)
+		}
+		log.Errorf("Could not refresh secrets, keeping the previous values: %s", err)
+		return nil
+	}
+
+	secretLock.Lock()
+	defer secretLock.Unlock()
+
+	updated := map[string]string{}
+	now := time.Now()
+	for _, handle := range handles {
+		value, err := checkSecret(secrets, handle)
+		auditSecret(handle, origins[handle], false, err)
+		if err != nil {
+			log.Errorf("Could not refresh secret '%s', keeping the previous value: %s", handle, err)
+			continue
+		}
+
+		if secretCache[handle] != value {
+			secretCache[handle] = value
+			log.AddSecretValues(value)
+			updated[handle] = value
+		}
+		secretFetchTime[handle] = now
+	}
+	return updated
+}
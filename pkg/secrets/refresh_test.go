@@ -0,0 +1,121 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets
+
+package secrets
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/util/common"
+)
+
+func resetRefresh() {
+	secretCache = map[string]string{}
+	secretFetchTime = map[string]time.Time{}
+	secretOrigin = map[string]common.StringSet{}
+	subscribers = nil
+	runCommand = execCommand
+}
+
+func TestRefreshSecrets(t *testing.T) {
+	defer resetRefresh()
+
+	secretCache["handle1"] = "p1"
+	secretCache["handle2"] = "p2"
+
+	notified := map[string]string{}
+	Subscribe(func(handle string, value string) {
+		notified[handle] = value
+	})
+
+	runCommand = func(string) ([]byte, error) {
+		return []byte("{\"handle1\":{\"value\":\"p1\"},\"handle2\":{\"value\":\"new_p2\"}}"), nil
+	}
+	refreshSecrets()
+
+	assert.Equal(t, map[string]string{"handle1": "p1", "handle2": "new_p2"}, secretCache)
+	assert.Equal(t, map[string]string{"handle2": "new_p2"}, notified)
+	assert.Contains(t, secretFetchTime, "handle1")
+	assert.Contains(t, secretFetchTime, "handle2")
+}
+
+func TestRefreshSecretsBackendError(t *testing.T) {
+	defer resetRefresh()
+
+	secretCache["handle1"] = "p1"
+	Subscribe(func(handle string, value string) {
+		assert.Fail(t, "no secret should have been updated")
+	})
+
+	runCommand = func(string) ([]byte, error) { return nil, fmt.Errorf("some error") }
+	refreshSecrets()
+
+	assert.Equal(t, map[string]string{"handle1": "p1"}, secretCache)
+}
+
+func TestRefreshSecretsHandleError(t *testing.T) {
+	defer resetRefresh()
+
+	secretCache["handle1"] = "p1"
+	secretCache["handle2"] = "p2"
+	secretCache["handle3"] = "p3"
+
+	notified := map[string]string{}
+	Subscribe(func(handle string, value string) {
+		notified[handle] = value
+	})
+
+	runCommand = func(string) ([]byte, error) {
+		return []byte("{\"handle1\":{\"value\":\"new_p1\"},\"handle2\":{\"error\":\"some error\"}}"), nil
+	}
+	refreshSecrets()
+
+	assert.Equal(t, map[string]string{"handle1": "new_p1", "handle2": "p2", "handle3": "p3"}, secretCache)
+	assert.Equal(t, map[string]string{"handle1": "new_p1"}, notified)
+}
+
+func TestRefreshSecretsDoesNotBlockDecrypt(t *testing.T) {
+	defer resetRefresh()
+
+	secretCache["handle1"] = "p1"
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	runCommand = func(string) ([]byte, error) {
+		close(started)
+		<-release
+		return []byte("{\"handle1\":{\"value\":\"new_p1\"}}"), nil
+	}
+
+	refreshed := make(chan struct{})
+	go func() {
+		refreshSecrets()
+		close(refreshed)
+	}()
+	<-started
+
+	// the cache can be read while the backend is running
+	locked := make(chan struct{})
+	go func() {
+		secretLock.Lock()
+		defer secretLock.Unlock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "secretLock was held while the backend was running")
+	}
+
+	close(release)
+	<-refreshed
+	assert.Equal(t, map[string]string{"handle1": "new_p1"}, secretCache)
+}
@@ -9,7 +9,10 @@ package secrets
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	yaml "gopkg.in/yaml.v2"
 
@@ -18,13 +21,35 @@ import (
 )
 
 var (
+	// secretLock protects the secrets caches, configurations can be decrypted from several goroutines. It is not held
+	// while the backend runs.
+	secretLock  sync.Mutex
 	secretCache map[string]string
+	// fetches of the backend in progress, indexed by handle, so that a handle is only fetched once at a time
+	secretFetches map[string]*secretFetch
+	// time at which each handle was fetched from the backend
+	secretFetchTime map[string]time.Time
 	// list of handles and where they were found
 	secretOrigin map[string]common.StringSet
 
+	// secretBackendType is "file" to read the secrets from the files named by their handles, or "socket" to fetch them
+	// from a daemon listening on secretBackendSocket, instead of running secret_backend_command
+	secretBackendType      string
+	secretBackendSocket    string
 	secretBackendCommand   string
 	secretBackendArguments []string
-	secretBackendTimeout   = 5
+	secretBackendTimeout   = 30
+	// secretBackendMaxConcurrency is the maximum number of secret_backend_command invocations run in parallel
+	secretBackendMaxConcurrency = 1
+	// secretBackendRetries is the number of times a secret_backend_command failing with a transient error is retried,
+	// waiting secretBackendRetryBaseDelay before the first retry and twice as long before each following one
+	secretBackendRetries        = 0
+	secretBackendRetryBaseDelay = time.Second
+	// secretBackendDecode is the decoding applied to the values returned by the secret_backend_command, "base64" or
+	// none when empty
+	secretBackendDecode string
+	// secretRefreshInterval is the duration after which a cached secret is fetched again, 0 to never fetch it again
+	secretRefreshInterval time.Duration
 
 	// SecretBackendOutputMaxSize defines max size of the JSON output from a secrets reader backend
 	SecretBackendOutputMaxSize = 1024 * 1024
@@ -32,17 +57,58 @@ var (
 
 func init() {
 	secretCache = make(map[string]string)
+	secretFetchTime = make(map[string]time.Time)
 	secretOrigin = make(map[string]common.StringSet)
+	secretFetches = make(map[string]*secretFetch)
+}
+
+// secretFetch is a fetch of the backend in progress, done is closed once it completed with value or err
+type secretFetch struct {
+	done  chan struct{}
+	value string
+	err   error
 }
 
 // Init initializes the command and other options of the secrets package. Since
 // this package is used by the 'config' package to decrypt itself we can't
 // directly use it.
-func Init(command string, arguments []string, timeout int, maxSize int) {
-	secretBackendCommand = command
-	secretBackendArguments = arguments
-	secretBackendTimeout = timeout
-	SecretBackendOutputMaxSize = maxSize
+func Init(opts Options) {
+	secretBackendType = opts.BackendType
+	secretBackendSocket = opts.Socket
+	secretBackendCommand = opts.Command
+	secretBackendArguments = opts.Arguments
+	secretBackendTimeout = opts.Timeout
+	SecretBackendOutputMaxSize = opts.OutputMaxSize
+	secretRefreshInterval = opts.RefreshInterval
+	secretAuditLogFile = opts.AuditLogFile
+	secretBackendMaxConcurrency = opts.MaxConcurrency
+	secretBackendRetries = opts.Retries
+	secretBackendRetryBaseDelay = opts.RetryBaseDelay
+	secretBackendDecode = opts.Decode
+}
+
+// isEnabled returns whether a secret backend is configured
+func isEnabled() bool {
+	switch secretBackendType {
+	case fileBackendType:
+		return true
+	case socketBackendType:
+		return secretBackendSocket != ""
+	default:
+		return secretBackendCommand != ""
+	}
+}
+
+// backendName returns the name of the configured secret backend
+func backendName() string {
+	switch secretBackendType {
+	case fileBackendType:
+		return fileBackendType
+	case socketBackendType:
+		return secretBackendSocket
+	default:
+		return secretBackendCommand
+	}
 }
 
 type walkerCallback func(string) (string, error)
@@ -144,10 +210,68 @@ func isEnc(str string) (bool, string) {
 // testing purpose
 var secretFetcher = fetchSecret
 
+// getCachedSecret returns the cached value of a handle, unless it was fetched more than
+// secretRefreshInterval ago. secretLock must be held.
+func getCachedSecret(handle string) (string, bool) {
+	secret, ok := secretCache[handle]
+	if !ok {
+		return "", false
+	}
+
+	if secretRefreshInterval > 0 {
+		if fetchTime, ok := secretFetchTime[handle]; ok && time.Since(fetchTime) > secretRefreshInterval {
+			log.Debugf("Secret '%s' expired from cache", handle)
+			return "", false
+		}
+	}
+	return secret, true
+}
+
+// completeFetches wakes up the configurations waiting for the given handles, the ones missing from secrets
+// failed with err. secretLock must be held.
+func completeFetches(handles []string, secrets map[string]string, err error) {
+	for _, handle := range handles {
+		fetch := secretFetches[handle]
+		if value, ok := secrets[handle]; ok {
+			fetch.value = value
+		} else {
+			fetch.err = err
+			if fetch.err == nil {
+				fetch.err = fmt.Errorf("unknown secret '%s'", handle)
+			}
+		}
+		delete(secretFetches, handle)
+		close(fetch.done)
+	}
+}
+
+// CollectHandles returns the sorted list of secret handles referenced in data, without fetching them
+func CollectHandles(data []byte) ([]string, error) {
+	var config interface{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("could not Unmarshal config: %s", err)
+	}
+
+	handles := common.NewStringSet()
+	err := walk(&config, func(str string) (string, error) {
+		if ok, handle := isEnc(str); ok {
+			handles.Add(handle)
+		}
+		return str, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := handles.GetAll()
+	sort.Strings(res)
+	return res, nil
+}
+
 // Decrypt replaces all encrypted secrets in data by executing
 // "secret_backend_command" once if all secrets aren't present in the cache.
 func Decrypt(data []byte, origin string) ([]byte, error) {
-	if data == nil || secretBackendCommand == "" {
+	if data == nil || !isEnabled() {
 		return data, nil
 	}
 
@@ -157,39 +281,87 @@ func Decrypt(data []byte, origin string) ([]byte, error) {
 		return nil, fmt.Errorf("could not Unmarshal config: %s", err)
 	}
 
-	// First we collect all new handles in the config
+	secretLock.Lock()
+
+	// First we collect all new handles in the config, the ones already being fetched are waited for
 	newHandles := []string{}
+	pending := map[string]*secretFetch{}
 	haveSecret := false
 	err = walk(&config, func(str string) (string, error) {
 		if ok, handle := isEnc(str); ok {
 			haveSecret = true
 			// Check if we already know this secret
-			if secret, ok := secretCache[handle]; ok {
+			if secret, ok := getCachedSecret(handle); ok {
 				log.Debugf("Secret '%s' was retrieved from cache", handle)
+				tlmSecretCacheHits.Inc()
+				auditSecret(handle, origin, true, nil)
 				// keep track of place where a handle was found
 				secretOrigin[handle].Add(origin)
 				return secret, nil
 			}
+			if _, ok := pending[handle]; ok {
+				return str, nil
+			}
+			tlmSecretCacheMisses.Inc()
+			if fetch, ok := secretFetches[handle]; ok {
+				pending[handle] = fetch
+				return str, nil
+			}
+			fetch := &secretFetch{done: make(chan struct{})}
+			secretFetches[handle] = fetch
+			pending[handle] = fetch
 			newHandles = append(newHandles, handle)
 		}
 		return str, nil
 	})
 	if err != nil {
+		completeFetches(newHandles, nil, err)
+		secretLock.Unlock()
 		return nil, err
 	}
+	secretLock.Unlock()
+
+	// check if any new secrets need to be fetch, the lock is released so that a slow backend doesn't block the
+	// configurations whose secrets are cached
+	secrets := map[string]string{}
+	if len(newHandles) != 0 {
+		fetched, err := secretFetcher(newHandles, origin)
+		for handle, secret := range fetched {
+			secrets[handle] = secret
+		}
+
+		secretLock.Lock()
+		completeFetches(newHandles, secrets, err)
+		secretLock.Unlock()
+
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// the configuration does not contain any secrets
 	if !haveSecret {
 		return data, nil
 	}
 
-	// check if any new secrets need to be fetch
-	if len(newHandles) != 0 {
-		secrets, err := secretFetcher(newHandles, origin)
-		if err != nil {
-			return nil, err
+	// Wait for the handles fetched by other configurations
+	for handle, fetch := range pending {
+		if _, ok := secrets[handle]; ok {
+			continue
+		}
+		<-fetch.done
+		if fetch.err != nil {
+			return nil, fetch.err
+		}
+		secretLock.Lock()
+		if origins, ok := secretOrigin[handle]; ok {
+			origins.Add(origin)
 		}
+		secretLock.Unlock()
+		secrets[handle] = fetch.value
+	}
 
+	if len(pending) != 0 {
 		// Replace all new encrypted secrets in the config
 		err = walk(&config, func(str string) (string, error) {
 			if ok, handle := isEnc(str); ok {
@@ -217,11 +389,16 @@ func Decrypt(data []byte, origin string) ([]byte, error) {
 
 // GetDebugInfo exposes debug informations about secrets to be included in a flare
 func GetDebugInfo() (*SecretInfo, error) {
-	if secretBackendCommand == "" {
+	if !isEnabled() {
 		return nil, fmt.Errorf("No secret_backend_command set: secrets feature is not enabled")
 	}
 	info := &SecretInfo{ExecutablePath: secretBackendCommand}
-	info.populateRights()
+	if secretBackendType != fileBackendType && secretBackendType != socketBackendType {
+		info.populateRights()
+	}
+
+	secretLock.Lock()
+	defer secretLock.Unlock()
 
 	info.SecretsHandles = map[string][]string{}
 	for handle, originNames := range secretOrigin {
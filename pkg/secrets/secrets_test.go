@@ -10,7 +10,9 @@ package secrets
 import (
 	"fmt"
 	"sort"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/util/common"
 	"github.com/stretchr/testify/assert"
@@ -216,6 +218,58 @@ func TestDecryptSecretNoCache(t *testing.T) {
 	assert.Equal(t, string(testConfDecrypted), string(newConf))
 }
 
+func TestDecryptSecretSlowFetchDoesNotBlockCache(t *testing.T) {
+	secretBackendCommand = "some_command"
+	secretCache["cached"] = "cached_password"
+	secretOrigin["cached"] = common.NewStringSet()
+
+	defer func() {
+		secretBackendCommand = ""
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+		secretFetcher = fetchSecret
+	}()
+
+	fetching := make(chan struct{})
+	release := make(chan struct{})
+	var fetches int32
+	secretFetcher = func(secrets []string, origin string) (map[string]string, error) {
+		if atomic.AddInt32(&fetches, 1) == 1 {
+			close(fetching)
+		}
+		<-release
+		// cache it like fetchSecret does
+		secretLock.Lock()
+		secretCache["slow"] = "slow_password"
+		secretOrigin["slow"] = common.NewStringSet(origin)
+		secretLock.Unlock()
+		return map[string]string{"slow": "slow_password"}, nil
+	}
+
+	results := make(chan []byte, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			conf, err := Decrypt([]byte("pass: ENC[slow]"), "slow")
+			assert.NoError(t, err)
+			results <- conf
+		}()
+	}
+	<-fetching
+
+	// a configuration whose secrets are cached is decrypted while the backend runs
+	conf, err := Decrypt([]byte("pass: ENC[cached]"), "cached")
+	require.NoError(t, err)
+	assert.Equal(t, "pass: cached_password\n", string(conf))
+
+	close(release)
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, "pass: slow_password\n", string(<-results))
+	}
+	// the handle is only fetched once for both configurations, the second one waits for the first fetch or reads
+	// its result from the cache
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+}
+
 func TestDecryptSecretNestedJSON(t *testing.T) {
 	secretBackendCommand = "some_command"
 
@@ -296,6 +350,91 @@ func TestDecryptSecretFullCache(t *testing.T) {
 	assert.Equal(t, testConfDecrypted, newConf)
 }
 
+func TestDecryptSecretCacheNotExpired(t *testing.T) {
+	secretBackendCommand = "some_command"
+	secretRefreshInterval = time.Hour
+	defer func() {
+		secretBackendCommand = ""
+		secretRefreshInterval = 0
+	}()
+
+	secretCache["pass1"] = "password1"
+	secretCache["pass2"] = "password2"
+	secretFetchTime["pass1"] = time.Now()
+	secretFetchTime["pass2"] = time.Now()
+	secretOrigin["pass1"] = common.NewStringSet("previous_test")
+	secretOrigin["pass2"] = common.NewStringSet("previous_test")
+	defer func() {
+		secretCache = map[string]string{}
+		secretFetchTime = map[string]time.Time{}
+		secretOrigin = map[string]common.StringSet{}
+		secretFetcher = fetchSecret
+	}()
+
+	secretFetcher = func(secrets []string, origin string) (map[string]string, error) {
+		require.Fail(t, "Secret Cache was not used properly")
+		return nil, nil
+	}
+
+	newConf, err := Decrypt(testConf, "test")
+	require.Nil(t, err)
+	assert.Equal(t, testConfDecrypted, newConf)
+}
+
+func TestDecryptSecretCacheExpired(t *testing.T) {
+	secretBackendCommand = "some_command"
+	secretRefreshInterval = time.Minute
+	defer func() {
+		secretBackendCommand = ""
+		secretRefreshInterval = 0
+	}()
+
+	secretCache["pass1"] = "password1"
+	secretCache["pass2"] = "old_password2"
+	secretFetchTime["pass1"] = time.Now()
+	secretFetchTime["pass2"] = time.Now().Add(-time.Hour)
+	secretOrigin["pass1"] = common.NewStringSet("previous_test")
+	secretOrigin["pass2"] = common.NewStringSet("previous_test")
+	defer func() {
+		secretCache = map[string]string{}
+		secretFetchTime = map[string]time.Time{}
+		secretOrigin = map[string]common.StringSet{}
+		secretFetcher = fetchSecret
+	}()
+
+	secretFetcher = func(secrets []string, origin string) (map[string]string, error) {
+		assert.Equal(t, []string{"pass2"}, secrets)
+		return map[string]string{
+			"pass2": "password2",
+		}, nil
+	}
+
+	newConf, err := Decrypt(testConf, "test")
+	require.Nil(t, err)
+	assert.Equal(t, testConfDecrypted, newConf)
+}
+
+func TestCollectHandles(t *testing.T) {
+	secretFetcher = func(secrets []string, origin string) (map[string]string, error) {
+		require.Fail(t, "secrets should not be fetched")
+		return nil, nil
+	}
+	defer func() { secretFetcher = fetchSecret }()
+
+	handles, err := CollectHandles(testConf)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pass1", "pass2"}, handles)
+
+	handles, err = CollectHandles(testConfJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pass1", "pass2"}, handles)
+
+	handles, err = CollectHandles(nil)
+	require.NoError(t, err)
+	assert.Empty(t, handles)
+	assert.Empty(t, secretCache)
+}
+
 func TestDebugInfo(t *testing.T) {
 	secretBackendCommand = "some_command"
 
@@ -335,3 +474,53 @@ func TestDebugInfo(t *testing.T) {
 		"pass3": {"test2"},
 	}, handles)
 }
+
+func TestInvalidateHandle(t *testing.T) {
+	secretBackendCommand = "some_command"
+	defer func() { secretBackendCommand = "" }()
+
+	secretCache["pass1"] = "password1"
+	secretCache["pass2"] = "old_password2"
+	secretFetchTime["pass1"] = time.Now()
+	secretFetchTime["pass2"] = time.Now()
+	secretOrigin["pass1"] = common.NewStringSet("previous_test")
+	secretOrigin["pass2"] = common.NewStringSet("previous_test")
+	defer func() {
+		secretCache = map[string]string{}
+		secretFetchTime = map[string]time.Time{}
+		secretOrigin = map[string]common.StringSet{}
+		secretFetcher = fetchSecret
+	}()
+
+	assert.True(t, InvalidateHandle("pass2"))
+	assert.False(t, InvalidateHandle("pass2"))
+	assert.Equal(t, map[string]string{"pass1": "password1"}, secretCache)
+	assert.Contains(t, secretOrigin, "pass2")
+
+	secretFetcher = func(secrets []string, origin string) (map[string]string, error) {
+		assert.Equal(t, []string{"pass2"}, secrets)
+		return map[string]string{
+			"pass2": "password2",
+		}, nil
+	}
+
+	newConf, err := Decrypt(testConf, "test")
+	require.Nil(t, err)
+	assert.Equal(t, testConfDecrypted, newConf)
+}
+
+func TestInvalidateAll(t *testing.T) {
+	secretCache["pass1"] = "password1"
+	secretCache["pass2"] = "password2"
+	secretFetchTime["pass1"] = time.Now()
+	secretFetchTime["pass2"] = time.Now()
+	defer func() {
+		secretCache = map[string]string{}
+		secretFetchTime = map[string]time.Time{}
+	}()
+
+	assert.Equal(t, 2, InvalidateAll())
+	assert.Empty(t, secretCache)
+	assert.Empty(t, secretFetchTime)
+	assert.Equal(t, 0, InvalidateAll())
+}
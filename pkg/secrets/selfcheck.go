@@ -0,0 +1,175 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets
+
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// selfCheckHandle is the handle sent to the secret_backend_command by the self check, the backend is only expected to
+// answer with valid JSON, not to resolve it
+const selfCheckHandle = "datadog_secret_backend_self_check"
+
+// terminationCheckDelay is the time the backend is left running before being asked to terminate by the self check
+var terminationCheckDelay = 500 * time.Millisecond
+
+// selfCheckReporter accumulates the results of the self check
+type selfCheckReporter struct {
+	results []SelfCheckResult
+}
+
+// report records the outcome of a check and returns whether it passed
+func (r *selfCheckReporter) report(name string, err error) bool {
+	result := SelfCheckResult{Name: name}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	r.results = append(r.results, result)
+	return err == nil
+}
+
+// warn records a check that passed with a warning
+func (r *selfCheckReporter) warn(name string, warning string) {
+	r.results = append(r.results, SelfCheckResult{Name: name, Warning: warning})
+}
+
+// skip records the checks depending on a failed one
+func (r *selfCheckReporter) skip(names ...string) {
+	for _, name := range names {
+		r.results = append(r.results, SelfCheckResult{Name: name, Error: "skipped because of a previous failure"})
+	}
+}
+
+// SelfCheck checks that the configured secret backend can be used by the agent. The secret_backend_command must
+// exist, have the right permissions, answer within secret_backend_timeout with valid JSON, and exit when it's asked
+// to terminate. The secret_backend_socket must exist and answer within secret_backend_timeout with valid JSON. The
+// checks depending on a failed one are reported as skipped.
+func SelfCheck() ([]SelfCheckResult, error) {
+	if !isEnabled() {
+		return nil, fmt.Errorf("No secret_backend_command set: secrets feature is not enabled")
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"version": PayloadVersion,
+		"secrets": []string{selfCheckHandle},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &selfCheckReporter{}
+	switch secretBackendType {
+	case fileBackendType:
+		return nil, fmt.Errorf("secret_backend_type is %s: the secrets are read from the files named by their handles, there is no backend to check", fileBackendType)
+	case socketBackendType:
+		selfCheckSocket(r, payload)
+	default:
+		selfCheckCommand(r, payload)
+	}
+	return r.results, nil
+}
+
+// selfCheckSocket checks the secret_backend_socket
+func selfCheckSocket(r *selfCheckReporter, payload []byte) {
+	responds := fmt.Sprintf("responds within %ds", secretBackendTimeout)
+	validJSON := "returns valid JSON"
+
+	if _, err := os.Stat(secretBackendSocket); !r.report("socket exists", err) {
+		r.skip(responds, validJSON)
+		return
+	}
+
+	output, err := runSocket(payload)
+	if r.report(responds, err) {
+		_, err = parseBackendOutput(output)
+		r.report(validJSON, err)
+	} else {
+		r.skip(validJSON)
+	}
+}
+
+// selfCheckCommand checks the secret_backend_command
+func selfCheckCommand(r *selfCheckReporter, payload []byte) {
+	responds := fmt.Sprintf("responds within %ds", secretBackendTimeout)
+	validJSON := "returns valid JSON"
+	termination := "exits when asked to terminate"
+
+	cmd := exec.Command(secretBackendCommand, secretBackendArguments...)
+	if _, err := os.Stat(cmd.Path); !r.report("executable exists", err) {
+		r.skip("executable permissions", responds, validJSON, termination)
+		return
+	}
+	if !r.report("executable permissions", checkRights(cmd.Path)) {
+		r.skip(responds, validJSON, termination)
+		return
+	}
+
+	output, err := execCommand(string(payload))
+	if r.report(responds, err) {
+		_, err = parseBackendOutput(output)
+		r.report(validJSON, err)
+	} else {
+		r.skip(validJSON)
+	}
+
+	terminated, err := checkTermination()
+	if err == nil && !terminated {
+		r.warn(termination, "it could not be asked to terminate and was killed instead, it is killed the same way when it times out")
+		return
+	}
+	r.report(termination, err)
+}
+
+// checkTermination starts the secret_backend_command without closing its stdin, so that it's still waiting for its
+// input, and checks that it exits within the grace period once it's asked to terminate. It returns false when the
+// command couldn't be asked to terminate and was killed instead.
+func checkTermination() (bool, error) {
+	cmd := exec.Command(secretBackendCommand, secretBackendArguments...)
+	setProcessGroup(cmd)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return false, err
+	}
+	defer stdin.Close()
+	cmd.Stdout = ioutil.Discard
+	cmd.Stderr = ioutil.Discard
+
+	if err := cmd.Start(); err != nil {
+		return false, err
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case <-done:
+		// the backend didn't wait for its input, there is nothing to terminate
+		return true, nil
+	case <-time.After(terminationCheckDelay):
+	}
+
+	terminated, err := terminateProcess(cmd)
+	if err != nil {
+		_ = killProcess(cmd)
+		return false, fmt.Errorf("could not terminate it: %s", err)
+	}
+
+	select {
+	case <-done:
+		return terminated, nil
+	case <-time.After(terminateGracePeriod):
+		_ = killProcess(cmd)
+		return false, fmt.Errorf("it was still running %s after being asked to terminate, it's killed when it times out", terminateGracePeriod)
+	}
+}
@@ -0,0 +1,134 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets,!windows
+
+package secrets
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfCheck(t *testing.T) {
+	defer func() {
+		secretBackendCommand = ""
+		secretBackendArguments = []string{}
+		secretBackendTimeout = 0
+	}()
+
+	secretBackendCommand = "./test/simple/simple"
+	setCorrectRight(secretBackendCommand)
+	secretBackendTimeout = 2
+
+	results, err := SelfCheck()
+	require.NoError(t, err)
+	assert.Equal(t, []SelfCheckResult{
+		{Name: "executable exists"},
+		{Name: "executable permissions"},
+		{Name: "responds within 2s"},
+		{Name: "returns valid JSON"},
+		{Name: "exits when asked to terminate"},
+	}, results)
+}
+
+func TestSelfCheckMissingExecutable(t *testing.T) {
+	defer func() { secretBackendCommand = "" }()
+
+	secretBackendCommand = "./test/does_not_exist"
+
+	results, err := SelfCheck()
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+	assert.NotEmpty(t, results[0].Error)
+	for _, result := range results[1:] {
+		assert.Equal(t, "skipped because of a previous failure", result.Error)
+	}
+}
+
+func TestSelfCheckSigterm(t *testing.T) {
+	defer func() {
+		secretBackendCommand = ""
+		secretBackendArguments = []string{}
+		secretBackendTimeout = 0
+		terminateGracePeriod = 2 * time.Second
+	}()
+
+	dir, err := ioutil.TempDir("", "secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// the backend exits on sigterm but never answers
+	secretBackendCommand = "./test/sigterm/sigterm"
+	setCorrectRight(secretBackendCommand)
+	secretBackendArguments = []string{filepath.Join(dir, "signal")}
+	secretBackendTimeout = 1
+	terminateGracePeriod = 500 * time.Millisecond
+
+	results, err := SelfCheck()
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+	assert.Equal(t, "error while running './test/sigterm/sigterm': command timeout after 1s", results[2].Error)
+	assert.Equal(t, "skipped because of a previous failure", results[3].Error)
+	assert.Empty(t, results[4].Error)
+
+	// the backend ignores sigterm
+	secretBackendCommand = "./test/ignore_sigterm/ignore_sigterm"
+	setCorrectRight(secretBackendCommand)
+	secretBackendArguments = []string{}
+
+	results, err = SelfCheck()
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+	assert.Contains(t, results[4].Error, "after being asked to terminate")
+}
+
+func TestSelfCheckSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	secretBackendType = socketBackendType
+	secretBackendSocket = filepath.Join(dir, "secrets.sock")
+	secretBackendTimeout = 2
+	// the command isn't checked when the socket backend is configured
+	secretBackendCommand = "./test/does_not_exist"
+	defer func() {
+		secretBackendType = ""
+		secretBackendSocket = ""
+		secretBackendTimeout = 0
+		secretBackendCommand = ""
+	}()
+
+	results, err := SelfCheck()
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, "socket exists", results[0].Name)
+	assert.NotEmpty(t, results[0].Error)
+
+	handles := serveSecretSocket(t, secretBackendSocket, []byte(`{}`))
+	results, err = SelfCheck()
+	require.NoError(t, err)
+	assert.Equal(t, []SelfCheckResult{
+		{Name: "socket exists"},
+		{Name: "responds within 2s"},
+		{Name: "returns valid JSON"},
+	}, results)
+	assert.Equal(t, []string{selfCheckHandle}, <-handles)
+}
+
+func TestSelfCheckFileBackend(t *testing.T) {
+	secretBackendType = fileBackendType
+	defer func() { secretBackendType = "" }()
+
+	_, err := SelfCheck()
+	assert.Error(t, err)
+}
@@ -0,0 +1,63 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets
+
+package secrets
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// socketBackendType is the secret_backend_type fetching the secrets from a daemon listening on secret_backend_socket
+const socketBackendType = "socket"
+
+// runSocket sends the payload to the daemon listening on secret_backend_socket and returns its answer. The payload and
+// the answer are both prefixed by their length as a 4 bytes big endian integer. The whole exchange must complete
+// within secret_backend_timeout, the connection is then closed.
+func runSocket(payload []byte) ([]byte, error) {
+	timeout := time.Duration(secretBackendTimeout) * time.Second
+	conn, err := net.DialTimeout("unix", secretBackendSocket, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to secret_backend_socket '%s': %s", secretBackendSocket, err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Debugf("could not close the connection to secret_backend_socket '%s': %s", secretBackendSocket, err)
+		}
+	}()
+
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, fmt.Errorf("could not set the secret_backend_socket '%s' deadline: %s", secretBackendSocket, err)
+	}
+
+	request := make([]byte, 4+len(payload))
+	binary.BigEndian.PutUint32(request, uint32(len(payload)))
+	copy(request[4:], payload)
+	if _, err := conn.Write(request); err != nil {
+		return nil, fmt.Errorf("could not send the request to secret_backend_socket '%s': %s", secretBackendSocket, err)
+	}
+
+	var length [4]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return nil, fmt.Errorf("could not read the answer of secret_backend_socket '%s': %s", secretBackendSocket, err)
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if uint64(size) > uint64(SecretBackendOutputMaxSize) {
+		return nil, fmt.Errorf("secret_backend_socket '%s' answer was too long: %d bytes exceeds %d bytes", secretBackendSocket, size, SecretBackendOutputMaxSize)
+	}
+
+	output := make([]byte, size)
+	if _, err := io.ReadFull(conn, output); err != nil {
+		return nil, fmt.Errorf("could not read the answer of secret_backend_socket '%s': %s", secretBackendSocket, err)
+	}
+	return output, nil
+}
@@ -0,0 +1,127 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build secrets,!windows
+
+package secrets
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/util/common"
+)
+
+// serveSecretSocket answers a single request on a unix socket with the given answer, and returns the handles of the
+// request
+func serveSecretSocket(t *testing.T, path string, answer []byte) <-chan []string {
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+
+	handles := make(chan []string, 1)
+	go func() {
+		defer listener.Close()
+		defer close(handles)
+
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var length [4]byte
+		if _, err := io.ReadFull(conn, length[:]); err != nil {
+			return
+		}
+		payload := make([]byte, binary.BigEndian.Uint32(length[:]))
+		if _, err := io.ReadFull(conn, payload); err != nil {
+			return
+		}
+		request := struct {
+			Secrets []string `json:"secrets"`
+		}{}
+		if err := json.Unmarshal(payload, &request); err != nil {
+			return
+		}
+		handles <- request.Secrets
+
+		binary.BigEndian.PutUint32(length[:], uint32(len(answer)))
+		conn.Write(append(length[:], answer...))
+	}()
+	return handles
+}
+
+func TestFetchSecretSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	secretBackendType = socketBackendType
+	secretBackendSocket = filepath.Join(dir, "secrets.sock")
+	secretBackendTimeout = 2
+	defer func() {
+		secretBackendType = ""
+		secretBackendSocket = ""
+		secretBackendTimeout = 0
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+	}()
+
+	handles := serveSecretSocket(t, secretBackendSocket, []byte("{\"handle1\":{\"value\":\"p1\"},\"handle2\":{\"value\":\"p2\"}}"))
+
+	resp, err := fetchSecret([]string{"handle1", "handle2"}, "test")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"handle1": "p1", "handle2": "p2"}, resp)
+	assert.Equal(t, []string{"handle1", "handle2"}, <-handles)
+}
+
+func TestFetchSecretSocketAnswerTooLong(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	secretBackendType = socketBackendType
+	secretBackendSocket = filepath.Join(dir, "secrets.sock")
+	secretBackendTimeout = 2
+	SecretBackendOutputMaxSize = 20
+	defer func() {
+		secretBackendType = ""
+		secretBackendSocket = ""
+		secretBackendTimeout = 0
+		SecretBackendOutputMaxSize = 1024 * 1024
+		secretCache = map[string]string{}
+		secretOrigin = map[string]common.StringSet{}
+	}()
+
+	serveSecretSocket(t, secretBackendSocket, []byte("{\"handle1\":{\"value\":\"a_password_longer_than_20_bytes\"}}"))
+
+	_, err = fetchSecret([]string{"handle1"}, "test")
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "answer was too long")
+}
+
+func TestFetchSecretSocketUnreachable(t *testing.T) {
+	secretBackendType = socketBackendType
+	secretBackendSocket = "/does/not/exist.sock"
+	secretBackendTimeout = 1
+	defer func() {
+		secretBackendType = ""
+		secretBackendSocket = ""
+		secretBackendTimeout = 0
+	}()
+
+	_, err := fetchSecret([]string{"handle1"}, "test")
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "could not connect to secret_backend_socket '/does/not/exist.sock'")
+}
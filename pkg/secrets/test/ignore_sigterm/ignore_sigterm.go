@@ -0,0 +1,22 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// ignore_sigterm never exits on its own and ignores sigterm, as well as the interrupt received on Windows when a
+// CTRL_BREAK_EVENT is sent to it: it can only be killed
+func main() {
+	signal.Ignore(syscall.SIGTERM, os.Interrupt)
+	for {
+		time.Sleep(1 * time.Second)
+	}
+}
@@ -0,0 +1,22 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// sigterm never exits on its own: it writes the name of the signal it received to the file given as argument
+func main() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM)
+
+	sig := <-signals
+	_ = ioutil.WriteFile(os.Args[1], []byte(sig.String()), 0600)
+}
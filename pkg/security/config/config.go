@@ -6,6 +6,8 @@
 package config
 
 import (
+	"fmt"
+
 	aconfig "github.com/DataDog/datadog-agent/pkg/config"
 	"github.com/DataDog/datadog-agent/pkg/process/config"
 )
@@ -30,6 +32,16 @@ type Config struct {
 	SyscallMonitor      bool
 	EventServerBurst    int
 	EventServerRate     int
+	ExecEnvAllowlist    []string
+	DisabledHookPoints  []string
+}
+
+// hookPointNames holds the names of the hook points of the probe, it is used to validate the disabled hook points
+var hookPointNames = make(map[string]bool)
+
+// RegisterHookPoint declares the name of a hook point that can be listed in the disabled hook points
+func RegisterHookPoint(name string) {
+	hookPointNames[name] = true
 }
 
 // NewConfig returns a new Config object
@@ -45,6 +57,8 @@ func NewConfig(cfg *config.AgentConfig) (*Config, error) {
 		PoliciesDir:         aconfig.Datadog.GetString("runtime_security_config.policies.dir"),
 		EventServerBurst:    aconfig.Datadog.GetInt("runtime_security_config.event_server.burst"),
 		EventServerRate:     aconfig.Datadog.GetInt("runtime_security_config.event_server.rate"),
+		ExecEnvAllowlist:    aconfig.Datadog.GetStringSlice("runtime_security_config.exec_env_allowlist"),
+		DisabledHookPoints:  aconfig.Datadog.GetStringSlice("runtime_security_config.disabled_hook_points"),
 	}
 
 	if cfg != nil {
@@ -67,5 +81,11 @@ func NewConfig(cfg *config.AgentConfig) (*Config, error) {
 		c.EnableKernelFilters = false
 	}
 
+	for _, name := range c.DisabledHookPoints {
+		if !hookPointNames[name] {
+			return nil, fmt.Errorf("unknown hook point `%s` in runtime_security_config.disabled_hook_points", name)
+		}
+	}
+
 	return c, nil
 }
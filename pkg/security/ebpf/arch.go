@@ -34,7 +34,7 @@ func GetSyscallFnName(name string) (string, error) {
 }
 
 func getSyscallFnNameWithKallsyms(name string, kallsymsContent string) (string, error) {
-	// We should search for new syscall function like "__x64__sys_open"
+	// We should search for new syscall function like "__x64__sys_open" or "__arm64_sys_openat"
 	// Note the start of word boundary. Should return exactly one string
 	regexStr := `(\b__` + RuntimeArch + `_[Ss]y[sS]_` + name + `\b)`
 	fnRegex := regexp.MustCompile(regexStr)
@@ -67,6 +67,8 @@ func init() {
 	switch string(uname.Machine[:bytes.IndexByte(uname.Machine[:], 0)]) {
 	case "x86_64":
 		RuntimeArch = "x64"
+	case "aarch64":
+		RuntimeArch = "arm64"
 	default:
 		RuntimeArch = "ia32"
 	}
@@ -0,0 +1,60 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package ebpf
+
+import (
+	"testing"
+)
+
+func TestGetSyscallFnNameWithKallsyms(t *testing.T) {
+	runtimeArch := RuntimeArch
+	defer func() { RuntimeArch = runtimeArch }()
+
+	tests := []struct {
+		arch     string
+		kallsyms string
+		name     string
+		expected string
+	}{
+		{
+			arch:     "x64",
+			kallsyms: "ffffffff8128e910 T __x64_sys_open\nffffffff8128e930 T __ia32_sys_open\n",
+			name:     "open",
+			expected: "__x64_sys_open",
+		},
+		{
+			arch:     "arm64",
+			kallsyms: "ffff0000082a1b20 T __arm64_sys_openat\nffff0000082a1b40 T __arm64_compat_sys_openat\n",
+			name:     "openat",
+			expected: "__arm64_sys_openat",
+		},
+		{
+			arch:     "x64",
+			kallsyms: "ffffffff8121c580 T SyS_open\nffffffff8121c580 T sys_open\n",
+			name:     "open",
+			expected: "SyS_open",
+		},
+	}
+
+	for _, test := range tests {
+		RuntimeArch = test.arch
+
+		syscall, err := getSyscallFnNameWithKallsyms(test.name, test.kallsyms)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if syscall != test.expected {
+			t.Errorf("expected %s, got %s", test.expected, syscall)
+		}
+	}
+
+	RuntimeArch = "arm64"
+	if _, err := getSyscallFnNameWithKallsyms("open", "ffff0000082a1b20 T __arm64_sys_openat\n"); err == nil {
+		t.Error("expected an error for a syscall not provided by the kernel")
+	}
+}
@@ -0,0 +1,82 @@
+#ifndef _BPF_H_
+#define _BPF_H_
+
+#include "syscalls.h"
+#include "process.h"
+
+// agent_pid holds the pid of the agent so that its own bpf calls aren't reported
+struct bpf_map_def SEC("maps/agent_pid") agent_pid = {
+    .type = BPF_MAP_TYPE_ARRAY,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(u32),
+    .max_entries = 1,
+    .pinning = 0,
+    .namespace = "",
+};
+
+struct bpf_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u32 cmd;
+    u32 padding;
+};
+
+static __attribute__((always_inline)) int is_agent_pid() {
+    u32 key = 0;
+    u32 *pid = bpf_map_lookup_elem(&agent_pid, &key);
+    if (!pid || *pid == 0)
+        return 0;
+
+    return *pid == bpf_get_current_pid_tgid() >> 32;
+}
+
+SYSCALL_KPROBE3(bpf, int, cmd, union bpf_attr *, uattr, unsigned int, size) {
+    // only the loading of programs and the creation of maps are reported, the map lookups and updates made by every
+    // eBPF based tool would flood the perf buffer
+    if (cmd != BPF_PROG_LOAD && cmd != BPF_MAP_CREATE)
+        return 0;
+
+    if (is_agent_pid())
+        return 0;
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_BPF,
+        .bpf = {
+            .cmd = (u32)cmd,
+        }
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(bpf) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct bpf_event_t event = {
+        .event.type = EVENT_BPF,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .cmd = syscall->bpf.cmd,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
@@ -0,0 +1,58 @@
+#ifndef _CAPSET_H_
+#define _CAPSET_H_
+
+#include "syscalls.h"
+#include "process.h"
+
+struct capset_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct capabilities_t old;
+    struct capabilities_t new;
+};
+
+// capset only changes the capabilities of the calling thread, the capability sets are read before and after the
+// syscall
+SYSCALL_KPROBE0(capset) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_CAPSET,
+    };
+
+    fill_capabilities(&syscall.capset.old);
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(capset) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct capset_event_t event = {
+        .event.type = EVENT_CAPSET,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .old = syscall->capset.old,
+    };
+
+    fill_capabilities(&event.new);
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
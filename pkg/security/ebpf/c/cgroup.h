@@ -36,6 +36,13 @@ static __attribute__((always_inline)) int trace__cgroup_write(struct pt_regs *ct
     bpf_probe_read(&container_d, sizeof(container_d), &dentry->d_parent);
     bpf_probe_read(&container_qstr, sizeof(container_qstr), &container_d->d_name);
     bpf_probe_read(&new_entry.container_id, sizeof(new_entry.container_id), (void*) container_qstr.name);
+
+    // Keep the control group directory so that its path can be resolved once the process exited
+    struct path_key_t cgroup_key = get_key(container_d, &f->f_path);
+    new_entry.cgroup.inode = cgroup_key.ino;
+    new_entry.cgroup.mount_id = cgroup_key.mount_id;
+    resolve_dentry(container_d, cgroup_key, NULL);
+
     bpf_map_update_elem(&proc_cache, &cookie, &new_entry, BPF_ANY);
 
     if (new_cookie) {
@@ -0,0 +1,121 @@
+#ifndef _CHROOT_H_
+#define _CHROOT_H_
+
+#include <linux/fs_struct.h>
+
+#include "syscalls.h"
+#include "process.h"
+
+struct chroot_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t root;
+    struct file_t old_root;
+};
+
+// trace__sys_chroot caches the root of the current task, before it's changed, and resolves it
+int __attribute__((always_inline)) trace__sys_chroot(u16 type) {
+    struct syscall_cache_t syscall = {
+        .type = type,
+    };
+
+    struct task_struct *task = (struct task_struct *)bpf_get_current_task();
+
+    struct fs_struct *fs;
+    bpf_probe_read(&fs, sizeof(fs), &task->fs);
+
+    struct dentry *old_dentry = get_path_dentry(&fs->root);
+    syscall.chroot.old_path_key = get_key(old_dentry, &fs->root);
+
+    cache_syscall(&syscall);
+
+    resolve_dentry(old_dentry, syscall.chroot.old_path_key, NULL);
+
+    return 0;
+}
+
+SYSCALL_KPROBE0(chroot) {
+    return trace__sys_chroot(EVENT_CHROOT);
+}
+
+SYSCALL_KPROBE0(pivot_root) {
+    return trace__sys_chroot(EVENT_PIVOT_ROOT);
+}
+
+// set_fs_root is called by chroot to set the new root of the current task
+SEC("kprobe/set_fs_root")
+int kprobe__set_fs_root(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_CHROOT)
+        return 0;
+
+    struct path *path = (struct path *)PT_REGS_PARM2(ctx);
+    syscall->chroot.dentry = get_path_dentry(path);
+    syscall->chroot.path_key = get_key(syscall->chroot.dentry, path);
+
+    return 0;
+}
+
+// security_sb_pivotroot is called by pivot_root with the new root, before the mounts are moved
+SEC("kprobe/security_sb_pivotroot")
+int kprobe__security_sb_pivotroot(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_PIVOT_ROOT)
+        return 0;
+
+    struct path *path = (struct path *)PT_REGS_PARM2(ctx);
+    syscall->chroot.dentry = get_path_dentry(path);
+    syscall->chroot.path_key = get_key(syscall->chroot.dentry, path);
+
+    return 0;
+}
+
+int __attribute__((always_inline)) trace__sys_chroot_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct chroot_event_t event = {
+        .event.type = syscall->type,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .root = {
+            .inode = syscall->chroot.path_key.ino,
+            .mount_id = syscall->chroot.path_key.mount_id,
+        },
+        .old_root = {
+            .inode = syscall->chroot.old_path_key.ino,
+            .mount_id = syscall->chroot.old_path_key.mount_id,
+        },
+    };
+
+    if (syscall->chroot.dentry) {
+        event.root.overlay_numlower = get_overlay_numlower(syscall->chroot.dentry);
+        resolve_dentry(syscall->chroot.dentry, syscall->chroot.path_key, NULL);
+    }
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(chroot) {
+    return trace__sys_chroot_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(pivot_root) {
+    return trace__sys_chroot_ret(ctx);
+}
+
+#endif
@@ -0,0 +1,63 @@
+#ifndef _COMMIT_CREDS_H_
+#define _COMMIT_CREDS_H_
+
+#include "process.h"
+#include "container.h"
+
+struct task_credentials_t {
+    struct credentials_t ids;
+    struct capabilities_t caps;
+};
+
+struct commit_creds_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct task_credentials_t old;
+    struct task_credentials_t new;
+};
+
+static int __attribute__((always_inline)) credentials_changed(struct task_credentials_t *old, struct task_credentials_t *new) {
+    return old->ids.uid != new->ids.uid || old->ids.gid != new->ids.gid ||
+        old->ids.euid != new->ids.euid || old->ids.egid != new->ids.egid ||
+        old->ids.fsuid != new->ids.fsuid || old->ids.fsgid != new->ids.fsgid ||
+        old->caps.effective != new->caps.effective || old->caps.permitted != new->caps.permitted ||
+        old->caps.inheritable != new->caps.inheritable;
+}
+
+// commit_creds installs new credentials on the current task. It is the common path of every credential change
+// (setuid family, capset, execution of a setuid binary, keyctl, ...). It is also called on each exec, so only the
+// calls actually changing the credentials are reported.
+SEC("kprobe/commit_creds")
+int kprobe_commit_creds(struct pt_regs *ctx) {
+    struct cred *new_cred = (struct cred *)PT_REGS_PARM1(ctx);
+
+    struct task_struct *task = (struct task_struct *)bpf_get_current_task();
+    struct cred *old_cred;
+    bpf_probe_read(&old_cred, sizeof(old_cred), &task->cred);
+
+    struct commit_creds_event_t event = {
+        .event.type = EVENT_COMMIT_CREDS,
+        .syscall = {
+            .timestamp = bpf_ktime_get_ns(),
+        },
+    };
+
+    read_credentials(old_cred, &event.old.ids);
+    read_capabilities(old_cred, &event.old.caps);
+    read_credentials(new_cred, &event.new.ids);
+    read_capabilities(new_cred, &event.new.caps);
+
+    if (!credentials_changed(&event.old, &event.new))
+        return 0;
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
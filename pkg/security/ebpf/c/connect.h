@@ -0,0 +1,133 @@
+#ifndef _CONNECT_H_
+#define _CONNECT_H_
+
+#include <linux/errno.h>
+#include <linux/net.h>
+#include <net/sock.h>
+
+#include "syscalls.h"
+#include "process.h"
+
+struct socket_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u16 family;
+    u16 type;
+    u16 sport;
+    u16 dport;
+    u64 saddr[2];
+    u64 daddr[2];
+};
+
+int __attribute__((always_inline)) trace__sys_socket(u64 type) {
+    struct syscall_cache_t syscall = {
+        .type = type,
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KPROBE0(connect) {
+    return trace__sys_socket(EVENT_CONNECT);
+}
+
+SYSCALL_KPROBE0(bind) {
+    return trace__sys_socket(EVENT_BIND);
+}
+
+int __attribute__((always_inline)) trace__security_socket(struct pt_regs *ctx, u64 type) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != type)
+        return 0;
+
+    struct socket *sock = (struct socket *)PT_REGS_PARM1(ctx);
+
+    struct sock *sk;
+    bpf_probe_read(&sk, sizeof(sk), &sock->sk);
+
+    u16 family;
+    bpf_probe_read(&family, sizeof(family), &sk->__sk_common.skc_family);
+
+    // only internet sockets are reported
+    if (family != AF_INET && family != AF_INET6)
+        return 0;
+
+    syscall->socket.sock = sock;
+
+    return 0;
+}
+
+SEC("kprobe/security_socket_connect")
+int kprobe__security_socket_connect(struct pt_regs *ctx) {
+    return trace__security_socket(ctx, EVENT_CONNECT);
+}
+
+SEC("kprobe/security_socket_bind")
+int kprobe__security_socket_bind(struct pt_regs *ctx) {
+    return trace__security_socket(ctx, EVENT_BIND);
+}
+
+static void __attribute__((always_inline)) fill_socket_data(struct socket *sock, struct socket_event_t *event) {
+    struct sock *sk;
+    bpf_probe_read(&sk, sizeof(sk), &sock->sk);
+
+    short type;
+    bpf_probe_read(&type, sizeof(type), &sock->type);
+    event->type = type;
+
+    bpf_probe_read(&event->family, sizeof(event->family), &sk->__sk_common.skc_family);
+    bpf_probe_read(&event->sport, sizeof(event->sport), &sk->__sk_common.skc_num);
+    // the destination port is kept in network byte order
+    bpf_probe_read(&event->dport, sizeof(event->dport), &sk->__sk_common.skc_dport);
+
+    if (event->family == AF_INET) {
+        bpf_probe_read(&event->saddr, sizeof(u32), &sk->__sk_common.skc_rcv_saddr);
+        bpf_probe_read(&event->daddr, sizeof(u32), &sk->__sk_common.skc_daddr);
+    } else {
+        bpf_probe_read(&event->saddr, sizeof(event->saddr), &sk->__sk_common.skc_v6_rcv_saddr);
+        bpf_probe_read(&event->daddr, sizeof(event->daddr), &sk->__sk_common.skc_v6_daddr);
+    }
+}
+
+int __attribute__((always_inline)) trace__sys_socket_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall || !syscall->socket.sock)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    // non blocking connections are reported while they are in progress
+    if (IS_UNHANDLED_ERROR(retval) && retval != -EINPROGRESS)
+        return 0;
+
+    struct socket_event_t event = {
+        .event.type = syscall->type,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+    };
+
+    // the source address and port are only known once the syscall returns
+    fill_socket_data(syscall->socket.sock, &event);
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(connect) {
+    return trace__sys_socket_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(bind) {
+    return trace__sys_socket_ret(ctx);
+}
+
+#endif
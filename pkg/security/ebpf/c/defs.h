@@ -166,6 +166,7 @@
 
 #define TTY_NAME_LEN 64
 #define CONTAINER_ID_LEN 64
+#define KMODULE_NAME_LEN 56
 #define MAX_XATTR_NAME_LEN 200
 
 
@@ -193,6 +194,26 @@ enum event_type
     EVENT_UMOUNT,
     EVENT_SETXATTR,
     EVENT_REMOVEXATTR,
+    EVENT_SETUID,
+    EVENT_SETGID,
+    EVENT_PTRACE,
+    EVENT_FORK,
+    EVENT_EXIT,
+    EVENT_COMMIT_CREDS,
+    EVENT_INIT_MODULE,
+    EVENT_CONNECT,
+    EVENT_BIND,
+    EVENT_SETNS,
+    EVENT_BPF,
+    EVENT_CHROOT,
+    EVENT_PIVOT_ROOT,
+    EVENT_KILL,
+    EVENT_MEMFD_CREATE,
+    EVENT_CAPSET,
+    EVENT_IO_URING,
+    EVENT_PRCTL,
+    EVENT_SYMLINK,
+    EVENT_TRUNCATE,
     EVENT_EXEC,
 };
 
@@ -219,16 +240,40 @@ struct process_context_t {
     u32 tid;
     u32 uid;
     u32 gid;
+    u32 cookie;
+    u32 padding;
     struct file_t executable;
+    struct file_t script;
+    u32 mntns;
+    u32 netns;
+};
+
+struct credentials_t {
+    u32 uid;
+    u32 gid;
+    u32 euid;
+    u32 egid;
+    u32 fsuid;
+    u32 fsgid;
+};
+
+struct capabilities_t {
+    u64 effective;
+    u64 permitted;
+    u64 inheritable;
 };
 
 struct container_context_t {
     char container_id[CONTAINER_ID_LEN];
+    struct file_t cgroup;
 };
 
 struct proc_cache_t {
     struct file_t executable;
+    struct file_t script;
     char container_id[CONTAINER_ID_LEN];
+    // cgroup is the control group directory the process was moved to, its path is resolved in the dentry cache
+    struct file_t cgroup;
 };
 
 struct bpf_map_def SEC("maps/events") events = {
@@ -1,26 +1,17 @@
 #ifndef _EXEC_H_
 #define _EXEC_H_
 
+#include <linux/binfmts.h>
+
 #include "filters.h"
 #include "syscalls.h"
 #include "container.h"
 
-struct _tracepoint_sched_process_fork
-{
-    unsigned short common_type;
-    unsigned char common_flags;
-    unsigned char common_preempt_count;
-    int common_pid;
-
-    char parent_comm[16];
-    pid_t parent_pid;
-    char child_comm[16];
-    pid_t child_pid;
-};
-
 void __attribute__((always_inline)) copy_proc_cache(struct proc_cache_t *dst, struct proc_cache_t *src) {
     dst->executable = src->executable;
+    dst->script = src->script;
     copy_container_id(dst->container_id, src->container_id);
+    dst->cgroup = src->cgroup;
     return;
 }
 
@@ -42,22 +33,201 @@ struct bpf_map_def SEC("maps/pid_cookie") pid_cookie = {
     .namespace = "",
 };
 
-int __attribute__((always_inline)) trace__sys_execveat() {
+// Only the first MAX_EXEC_ARGS arguments are captured, each of them being
+// truncated to MAX_EXEC_ARG_LEN bytes (including the trailing NUL byte)
+#define MAX_EXEC_ARGS 12
+#define MAX_EXEC_ARG_LEN 64
+
+// Only the environment variables listed in exec_env_allowlist (at most MAX_EXEC_ENV_ALLOWLIST
+// names) are captured. The first MAX_EXEC_ENVS_SCAN variables are checked and at most
+// MAX_EXEC_ENVS of them are kept, each of them being truncated to MAX_EXEC_ENV_LEN bytes (including the trailing NUL byte)
+#define MAX_EXEC_ENVS_SCAN 32
+#define MAX_EXEC_ENVS 4
+#define MAX_EXEC_ENV_LEN 128
+#define MAX_EXEC_ENV_NAME_LEN 32
+#define MAX_EXEC_ENV_ALLOWLIST 16
+
+struct exec_args_t {
+    u32 count;
+    u32 truncated;
+    char args[MAX_EXEC_ARGS][MAX_EXEC_ARG_LEN];
+    u32 envs_count;
+    u32 envs_truncated;
+    char envs[MAX_EXEC_ENVS][MAX_EXEC_ENV_LEN];
+};
+
+struct bpf_map_def SEC("maps/exec_env_allowlist") exec_env_allowlist = {
+    .type = BPF_MAP_TYPE_HASH,
+    .key_size = MAX_EXEC_ENV_NAME_LEN,
+    .value_size = sizeof(u8),
+    .max_entries = MAX_EXEC_ENV_ALLOWLIST,
+    .pinning = 0,
+    .namespace = "",
+};
+
+// exec_args_gen is used as a scratch buffer as struct exec_args_t doesn't fit on the eBPF stack
+struct bpf_map_def SEC("maps/exec_args_gen") exec_args_gen = {
+    .type = BPF_MAP_TYPE_PERCPU_ARRAY,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(struct exec_args_t),
+    .max_entries = 1,
+    .pinning = 0,
+    .namespace = "",
+};
+
+// exec_args_cache holds the arguments of an execve call until the process cache entry is created
+struct bpf_map_def SEC("maps/exec_args_cache") exec_args_cache = {
+    .type = BPF_MAP_TYPE_LRU_HASH,
+    .key_size = sizeof(u64),
+    .value_size = sizeof(struct exec_args_t),
+    .max_entries = 512,
+    .pinning = 0,
+    .namespace = "",
+};
+
+struct bpf_map_def SEC("maps/exec_args") exec_args = {
+    .type = BPF_MAP_TYPE_LRU_HASH,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(struct exec_args_t),
+    .max_entries = 4095,
+    .pinning = 0,
+    .namespace = "",
+};
+
+// Only the first MEMFD_NAME_LEN bytes of the name given to memfd_create are captured (including the trailing NUL byte)
+#define MEMFD_NAME_LEN 64
+
+struct memfd_t {
+    char name[MEMFD_NAME_LEN];
+};
+
+// memfd_names holds the name of the files created by memfd_create, indexed by path key, until they are executed
+struct bpf_map_def SEC("maps/memfd_names") memfd_names = {
+    .type = BPF_MAP_TYPE_LRU_HASH,
+    .key_size = sizeof(struct path_key_t),
+    .value_size = sizeof(struct memfd_t),
+    .max_entries = 1024,
+    .pinning = 0,
+    .namespace = "",
+};
+
+// exec_memfd holds the memfd_create file each fileless process cache entry was executed from
+struct bpf_map_def SEC("maps/exec_memfd") exec_memfd = {
+    .type = BPF_MAP_TYPE_LRU_HASH,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(struct memfd_t),
+    .max_entries = 4095,
+    .pinning = 0,
+    .namespace = "",
+};
+
+// bpf_probe_read_str returns the buffer size both when the string fits exactly and when it was
+// truncated, look at the last byte of the source string to tell them apart
+int __attribute__((always_inline)) is_str_truncated(const char *src, int len, int size) {
+    if (len < size)
+        return 0;
+
+    char c = 0;
+    bpf_probe_read(&c, sizeof(c), (void *)src + size - 1);
+    return c != 0;
+}
+
+void __attribute__((always_inline)) fill_exec_envs(struct exec_args_t *args, const char **envp) {
+    args->envs_count = 0;
+    args->envs_truncated = 0;
+
+    const char *env;
+    char name[MAX_EXEC_ENV_NAME_LEN];
+#pragma unroll
+    for (int i = 0; i < MAX_EXEC_ENVS_SCAN; i++) {
+        env = NULL;
+        bpf_probe_read(&env, sizeof(env), &envp[i]);
+        if (!env)
+            break;
+
+        __builtin_memset(name, 0, sizeof(name));
+        bpf_probe_read_str(name, sizeof(name), env);
+
+        // keep only the name of the variable to look it up in the allowlist
+        int found = 0;
+#pragma unroll
+        for (int j = 0; j < MAX_EXEC_ENV_NAME_LEN; j++) {
+            if (name[j] == '=')
+                found = 1;
+            if (found)
+                name[j] = 0;
+        }
+
+        if (!found || !bpf_map_lookup_elem(&exec_env_allowlist, name))
+            continue;
+
+        u32 index = args->envs_count;
+        if (index >= MAX_EXEC_ENVS) {
+            args->envs_truncated = 1;
+            break;
+        }
+
+        int len = bpf_probe_read_str(args->envs[index], MAX_EXEC_ENV_LEN, env);
+        if (is_str_truncated(env, len, MAX_EXEC_ENV_LEN))
+            args->envs_truncated = 1;
+        args->envs_count++;
+    }
+}
+
+void __attribute__((always_inline)) cache_exec_args(const char **argv, const char **envp) {
+    u32 key = 0;
+    struct exec_args_t *args = bpf_map_lookup_elem(&exec_args_gen, &key);
+    if (!args)
+        return;
+
+    args->count = 0;
+    args->truncated = 0;
+
+    const char *arg;
+#pragma unroll
+    for (int i = 0; i < MAX_EXEC_ARGS; i++) {
+        arg = NULL;
+        bpf_probe_read(&arg, sizeof(arg), &argv[i]);
+        if (!arg)
+            break;
+
+        int len = bpf_probe_read_str(args->args[i], MAX_EXEC_ARG_LEN, arg);
+        if (is_str_truncated(arg, len, MAX_EXEC_ARG_LEN))
+            args->truncated = 1;
+        args->count++;
+    }
+
+    // more arguments than the ones we captured
+    if (args->count == MAX_EXEC_ARGS) {
+        arg = NULL;
+        bpf_probe_read(&arg, sizeof(arg), &argv[MAX_EXEC_ARGS]);
+        if (arg)
+            args->truncated = 1;
+    }
+
+    fill_exec_envs(args, envp);
+
+    u64 pid_tgid = bpf_get_current_pid_tgid();
+    bpf_map_update_elem(&exec_args_cache, &pid_tgid, args, BPF_ANY);
+}
+
+int __attribute__((always_inline)) trace__sys_execveat(const char **argv, const char **envp) {
     struct syscall_cache_t syscall = {
         .type = EVENT_EXEC,
     };
 
     cache_syscall(&syscall);
+    cache_exec_args(argv, envp);
 
     return 0;
 }
 
-SYSCALL_KPROBE0(execve) {
-    return trace__sys_execveat();
+SYSCALL_KPROBE3(execve, const char *, filename, const char **, argv, const char **, envp) {
+    return trace__sys_execveat(argv, envp);
 }
 
-SYSCALL_KPROBE0(execveat) {
-    return trace__sys_execveat();
+SYSCALL_KPROBE4(execveat, int, fd, const char *, filename, const char **, argv, const char **, envp) {
+    return trace__sys_execveat(argv, envp);
 }
 
 struct proc_cache_t * __attribute__((always_inline)) get_pid_cache(u32 tgid) {
@@ -73,6 +243,10 @@ struct proc_cache_t * __attribute__((always_inline)) get_pid_cache(u32 tgid) {
 }
 
 int __attribute__((always_inline)) vfs_handle_exec_event(struct pt_regs *ctx, struct syscall_cache_t *syscall) {
+    // the interpreter of a script, and the elf interpreter, are opened by the same syscall
+    if (syscall->exec.cookie)
+        return 0;
+
     struct path *path = (struct path *)PT_REGS_PARM1(ctx);
 
     // new cache entry
@@ -91,8 +265,9 @@ int __attribute__((always_inline)) vfs_handle_exec_event(struct pt_regs *ctx, st
 
     struct proc_cache_t *parent_entry = get_pid_cache(tgid);
     if (parent_entry) {
-        // inherit container ID
+        // inherit container ID and control group
         copy_container_id(entry.container_id, parent_entry->container_id);
+        entry.cgroup = parent_entry->cgroup;
     }
 
     // insert new proc cache entry
@@ -102,41 +277,76 @@ int __attribute__((always_inline)) vfs_handle_exec_event(struct pt_regs *ctx, st
     // insert pid <-> cookie mapping
     bpf_map_update_elem(&pid_cookie, &tgid, &cookie, BPF_ANY);
 
-    pop_syscall();
+    // attach the arguments captured at syscall entry to the new process cache entry
+    struct exec_args_t *args = bpf_map_lookup_elem(&exec_args_cache, &pid_tgid);
+    if (args) {
+        bpf_map_update_elem(&exec_args, &cookie, args, BPF_ANY);
+        bpf_map_delete_elem(&exec_args_cache, &pid_tgid);
+    }
+
+    syscall->exec.cookie = cookie;
 
     return 0;
 }
 
-SEC("tracepoint/sched/sched_process_fork")
-int sched_process_fork(struct _tracepoint_sched_process_fork *args)
-{
-    u32 pid = 0;
-    u32 ppid = 0;
-    bpf_probe_read(&pid, sizeof(pid), &args->child_pid);
-    bpf_probe_read(&ppid, sizeof(ppid), &args->parent_pid);
+// security_bprm_check is called for each binary handler looked up by exec: once for the file that was
+// executed, and once more for the interpreter of a script. bprm->file is the file being loaded, which is also
+// the file backing the descriptor of an execveat call with AT_EMPTY_PATH.
+SEC("kprobe/security_bprm_check")
+int kprobe__security_bprm_check(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_EXEC || !syscall->exec.cookie)
+        return 0;
 
-    // Ensures pid and ppid point to the same cookie
-    u32 *cookie = (u32 *) bpf_map_lookup_elem(&pid_cookie, &ppid);
-    if (cookie) {
-        // Select the old cache entry
-        u32 cookie_key = *cookie;
-        bpf_map_update_elem(&pid_cookie, &pid, &cookie_key, BPF_ANY);
+    syscall->exec.depth++;
+
+    struct linux_binprm *bprm = (struct linux_binprm *)PT_REGS_PARM1(ctx);
+    struct file *file;
+    bpf_probe_read(&file, sizeof(file), &bprm->file);
+
+    struct dentry *dentry = get_file_dentry(file);
+    struct path_key_t key = get_key(dentry, &file->f_path);
+    resolve_dentry(dentry, key, NULL);
+
+    if (syscall->exec.depth < 2) {
+        // a process executed from a file created by memfd_create, usually with execveat, runs from anonymous
+        // memory and doesn't leave any file on disk
+        struct memfd_t *memfd = bpf_map_lookup_elem(&memfd_names, &key);
+        if (memfd) {
+            u32 cookie = syscall->exec.cookie;
+            bpf_map_update_elem(&exec_memfd, &cookie, memfd, BPF_ANY);
+            bpf_map_delete_elem(&memfd_names, &key);
+        }
+        return 0;
     }
+
+    u32 cookie = syscall->exec.cookie;
+    struct proc_cache_t *entry = bpf_map_lookup_elem(&proc_cache, &cookie);
+    if (!entry)
+        return 0;
+
+    // keep the file that was invoked as the script, the interpreter becomes the executable
+    if (!entry->script.inode)
+        entry->script = entry->executable;
+
+    entry->executable.inode = key.ino;
+    entry->executable.mount_id = key.mount_id;
+    entry->executable.overlay_numlower = get_overlay_numlower(dentry);
+
     return 0;
 }
 
-SEC("kprobe/do_exit")
-int kprobe_do_exit(struct pt_regs *ctx) {
-    u64 pid_tgid = bpf_get_current_pid_tgid();
-    u32 tgid = pid_tgid >> 32;
-    u32 pid = pid_tgid;
-
-    // Delete pid <-> cookie mapping
-    if (tgid == pid) {
-        bpf_map_delete_elem(&pid_cookie, &tgid);
-    }
-    // (do not delete cookie <-> proc_cache entry since it can be used by a parent process)
+int __attribute__((always_inline)) trace__sys_execveat_ret(struct pt_regs *ctx) {
+    pop_syscall();
     return 0;
 }
 
+SYSCALL_KRETPROBE(execve) {
+    return trace__sys_execveat_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(execveat) {
+    return trace__sys_execveat_ret(ctx);
+}
+
 #endif
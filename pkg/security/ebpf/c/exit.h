@@ -0,0 +1,63 @@
+#ifndef _EXIT_H_
+#define _EXIT_H_
+
+#include "filters.h"
+#include "process.h"
+#include "container.h"
+
+struct bpf_map_def SEC("maps/exit_policy") exit_policy = {
+    .type = BPF_MAP_TYPE_ARRAY,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(struct policy_t),
+    .max_entries = 1,
+    .pinning = 0,
+    .namespace = "",
+};
+
+struct exit_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u32 code;
+    u32 padding;
+};
+
+// do_exit is called by every exiting thread, only the exit of the thread group leader is reported. The exit
+// code is the one given to exit_group or built from the fatal signal by do_group_exit.
+SEC("kprobe/do_exit")
+int kprobe_do_exit(struct pt_regs *ctx) {
+    u64 pid_tgid = bpf_get_current_pid_tgid();
+    u32 tgid = pid_tgid >> 32;
+    u32 pid = pid_tgid;
+
+    if (tgid != pid)
+        return 0;
+
+    // the policy is only set when exit rules are loaded, the pid <-> cookie mapping has to be deleted in any case
+    u32 key = 0;
+    struct policy_t *policy = bpf_map_lookup_elem(&exit_policy, &key);
+    if (policy && policy->mode) {
+        struct exit_event_t event = {
+            .event.type = EVENT_EXIT,
+            .syscall = {
+                .timestamp = bpf_ktime_get_ns(),
+            },
+            .code = (u32)PT_REGS_PARM1(ctx),
+        };
+
+        // the process cache entry is still reachable through the pid <-> cookie mapping at this point
+        struct proc_cache_t *entry = fill_process_data(&event.process);
+        fill_container_data(entry, &event.container);
+
+        send_event(ctx, event);
+    }
+
+    // Delete pid <-> cookie mapping
+    // (do not delete cookie <-> proc_cache entry since it can be used by a parent process)
+    bpf_map_delete_elem(&pid_cookie, &tgid);
+
+    return 0;
+}
+
+#endif
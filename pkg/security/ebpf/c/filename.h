@@ -16,6 +16,9 @@ int kprobe__filename_create(struct pt_regs *ctx) {
        case EVENT_LINK:
             syscall->link.target_path = (struct path *)PT_REGS_PARM3(ctx);
             break;
+       case EVENT_SYMLINK:
+            syscall->symlink.dir = (struct path *)PT_REGS_PARM3(ctx);
+            break;
     }
     return 0;
 }
@@ -0,0 +1,124 @@
+#ifndef _FORK_H_
+#define _FORK_H_
+
+#include "syscalls.h"
+#include "process.h"
+#include "container.h"
+
+struct _tracepoint_sched_process_fork
+{
+    unsigned short common_type;
+    unsigned char common_flags;
+    unsigned char common_preempt_count;
+    int common_pid;
+
+    char parent_comm[16];
+    pid_t parent_pid;
+    char child_comm[16];
+    pid_t child_pid;
+};
+
+struct fork_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u32 child_pid;
+    u32 padding;
+};
+
+int __attribute__((always_inline)) trace__sys_fork(u64 flags) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_FORK,
+        .fork = {
+            .flags = flags,
+        }
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KPROBE0(fork) {
+    return trace__sys_fork(0);
+}
+
+SYSCALL_KPROBE0(vfork) {
+    return trace__sys_fork(CLONE_VFORK | CLONE_VM);
+}
+
+SYSCALL_KPROBE1(clone, unsigned long, flags) {
+    return trace__sys_fork(flags);
+}
+
+SYSCALL_KPROBE1(clone3, void *, uargs) {
+    // flags is the first field of struct clone_args
+    u64 flags = 0;
+    bpf_probe_read(&flags, sizeof(flags), uargs);
+
+    return trace__sys_fork(flags);
+}
+
+SEC("tracepoint/sched/sched_process_fork")
+int sched_process_fork(struct _tracepoint_sched_process_fork *args)
+{
+    u32 pid = 0;
+    u32 ppid = 0;
+    bpf_probe_read(&pid, sizeof(pid), &args->child_pid);
+    bpf_probe_read(&ppid, sizeof(ppid), &args->parent_pid);
+
+    // Ensures pid and ppid point to the same cookie
+    u32 *cookie = (u32 *) bpf_map_lookup_elem(&pid_cookie, &ppid);
+    if (cookie) {
+        // Select the old cache entry
+        u32 cookie_key = *cookie;
+        bpf_map_update_elem(&pid_cookie, &pid, &cookie_key, BPF_ANY);
+    }
+
+    // the tracepoint is triggered in the context of the parent, only report new processes, not new threads
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_FORK || syscall->fork.flags & CLONE_THREAD)
+        return 0;
+
+    struct fork_event_t event = {
+        .event.type = EVENT_FORK,
+        .syscall = {
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .child_pid = pid,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(args, event);
+
+    return 0;
+}
+
+int __attribute__((always_inline)) trace__sys_fork_ret() {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (syscall && syscall->type == EVENT_FORK)
+        pop_syscall();
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(fork) {
+    return trace__sys_fork_ret();
+}
+
+SYSCALL_KRETPROBE(vfork) {
+    return trace__sys_fork_ret();
+}
+
+SYSCALL_KRETPROBE(clone) {
+    return trace__sys_fork_ret();
+}
+
+SYSCALL_KRETPROBE(clone3) {
+    return trace__sys_fork_ret();
+}
+
+#endif
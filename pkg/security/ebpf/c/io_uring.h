@@ -0,0 +1,136 @@
+#ifndef _IO_URING_H_
+#define _IO_URING_H_
+
+#include "syscalls.h"
+#include "process.h"
+#include "container.h"
+#include "setns.h"
+
+enum io_uring_operation
+{
+    IO_URING_OPEN = 1,
+    IO_URING_READ,
+    IO_URING_WRITE,
+    IO_URING_CONNECT,
+};
+
+struct io_uring_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t file;
+    u32 operation;
+    u32 padding;
+};
+
+static int __attribute__((always_inline)) trace_io_uring_operation(struct pt_regs *ctx, u32 operation) {
+    // the per operation structures of the union at the start of struct io_kiocb all begin with the file the
+    // request targets, which is resolved from the descriptor of the submission before the operation is issued.
+    // An open request has no file yet, the file it opened is looked up from the returned descriptor.
+    struct file *file = NULL;
+    if (operation != IO_URING_OPEN) {
+        void *req = (void *)PT_REGS_PARM1(ctx);
+        bpf_probe_read(&file, sizeof(file), req);
+    }
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_IO_URING,
+        .io_uring = {
+            .operation = operation,
+            .file = file,
+        }
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+static int __attribute__((always_inline)) trace_io_uring_operation_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall || syscall->type != EVENT_IO_URING)
+        return 0;
+
+    // a request that would block returns -EAGAIN and is issued again from the io-wq workers, only report it
+    // once it was actually issued
+    int retval = PT_REGS_RC(ctx);
+    if (retval == -EAGAIN)
+        return 0;
+
+    struct io_uring_event_t event = {
+        .event.type = EVENT_IO_URING,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .operation = syscall->io_uring.operation,
+    };
+
+    struct file *file = syscall->io_uring.file;
+    if (event.operation == IO_URING_OPEN && retval >= 0)
+        file = get_fd_file(retval);
+
+    if (file) {
+        struct dentry *dentry = get_file_dentry(file);
+        struct path_key_t key = get_key(dentry, &file->f_path);
+        event.file.inode = key.ino;
+        event.file.mount_id = key.mount_id;
+        event.file.overlay_numlower = get_overlay_numlower(dentry);
+        resolve_dentry(dentry, key, NULL);
+    }
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+// io_uring requests are issued by the opcode specific functions of fs/io_uring.c, either inline from io_uring_enter
+// or from the io-wq workers of the submitting process, without going through the syscalls hooked by the other
+// probes. struct io_kiocb is private to fs/io_uring.c, so the operation is identified by the function called
+// instead of the opcode of the request. io_openat2 handles both IORING_OP_OPENAT and IORING_OP_OPENAT2, io_read
+// and io_write the vectored, fixed and plain variants.
+SEC("kprobe/io_openat2")
+int kprobe_io_openat2(struct pt_regs *ctx) {
+    return trace_io_uring_operation(ctx, IO_URING_OPEN);
+}
+
+SEC("kretprobe/io_openat2")
+int kretprobe_io_openat2(struct pt_regs *ctx) {
+    return trace_io_uring_operation_ret(ctx);
+}
+
+SEC("kprobe/io_read")
+int kprobe_io_read(struct pt_regs *ctx) {
+    return trace_io_uring_operation(ctx, IO_URING_READ);
+}
+
+SEC("kretprobe/io_read")
+int kretprobe_io_read(struct pt_regs *ctx) {
+    return trace_io_uring_operation_ret(ctx);
+}
+
+SEC("kprobe/io_write")
+int kprobe_io_write(struct pt_regs *ctx) {
+    return trace_io_uring_operation(ctx, IO_URING_WRITE);
+}
+
+SEC("kretprobe/io_write")
+int kretprobe_io_write(struct pt_regs *ctx) {
+    return trace_io_uring_operation_ret(ctx);
+}
+
+SEC("kprobe/io_connect")
+int kprobe_io_connect(struct pt_regs *ctx) {
+    return trace_io_uring_operation(ctx, IO_URING_CONNECT);
+}
+
+SEC("kretprobe/io_connect")
+int kretprobe_io_connect(struct pt_regs *ctx) {
+    return trace_io_uring_operation_ret(ctx);
+}
+
+#endif
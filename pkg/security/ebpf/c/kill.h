@@ -0,0 +1,74 @@
+#ifndef _KILL_H_
+#define _KILL_H_
+
+#include "syscalls.h"
+#include "process.h"
+
+struct kill_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u32 pid;
+    u32 sig;
+};
+
+int __attribute__((always_inline)) trace__sys_kill(u32 pid, u32 sig) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_KILL,
+        .kill = {
+            .pid = pid,
+            .sig = sig,
+        }
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KPROBE2(kill, int, pid, int, sig) {
+    return trace__sys_kill((u32)pid, (u32)sig);
+}
+
+// tgkill sends the signal to a thread of the thread group, the thread group is reported as the target
+SYSCALL_KPROBE3(tgkill, int, tgid, int, pid, int, sig) {
+    return trace__sys_kill((u32)tgid, (u32)sig);
+}
+
+int __attribute__((always_inline)) trace__sys_kill_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct kill_event_t event = {
+        .event.type = EVENT_KILL,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .pid = syscall->kill.pid,
+        .sig = syscall->kill.sig,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(kill) {
+    return trace__sys_kill_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(tgkill) {
+    return trace__sys_kill_ret(ctx);
+}
+
+#endif
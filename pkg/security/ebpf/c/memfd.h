@@ -0,0 +1,72 @@
+#ifndef _MEMFD_H_
+#define _MEMFD_H_
+
+#include "syscalls.h"
+#include "process.h"
+#include "setns.h"
+
+struct memfd_create_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u32 flags;
+    u32 padding;
+    char name[MEMFD_NAME_LEN];
+};
+
+SYSCALL_KPROBE2(memfd_create, const char *, uname, unsigned int, flags) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_MEMFD_CREATE,
+        .memfd = {
+            .name = uname,
+            .flags = flags,
+        }
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(memfd_create) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct memfd_create_event_t event = {
+        .event.type = EVENT_MEMFD_CREATE,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .flags = syscall->memfd.flags,
+    };
+    bpf_probe_read_str(event.name, sizeof(event.name), (void *)syscall->memfd.name);
+
+    // the returned file descriptor is the only reference to the file, keep its name by path key to report the
+    // process executed from it. The mount id is the internal mount of memfd files, so that a file on disk with
+    // the same inode number isn't mistaken for it.
+    if (retval >= 0) {
+        struct file *file = get_fd_file(retval);
+        if (file) {
+            struct path_key_t key = get_key(get_file_dentry(file), &file->f_path);
+            struct memfd_t memfd = {};
+            bpf_probe_read_str(memfd.name, sizeof(memfd.name), (void *)syscall->memfd.name);
+            bpf_map_update_elem(&memfd_names, &key, &memfd, BPF_ANY);
+        }
+    }
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
@@ -27,6 +27,11 @@ int kprobe__mnt_want_write(struct pt_regs *ctx) {
             return 0;
         syscall->setattr.path_key.mount_id = get_vfsmount_mount_id(mnt);
         break;
+    case EVENT_TRUNCATE:
+        if (syscall->setattr.path_key.mount_id > 0)
+            return 0;
+        syscall->setattr.path_key.mount_id = get_vfsmount_mount_id(mnt);
+        break;
     case EVENT_RENAME:
         if (syscall->rename.src_key.mount_id > 0)
             return 0;
@@ -0,0 +1,106 @@
+#ifndef _MODULE_H_
+#define _MODULE_H_
+
+#include <linux/module.h>
+
+#include "syscalls.h"
+#include "process.h"
+
+struct init_module_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t file;
+    char name[KMODULE_NAME_LEN];
+};
+
+int __attribute__((always_inline)) trace__sys_init_module() {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_INIT_MODULE,
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KPROBE0(init_module) {
+    return trace__sys_init_module();
+}
+
+SYSCALL_KPROBE0(finit_module) {
+    return trace__sys_init_module();
+}
+
+// security_kernel_read_file is called by finit_module to read the module from the given file descriptor
+SEC("kprobe/security_kernel_read_file")
+int kprobe__security_kernel_read_file(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_INIT_MODULE)
+        return 0;
+
+    struct file *file = (struct file *)PT_REGS_PARM1(ctx);
+    syscall->init_module.dentry = get_file_dentry(file);
+    syscall->init_module.path_key = get_key(syscall->init_module.dentry, &file->f_path);
+
+    return 0;
+}
+
+// do_init_module is called once the module was loaded, before its init function is run
+SEC("kprobe/do_init_module")
+int kprobe__do_init_module(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_INIT_MODULE)
+        return 0;
+
+    struct module *mod = (struct module *)PT_REGS_PARM1(ctx);
+    bpf_probe_read_str(&syscall->init_module.name, sizeof(syscall->init_module.name), &mod->name);
+
+    return 0;
+}
+
+int __attribute__((always_inline)) trace__sys_init_module_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct init_module_event_t event = {
+        .event.type = EVENT_INIT_MODULE,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .file = {
+            .inode = syscall->init_module.path_key.ino,
+            .mount_id = syscall->init_module.path_key.mount_id,
+        },
+    };
+    bpf_probe_read_str(&event.name, sizeof(event.name), syscall->init_module.name);
+
+    if (syscall->init_module.dentry) {
+        event.file.overlay_numlower = get_overlay_numlower(syscall->init_module.dentry);
+        resolve_dentry(syscall->init_module.dentry, syscall->init_module.path_key, NULL);
+    }
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(init_module) {
+    return trace__sys_init_module_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(finit_module) {
+    return trace__sys_init_module_ret(ctx);
+}
+
+#endif
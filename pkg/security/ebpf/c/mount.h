@@ -4,6 +4,7 @@
 #include "syscalls.h"
 
 #define FSTYPE_LEN 16
+#define MOUNT_SOURCE_LEN 64
 
 struct mount_event_t {
     struct kevent_t event;
@@ -19,12 +20,16 @@ struct mount_event_t {
     int root_mount_id;
     u32 padding;
     char fstype[FSTYPE_LEN];
+    u64 flags;
+    char source[MOUNT_SOURCE_LEN];
 };
 
-SYSCALL_COMPAT_KPROBE3(mount, const char*, source, const char*, target, const char*, fstype) {
+SYSCALL_COMPAT_KPROBE4(mount, const char*, source, const char*, target, const char*, fstype, unsigned long, flags) {
     struct syscall_cache_t syscall = {
         .mount = {
-            .fstype = fstype
+            .source = source,
+            .fstype = fstype,
+            .flags = flags,
         }
     };
     cache_syscall(&syscall);
@@ -93,8 +98,10 @@ SYSCALL_COMPAT_KRETPROBE(mount) {
         .parent_ino = path_key.ino,
         .root_ino = syscall->mount.root_key.ino,
         .root_mount_id = syscall->mount.root_key.mount_id,
+        .flags = syscall->mount.flags,
     };
     bpf_probe_read_str(&event.fstype, FSTYPE_LEN, (void*) syscall->mount.fstype);
+    bpf_probe_read_str(&event.source, MOUNT_SOURCE_LEN, (void*) syscall->mount.source);
 
     if (event.new_mount_id == 0 && event.new_device == 0) {
         return 0;
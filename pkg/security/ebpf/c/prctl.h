@@ -0,0 +1,76 @@
+#ifndef _PRCTL_H_
+#define _PRCTL_H_
+
+#include <linux/prctl.h>
+
+#include "syscalls.h"
+#include "process.h"
+
+struct prctl_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u64 arg;
+    u32 option;
+    u32 padding;
+    char old_name[TASK_COMM_LEN];
+    char new_name[TASK_COMM_LEN];
+};
+
+SYSCALL_KPROBE2(prctl, int, option, unsigned long, arg2) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_PRCTL,
+        .prctl = {
+            .option = (u32)option,
+            .arg = arg2,
+        }
+    };
+
+    // the comm of the process is reported by the process context once the syscall returned, keep the one it had
+    // before a PR_SET_NAME
+    bpf_get_current_comm(&syscall.prctl.old_name, sizeof(syscall.prctl.old_name));
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(prctl) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct prctl_event_t event = {
+        .event.type = EVENT_PRCTL,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .option = syscall->prctl.option,
+        .arg = syscall->prctl.arg,
+    };
+
+#pragma unroll
+    for (int i = 0; i < TASK_COMM_LEN; i++)
+        event.old_name[i] = syscall->prctl.old_name[i];
+
+    // the argument of PR_SET_NAME is a user space pointer, the name actually set by the kernel is reported instead
+    if (event.option == PR_SET_NAME) {
+        event.arg = 0;
+        bpf_get_current_comm(&event.new_name, sizeof(event.new_name));
+    }
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
@@ -22,12 +22,29 @@
 #include "cgroup.h"
 #include "open.h"
 #include "utimes.h"
+#include "truncate.h"
 #include "mount.h"
 #include "umount.h"
 #include "link.h"
+#include "symlink.h"
 #include "raw_syscalls.h"
 #include "getattr.h"
 #include "setxattr.h"
+#include "setuid.h"
+#include "ptrace.h"
+#include "fork.h"
+#include "exit.h"
+#include "commit_creds.h"
+#include "module.h"
+#include "connect.h"
+#include "setns.h"
+#include "bpf.h"
+#include "chroot.h"
+#include "kill.h"
+#include "memfd.h"
+#include "capset.h"
+#include "io_uring.h"
+#include "prctl.h"
 
 __u32 _version SEC("version") = 0xFFFFFFFE;
 
@@ -3,18 +3,63 @@
 
 #include <linux/tty.h>
 #include <linux/sched.h>
+#include <linux/cred.h>
+#include <linux/nsproxy.h>
+#include <linux/pid_namespace.h>
+#include <net/net_namespace.h>
+
+#define MNT_NS_OFFSETOF_INUM 24 // offsetof(struct mnt_namespace, ns.inum) before 5.11
+
+// struct mnt_namespace is private to fs/mount.h, the offset of its inode number is set by userspace as it changed
+// in 5.11 with the move of the reference count to struct ns_common
+struct bpf_map_def SEC("maps/mnt_ns_inum_offset") mnt_ns_inum_offset = {
+    .type = BPF_MAP_TYPE_ARRAY,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(u32),
+    .max_entries = 1,
+    .pinning = 0,
+    .namespace = "",
+};
+
+u32 __attribute__((always_inline)) get_mnt_ns_offset_of_inum(void) {
+    u32 key = 0;
+    u32 *offset = bpf_map_lookup_elem(&mnt_ns_inum_offset, &key);
+    if (offset && *offset) {
+        return *offset;
+    }
+    return MNT_NS_OFFSETOF_INUM;
+}
+
+// fill_namespaces reports the inode numbers of the pid, mount and network namespaces of the current task, they are
+// available before the container of the task is resolved. The pid namespace is the one of the children of the task,
+// which only differs from its own after an unshare(CLONE_NEWPID).
+static void __attribute__((always_inline)) fill_namespaces(struct process_context_t *data) {
+    struct task_struct *task = (struct task_struct *)bpf_get_current_task();
+
+    struct nsproxy *nsproxy;
+    bpf_probe_read(&nsproxy, sizeof(nsproxy), &task->nsproxy);
+    // the namespaces of an exiting task are already released
+    if (!nsproxy)
+        return;
+
+    u32 inum = 0;
+    struct pid_namespace *pid_ns;
+    bpf_probe_read(&pid_ns, sizeof(pid_ns), &nsproxy->pid_ns_for_children);
+    bpf_probe_read(&inum, sizeof(inum), &pid_ns->ns.inum);
+    data->pidns = inum;
+
+    struct net *net_ns;
+    bpf_probe_read(&net_ns, sizeof(net_ns), &nsproxy->net_ns);
+    bpf_probe_read(&data->netns, sizeof(data->netns), &net_ns->ns.inum);
+
+    void *mnt_ns;
+    bpf_probe_read(&mnt_ns, sizeof(mnt_ns), &nsproxy->mnt_ns);
+    bpf_probe_read(&data->mntns, sizeof(data->mntns), mnt_ns + get_mnt_ns_offset_of_inum());
+}
 
 static struct proc_cache_t * __attribute__((always_inline)) fill_process_data(struct process_context_t *data) {
-//    // Process data
 //    struct task_struct *task = (struct task_struct *)bpf_get_current_task();
 //
-//    struct nsproxy *nsproxy;
-//    bpf_probe_read(&nsproxy, sizeof(nsproxy), &task->nsproxy);
-//
-//    struct pid_namespace *pid_ns;
-//    bpf_probe_read(&pid_ns, sizeof(pid_ns), &nsproxy->pid_ns_for_children);
-//    bpf_probe_read(&data->pidns, sizeof(data->pidns), &pid_ns->ns.inum);
-//
 //    // TTY
 //    struct signal_struct *signal;
 //    bpf_probe_read(&signal, sizeof(signal), &task->signal);
@@ -22,6 +67,8 @@ static struct proc_cache_t * __attribute__((always_inline)) fill_process_data(st
 //    bpf_probe_read(&tty, sizeof(tty), &signal->tty);
 //    bpf_probe_read_str(data->tty_name, TTY_NAME_LEN, tty->name);
 
+    fill_namespaces(data);
+
     // Comm
     bpf_get_current_comm(&data->comm, sizeof(data->comm));
 
@@ -38,12 +85,52 @@ static struct proc_cache_t * __attribute__((always_inline)) fill_process_data(st
     data->uid = userid >> 32;
     data->gid = userid;
 
+    u32 *cookie = (u32 *) bpf_map_lookup_elem(&pid_cookie, &tgid);
+    if (cookie) {
+        data->cookie = *cookie;
+    }
+
     struct proc_cache_t *entry = get_pid_cache(tgid);
     if (entry) {
         data->executable = entry->executable;
+        data->script = entry->script;
     }
 
     return entry;
 }
 
+static void __attribute__((always_inline)) read_credentials(struct cred *cred, struct credentials_t *credentials) {
+    bpf_probe_read(&credentials->uid, sizeof(credentials->uid), &cred->uid);
+    bpf_probe_read(&credentials->gid, sizeof(credentials->gid), &cred->gid);
+    bpf_probe_read(&credentials->euid, sizeof(credentials->euid), &cred->euid);
+    bpf_probe_read(&credentials->egid, sizeof(credentials->egid), &cred->egid);
+    bpf_probe_read(&credentials->fsuid, sizeof(credentials->fsuid), &cred->fsuid);
+    bpf_probe_read(&credentials->fsgid, sizeof(credentials->fsgid), &cred->fsgid);
+}
+
+static void __attribute__((always_inline)) read_capabilities(struct cred *cred, struct capabilities_t *capabilities) {
+    // kernel_cap_t is made of two u32, the lower one holding the first 32 capabilities
+    bpf_probe_read(&capabilities->effective, sizeof(capabilities->effective), &cred->cap_effective);
+    bpf_probe_read(&capabilities->permitted, sizeof(capabilities->permitted), &cred->cap_permitted);
+    bpf_probe_read(&capabilities->inheritable, sizeof(capabilities->inheritable), &cred->cap_inheritable);
+}
+
+static void __attribute__((always_inline)) fill_credentials(struct credentials_t *credentials) {
+    struct task_struct *task = (struct task_struct *)bpf_get_current_task();
+
+    struct cred *cred;
+    bpf_probe_read(&cred, sizeof(cred), &task->cred);
+
+    read_credentials(cred, credentials);
+}
+
+static void __attribute__((always_inline)) fill_capabilities(struct capabilities_t *capabilities) {
+    struct task_struct *task = (struct task_struct *)bpf_get_current_task();
+
+    struct cred *cred;
+    bpf_probe_read(&cred, sizeof(cred), &task->cred);
+
+    read_capabilities(cred, capabilities);
+}
+
 #endif
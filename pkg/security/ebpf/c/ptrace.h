@@ -0,0 +1,57 @@
+#ifndef _PTRACE_H_
+#define _PTRACE_H_
+
+#include "syscalls.h"
+#include "process.h"
+
+struct ptrace_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u32 request;
+    u32 pid;
+};
+
+SYSCALL_COMPAT_KPROBE2(ptrace, long, request, long, pid) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_PTRACE,
+        .ptrace = {
+            .request = (u32)request,
+            .pid = (u32)pid,
+        }
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_COMPAT_KRETPROBE(ptrace) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct ptrace_event_t event = {
+        .event.type = EVENT_PTRACE,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .request = syscall->ptrace.request,
+        .pid = syscall->ptrace.pid,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
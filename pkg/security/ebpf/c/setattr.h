@@ -25,7 +25,7 @@ int kprobe__security_inode_setattr(struct pt_regs *ctx) {
         }
     }
 
-    if (syscall->type == EVENT_UTIME || syscall->type == EVENT_CHMOD || syscall->type == EVENT_CHOWN) {
+    if (syscall->type == EVENT_UTIME || syscall->type == EVENT_CHMOD || syscall->type == EVENT_CHOWN || syscall->type == EVENT_TRUNCATE) {
         if (syscall->setattr.dentry)
             return 0;
         syscall->setattr.dentry = (struct dentry *)PT_REGS_PARM1(ctx);
@@ -0,0 +1,96 @@
+#ifndef _SETNS_H_
+#define _SETNS_H_
+
+#include <linux/fdtable.h>
+
+#include "syscalls.h"
+#include "process.h"
+
+struct setns_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u64 ns_inode;
+    u32 fd;
+    u32 nstype;
+};
+
+// get_fd_file returns the file behind the given file descriptor of the current task
+static struct file * __attribute__((always_inline)) get_fd_file(int fd) {
+    if (fd < 0)
+        return NULL;
+
+    struct task_struct *task = (struct task_struct *)bpf_get_current_task();
+
+    struct files_struct *files;
+    bpf_probe_read(&files, sizeof(files), &task->files);
+
+    struct fdtable *fdt;
+    bpf_probe_read(&fdt, sizeof(fdt), &files->fdt);
+
+    struct file **fds;
+    bpf_probe_read(&fds, sizeof(fds), &fdt->fd);
+
+    struct file *file = NULL;
+    bpf_probe_read(&file, sizeof(file), &fds[fd]);
+    return file;
+}
+
+// get_fd_inode returns the inode number of the file behind the given file descriptor of the current task. For a
+// namespace file, it is the inode number of the namespace.
+static u64 __attribute__((always_inline)) get_fd_inode(int fd) {
+    struct file *file = get_fd_file(fd);
+    if (!file)
+        return 0;
+
+    struct inode *inode;
+    bpf_probe_read(&inode, sizeof(inode), &file->f_inode);
+
+    return get_inode_ino(inode);
+}
+
+SYSCALL_KPROBE2(setns, int, fd, int, nstype) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_SETNS,
+        .setns = {
+            .fd = (u32)fd,
+            .nstype = (u32)nstype,
+            .ns_inode = get_fd_inode(fd),
+        }
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(setns) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct setns_event_t event = {
+        .event.type = EVENT_SETNS,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .ns_inode = syscall->setns.ns_inode,
+        .fd = syscall->setns.fd,
+        .nstype = syscall->setns.nstype,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
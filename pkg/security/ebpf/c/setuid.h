@@ -0,0 +1,121 @@
+#ifndef _SETUID_H_
+#define _SETUID_H_
+
+#include "syscalls.h"
+#include "process.h"
+
+struct setid_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct credentials_t old;
+    struct credentials_t new;
+};
+
+int __attribute__((always_inline)) trace__sys_setid(u64 type) {
+    struct syscall_cache_t syscall = {
+        .type = type,
+    };
+
+    // the credentials are resolved before they are updated by the syscall
+    fill_credentials(&syscall.setid.old);
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KPROBE0(setuid) {
+    return trace__sys_setid(EVENT_SETUID);
+}
+
+SYSCALL_KPROBE0(setreuid) {
+    return trace__sys_setid(EVENT_SETUID);
+}
+
+SYSCALL_KPROBE0(setresuid) {
+    return trace__sys_setid(EVENT_SETUID);
+}
+
+SYSCALL_KPROBE0(setfsuid) {
+    return trace__sys_setid(EVENT_SETUID);
+}
+
+SYSCALL_KPROBE0(setgid) {
+    return trace__sys_setid(EVENT_SETGID);
+}
+
+SYSCALL_KPROBE0(setregid) {
+    return trace__sys_setid(EVENT_SETGID);
+}
+
+SYSCALL_KPROBE0(setresgid) {
+    return trace__sys_setid(EVENT_SETGID);
+}
+
+SYSCALL_KPROBE0(setfsgid) {
+    return trace__sys_setid(EVENT_SETGID);
+}
+
+int __attribute__((always_inline)) trace__sys_setid_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct setid_event_t event = {
+        .event.type = syscall->type,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .old = syscall->setid.old,
+    };
+
+    fill_credentials(&event.new);
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(setuid) {
+    return trace__sys_setid_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(setreuid) {
+    return trace__sys_setid_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(setresuid) {
+    return trace__sys_setid_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(setfsuid) {
+    return trace__sys_setid_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(setgid) {
+    return trace__sys_setid_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(setregid) {
+    return trace__sys_setid_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(setresgid) {
+    return trace__sys_setid_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(setfsgid) {
+    return trace__sys_setid_ret(ctx);
+}
+
+#endif
@@ -0,0 +1,99 @@
+#ifndef _SYMLINK_H_
+#define _SYMLINK_H_
+
+#include "syscalls.h"
+
+// The target of a symbolic link is an arbitrary string, it is truncated to SYMLINK_TARGET_LEN bytes (including the
+// trailing NUL byte)
+#define SYMLINK_TARGET_LEN 128
+
+struct symlink_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t link;
+    char target[SYMLINK_TARGET_LEN];
+};
+
+int __attribute__((always_inline)) trace__sys_symlink(const char *target) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_SYMLINK,
+        .symlink = {
+            .target = target,
+        }
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KPROBE2(symlink, const char *, target, const char *, linkpath) {
+    return trace__sys_symlink(target);
+}
+
+SYSCALL_KPROBE3(symlinkat, const char *, target, int, newdirfd, const char *, linkpath) {
+    return trace__sys_symlink(target);
+}
+
+SEC("kprobe/vfs_symlink")
+int kprobe__vfs_symlink(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_SYMLINK)
+        return 0;
+    // In a container, vfs_symlink can be called multiple times to handle the different layers of the overlay filesystem.
+    // The first call is the only one we really care about, the subsequent calls contain paths to the overlay work layer.
+    if (syscall->symlink.dentry)
+        return 0;
+
+    syscall->symlink.dentry = (struct dentry *)PT_REGS_PARM2(ctx);
+    // dir was set by kprobe/filename_create before we reach this point
+    syscall->symlink.path_key = get_key(syscall->symlink.dentry, syscall->symlink.dir);
+    return 0;
+}
+
+int __attribute__((always_inline)) trace__sys_symlink_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    // the inode of the new link is only set once the link was created
+    syscall->symlink.path_key.ino = get_dentry_ino(syscall->symlink.dentry);
+    struct symlink_event_t event = {
+        .event.type = EVENT_SYMLINK,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .link = {
+            .inode = syscall->symlink.path_key.ino,
+            .mount_id = syscall->symlink.path_key.mount_id,
+            .overlay_numlower = get_overlay_numlower(syscall->symlink.dentry),
+        },
+    };
+    bpf_probe_read_str(event.target, sizeof(event.target), (void *)syscall->symlink.target);
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    resolve_dentry(syscall->symlink.dentry, syscall->symlink.path_key, NULL);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(symlink) {
+    return trace__sys_symlink_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(symlinkat) {
+    return trace__sys_symlink_ret(ctx);
+}
+
+#endif
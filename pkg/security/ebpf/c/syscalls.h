@@ -62,6 +62,7 @@ struct syscall_cache_t {
                     struct ktimeval atime;
                     struct ktimeval mtime;
                 };
+                u64 length;
             };
         } setattr;
 
@@ -70,13 +71,78 @@ struct syscall_cache_t {
             struct mount *dest_mnt;
             struct mountpoint *dest_mountpoint;
             struct path_key_t root_key;
+            const char *source;
             const char *fstype;
+            unsigned long flags;
         } mount;
 
         struct {
             struct vfsmount *vfs;
         } umount;
 
+        struct {
+            struct dentry *dentry;
+            struct path_key_t path_key;
+            char name[KMODULE_NAME_LEN];
+        } init_module;
+
+        struct {
+            struct socket *sock;
+        } socket;
+
+        struct {
+            u64 ns_inode;
+            u32 fd;
+            u32 nstype;
+        } setns;
+
+        struct {
+            u32 cmd;
+        } bpf;
+
+        struct {
+            u32 cookie;
+            u32 depth;
+        } exec;
+
+        struct {
+            u32 pid;
+            u32 sig;
+        } kill;
+
+        struct {
+            const char *name;
+            u32 flags;
+        } memfd;
+
+        struct {
+            struct capabilities_t old;
+        } capset;
+
+        struct {
+            u32 operation;
+            struct file *file;
+        } io_uring;
+
+        struct {
+            u64 arg;
+            u32 option;
+            char old_name[TASK_COMM_LEN];
+        } prctl;
+
+        struct {
+            const char *target;
+            struct path *dir;
+            struct dentry *dentry;
+            struct path_key_t path_key;
+        } symlink;
+
+        struct {
+            struct dentry *dentry;
+            struct path_key_t path_key;
+            struct path_key_t old_path_key;
+        } chroot;
+
         struct {
             struct path_key_t src_key;
             struct path *target_path;
@@ -90,6 +156,19 @@ struct syscall_cache_t {
             struct path_key_t path_key;
             const char *name;
         } setxattr;
+
+        struct {
+            struct credentials_t old;
+        } setid;
+
+        struct {
+            u32 request;
+            u32 pid;
+        } ptrace;
+
+        struct {
+            u64 flags;
+        } fork;
     };
 };
 
@@ -0,0 +1,87 @@
+#ifndef _TRUNCATE_H_
+#define _TRUNCATE_H_
+
+#include "syscalls.h"
+#include "setns.h"
+
+struct truncate_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t file;
+    u64 length;
+};
+
+int __attribute__((always_inline)) trace__sys_truncate(u64 length, int mount_id) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_TRUNCATE,
+        .setattr = {
+            .path_key = {
+                .mount_id = mount_id,
+            },
+            .length = length,
+        }
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+// the mount id of the truncated file is resolved by kprobe/mnt_want_write, and its dentry by
+// kprobe/security_inode_setattr
+SYSCALL_KPROBE2(truncate, const char *, path, long, length) {
+    return trace__sys_truncate((u64)length, 0);
+}
+
+// ftruncate doesn't take a write reference on the mount, the mount id is resolved from the file descriptor
+SYSCALL_KPROBE2(ftruncate, unsigned int, fd, unsigned long, length) {
+    int mount_id = 0;
+    struct file *file = get_fd_file(fd);
+    if (file)
+        mount_id = get_path_mount_id(&file->f_path);
+
+    return trace__sys_truncate((u64)length, mount_id);
+}
+
+int __attribute__((always_inline)) trace__sys_truncate_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct truncate_event_t event = {
+        .event.type = EVENT_TRUNCATE,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .file = {
+            .inode = syscall->setattr.path_key.ino,
+            .mount_id = syscall->setattr.path_key.mount_id,
+            .overlay_numlower = get_overlay_numlower(syscall->setattr.dentry),
+        },
+        .length = syscall->setattr.length,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(truncate) {
+    return trace__sys_truncate_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(ftruncate) {
+    return trace__sys_truncate_ret(ctx);
+}
+
+#endif
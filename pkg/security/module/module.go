@@ -10,11 +10,13 @@ package module
 import (
 	"context"
 	"encoding/json"
+	"expvar"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -42,6 +44,27 @@ type Module struct {
 	listener     net.Listener
 	statsdClient *statsd.Client
 	rateLimiter  *RateLimiter
+	rsa          *sprobe.RuleSetApplier
+	reportLock   sync.RWMutex
+	report       *sprobe.Report
+}
+
+var (
+	// registeredModule is the module whose hook points report is published through expvar
+	registeredModule     *Module
+	registeredModuleLock sync.RWMutex
+)
+
+func init() {
+	expvar.Publish("runtime_security_hook_points", expvar.Func(func() interface{} {
+		registeredModuleLock.RLock()
+		defer registeredModuleLock.RUnlock()
+
+		if registeredModule == nil {
+			return nil
+		}
+		return registeredModule.GetHookPointsStatus()
+	}))
 }
 
 // Register the runtime security agent module
@@ -65,6 +88,21 @@ func (m *Module) Register(httpMux *http.ServeMux) error {
 		}
 	}()
 
+	// the functional tests register the module without any HTTP mux
+	if httpMux != nil {
+		httpMux.HandleFunc("/runtime_security/hook_points/enable", func(w http.ResponseWriter, req *http.Request) {
+			m.handleHookPointToggle(w, req, func(name string) error {
+				return m.probe.EnableHookPoint(name, m.ruleSet, m.rsa)
+			})
+		})
+
+		httpMux.HandleFunc("/runtime_security/hook_points/disable", func(w http.ResponseWriter, req *http.Request) {
+			m.handleHookPointToggle(w, req, func(name string) error {
+				return m.probe.DisableHookPoint(name, m.rsa)
+			})
+		})
+	}
+
 	m.probe.SetEventHandler(m)
 	m.ruleSet.AddListener(m)
 
@@ -74,13 +112,19 @@ func (m *Module) Register(httpMux *http.ServeMux) error {
 		return err
 	}
 
-	rsa := sprobe.NewRuleSetApplier(m.config)
-
-	report, err := rsa.Apply(m.ruleSet, m.probe)
+	m.reportLock.Lock()
+	report, err := m.rsa.Apply(m.ruleSet, m.probe)
+	m.report = report
+	m.reportLock.Unlock()
 	if err != nil {
+		// the error is part of the report, exposed through the module stats
 		log.Warn(err)
 	}
 
+	registeredModuleLock.Lock()
+	registeredModule = m
+	registeredModuleLock.Unlock()
+
 	// now that the probes have started, run the snapshot functions for the probes that require
 	// to fetch the current state of the system (example: mount points probes, process probes, ...)
 	if err := m.probe.Snapshot(); err != nil {
@@ -93,8 +137,35 @@ func (m *Module) Register(httpMux *http.ServeMux) error {
 	return nil
 }
 
+// handleHookPointToggle enables or disables the hook point given by the `name` query parameter
+func (m *Module) handleHookPointToggle(w http.ResponseWriter, req *http.Request, toggle func(name string) error) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	// the hook point report is updated by the toggle
+	m.reportLock.Lock()
+	defer m.reportLock.Unlock()
+
+	name := req.URL.Query().Get("name")
+	if err := toggle(name); err != nil {
+		log.Errorf("unable to toggle hook point: %s", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
 // Close the module
 func (m *Module) Close() {
+	registeredModuleLock.Lock()
+	if registeredModule == m {
+		registeredModule = nil
+	}
+	registeredModuleLock.Unlock()
+
 	if m.grpcServer != nil {
 		m.grpcServer.Stop()
 	}
@@ -159,9 +230,47 @@ func (m *Module) GetStats() map[string]interface{} {
 		return nil
 	}
 
-	return map[string]interface{}{
+	stats := map[string]interface{}{
 		"probe": probeStats,
 	}
+
+	if hookPoints := m.GetHookPointsStatus(); hookPoints != nil {
+		stats["hook_points"] = hookPoints
+	}
+	if err := m.GetRuleSetError(); err != "" {
+		stats["rule_set_error"] = err
+	}
+	stats["disabled_hook_points"] = m.probe.GetDisabledHookPoints()
+
+	return stats
+}
+
+// GetHookPointsStatus returns the registration status of the hook points, indexed by hook point name
+func (m *Module) GetHookPointsStatus() map[string]*sprobe.HookPointReport {
+	m.reportLock.RLock()
+	defer m.reportLock.RUnlock()
+
+	if m.report == nil {
+		return nil
+	}
+
+	// the report is updated when a hook point is toggled, return a copy of it
+	hookPoints := make(map[string]*sprobe.HookPointReport, len(m.report.HookPoints))
+	for name, hookPoint := range m.report.HookPoints {
+		hookPoints[name] = hookPoint
+	}
+	return hookPoints
+}
+
+// GetRuleSetError returns the error that prevented the rule set from being applied, if any
+func (m *Module) GetRuleSetError() string {
+	m.reportLock.RLock()
+	defer m.reportLock.RUnlock()
+
+	if m.report == nil {
+		return ""
+	}
+	return m.report.Error
 }
 
 // GetRuleSet returns the set of loaded rules
@@ -215,6 +324,7 @@ func NewModule(cfg *aconfig.AgentConfig) (api.Module, error) {
 		grpcServer:   grpc.NewServer(),
 		statsdClient: statsdClient,
 		rateLimiter:  NewRateLimiter(ruleSet.ListRuleIDs()),
+		rsa:          sprobe.NewRuleSetApplier(config),
 	}
 
 	sapi.RegisterSecurityModuleServer(m.grpcServer, m.eventServer)
@@ -10,10 +10,13 @@ package probe
 import (
 	"math"
 
+	"github.com/pkg/errors"
+
 	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
 	"github.com/DataDog/datadog-agent/pkg/security/rules"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
 // RuleSetApplier defines a rule set applier. It applies rules using an Applier
@@ -29,6 +32,7 @@ type Applier interface {
 	ApplyApprovers(eventType eval.EventType, approvers rules.Approvers) error
 	RegisterKProbe(kprobe *ebpf.KProbe) error
 	RegisterTracepoint(tracepoint string) error
+	IsHookPointDisabled(name string) bool
 }
 
 func (rsa *RuleSetApplier) applyFilterPolicy(eventType eval.EventType, tableName string, mode PolicyMode, flags PolicyFlag, applier Applier) error {
@@ -71,6 +75,60 @@ func (rsa *RuleSetApplier) registerTracepoint(tracepoint string, applier Applier
 	return nil
 }
 
+func (rsa *RuleSetApplier) isHookPointDisabled(hookPoint *HookPoint, applier Applier) bool {
+	if applier != nil {
+		return applier.IsHookPointDisabled(hookPoint.Name)
+	}
+
+	return false
+}
+
+// IsHookPointNeeded returns whether the rule set requires one of the event types of a hook point
+func (rsa *RuleSetApplier) IsHookPointNeeded(rs *rules.RuleSet, hookPoint *HookPoint) bool {
+	for _, eventType := range hookPoint.EventTypes {
+		if eventType == "*" || rs.HasRulesForEventType(eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// registerHookPoint registers the kprobes and the tracepoint of a hook point and reports its status. An error is
+// returned only if a non-optional hook point couldn't be attached at all.
+func (rsa *RuleSetApplier) registerHookPoint(hookPoint *HookPoint, applier Applier) error {
+	var errs []error
+
+	registered := 0
+	for _, kprobe := range hookPoint.KProbes {
+		// use hook point name if kprobe name not provided
+		if len(kprobe.Name) == 0 {
+			kprobe.Name = hookPoint.Name
+		}
+
+		if err := rsa.registerKProbe(kprobe, applier); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to register kprobe `%s`", kprobe.Name))
+		} else {
+			registered++
+		}
+	}
+	attached := len(hookPoint.KProbes) == 0 || registered > 0
+
+	if len(hookPoint.Tracepoint) > 0 {
+		if err := rsa.registerTracepoint(hookPoint.Tracepoint, applier); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to register tracepoint `%s`", hookPoint.Tracepoint))
+			attached = false
+		}
+	}
+
+	rsa.reporter.SetHookPointStatus(hookPoint, attached, errs)
+
+	if !attached && !hookPoint.Optional {
+		return errors.Wrapf(errs[0], "failed to attach hook point `%s`", hookPoint.Name)
+	}
+
+	return nil
+}
+
 func (rsa *RuleSetApplier) setupKProbe(rs *rules.RuleSet, eventType eval.EventType, applier Applier) error {
 	policyTable := allPolicyTables[eventType]
 	if policyTable == "" {
@@ -119,15 +177,22 @@ func (rsa *RuleSetApplier) setupKProbe(rs *rules.RuleSet, eventType eval.EventTy
 	return nil
 }
 
+// reportError reports the error that prevented the rule set from being applied
+func (rsa *RuleSetApplier) reportError(err error) (*Report, error) {
+	rsa.reporter.SetError(err)
+	return rsa.reporter.GetReport(), err
+}
+
 // Apply applies the loaded set of rules and returns a report
-// of the applied approvers for it.
+// of the applied approvers and of the registered hook points for it.
+// The report is returned even if the rule set couldn't be applied.
 func (rsa *RuleSetApplier) Apply(rs *rules.RuleSet, applier Applier) (*Report, error) {
 	alreadySetup := make(map[eval.EventType]bool)
 	alreadyRegistered := make(map[*HookPoint]bool)
 
 	if applier != nil {
 		if err := applier.Init(); err != nil {
-			return nil, err
+			return rsa.reportError(err)
 		}
 	}
 
@@ -144,7 +209,7 @@ func (rsa *RuleSetApplier) Apply(rs *rules.RuleSet, applier Applier) (*Report, e
 
 			if rs.HasRulesForEventType(eventType) {
 				if err := rsa.setupKProbe(rs, eventType, applier); err != nil {
-					return nil, err
+					return rsa.reportError(err)
 				}
 				alreadySetup[eventType] = true
 			}
@@ -157,23 +222,15 @@ func (rsa *RuleSetApplier) Apply(rs *rules.RuleSet, applier Applier) (*Report, e
 					continue
 				}
 
-				for _, kprobe := range hookPoint.KProbes {
-					// use hook point name if kprobe name not provided
-					if len(kprobe.Name) == 0 {
-						kprobe.Name = hookPoint.Name
-					}
-
-					if err := rsa.registerKProbe(kprobe, applier); err != nil {
-						if !hookPoint.Optional && len(hookPoint.KProbes) == 1 {
-							return nil, err
-						}
-					}
+				if rsa.isHookPointDisabled(hookPoint, applier) {
+					log.Infof("hook point `%s` is disabled", hookPoint.Name)
+					rsa.reporter.SetHookPointDisabled(hookPoint)
+					alreadyRegistered[hookPoint] = true
+					continue
 				}
 
-				if len(hookPoint.Tracepoint) > 0 {
-					if err := rsa.registerTracepoint(hookPoint.Tracepoint, applier); err != nil {
-						return nil, err
-					}
+				if err := rsa.registerHookPoint(hookPoint, applier); err != nil {
+					return rsa.reportError(err)
 				}
 				alreadyRegistered[hookPoint] = true
 			}
@@ -183,6 +240,29 @@ func (rsa *RuleSetApplier) Apply(rs *rules.RuleSet, applier Applier) (*Report, e
 	return rsa.reporter.GetReport(), nil
 }
 
+// EnableHookPoint sets up the policies and the approvers of the event types of a hook point, then registers it and
+// reports its status. It is used to attach a hook point that was disabled when the rule set was applied.
+func (rsa *RuleSetApplier) EnableHookPoint(rs *rules.RuleSet, hookPoint *HookPoint, applier Applier) (bool, error) {
+	for _, eventType := range hookPoint.EventTypes {
+		if eventType != "*" && rs.HasRulesForEventType(eventType) {
+			if err := rsa.setupKProbe(rs, eventType, applier); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	if err := rsa.registerHookPoint(hookPoint, applier); err != nil {
+		return false, err
+	}
+
+	return rsa.reporter.GetReport().HookPoints[hookPoint.Name].Attached, nil
+}
+
+// DisableHookPoint reports a hook point detached at runtime as disabled
+func (rsa *RuleSetApplier) DisableHookPoint(hookPoint *HookPoint) {
+	rsa.reporter.SetHookPointDisabled(hookPoint)
+}
+
 // NewRuleSetApplier returns a new RuleSetApplier
 func NewRuleSetApplier(cfg *config.Config) *RuleSetApplier {
 	return &RuleSetApplier{
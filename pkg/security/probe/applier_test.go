@@ -0,0 +1,205 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/config"
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+type failingApplier struct {
+	failingKProbes     map[string]bool
+	policies           map[eval.EventType]PolicyMode
+	disabledHookPoints map[string]bool
+}
+
+func (a *failingApplier) Init() error {
+	return nil
+}
+
+func (a *failingApplier) ApplyFilterPolicy(eventType eval.EventType, tableName string, mode PolicyMode, flags PolicyFlag) error {
+	if a.policies != nil {
+		a.policies[eventType] = mode
+	}
+	return nil
+}
+
+func (a *failingApplier) ApplyApprovers(eventType eval.EventType, approvers rules.Approvers) error {
+	return nil
+}
+
+func (a *failingApplier) RegisterKProbe(kprobe *ebpf.KProbe) error {
+	if a.failingKProbes[kprobe.EntryFunc] {
+		return errors.New("kprobe not found")
+	}
+	return nil
+}
+
+func (a *failingApplier) RegisterTracepoint(tracepoint string) error {
+	return nil
+}
+
+func (a *failingApplier) IsHookPointDisabled(name string) bool {
+	return a.disabledHookPoints[name]
+}
+
+func failingKProbes(hookPointName string) map[string]bool {
+	failing := make(map[string]bool)
+	for _, hookPoint := range allHookPoints {
+		if hookPoint.Name == hookPointName {
+			for _, kprobe := range hookPoint.KProbes {
+				failing[kprobe.EntryFunc] = true
+			}
+		}
+	}
+	return failing
+}
+
+func TestHookPointsReport(t *testing.T) {
+	rs := rules.NewRuleSet(&Model{}, func() eval.Event { return &Event{} }, rules.NewOptsWithParams(true, SECLConstants, nil))
+	addRuleExpr(t, rs, `chmod.filename == "/etc/passwd"`)
+
+	rsa := NewRuleSetApplier(&config.Config{})
+
+	t.Run("optional", func(t *testing.T) {
+		report, err := rsa.Apply(rs, &failingApplier{failingKProbes: failingKProbes("sys_chmod")})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		status, ok := report.HookPoints["sys_chmod"]
+		if !ok {
+			t.Fatal("sys_chmod hook point not reported")
+		}
+		if status.Attached || !status.Optional || len(status.Errors) == 0 {
+			t.Errorf("unexpected sys_chmod status: %+v", status)
+		}
+
+		status, ok = report.HookPoints["sys_fchmod"]
+		if !ok {
+			t.Fatal("sys_fchmod hook point not reported")
+		}
+		if !status.Attached || len(status.Errors) != 0 {
+			t.Errorf("unexpected sys_fchmod status: %+v", status)
+		}
+
+		if _, ok = report.HookPoints["sys_open"]; ok {
+			t.Error("sys_open hook point shouldn't be registered without open rules")
+		}
+	})
+
+	t.Run("required", func(t *testing.T) {
+		rsa := NewRuleSetApplier(&config.Config{})
+
+		report, err := rsa.Apply(rs, &failingApplier{failingKProbes: failingKProbes("sys_fchmod")})
+		if err == nil {
+			t.Fatal("expected an error for the sys_fchmod hook point")
+		}
+
+		if status := report.HookPoints["sys_fchmod"]; status == nil || status.Attached {
+			t.Errorf("unexpected sys_fchmod status: %+v", status)
+		}
+
+		if report.Error != err.Error() {
+			t.Errorf("expected the error to be reported, got `%s`", report.Error)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		rsa := NewRuleSetApplier(&config.Config{})
+
+		applier := &failingApplier{
+			failingKProbes:     failingKProbes("sys_fchmod"),
+			disabledHookPoints: map[string]bool{"sys_fchmod": true},
+		}
+		report, err := rsa.Apply(rs, applier)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if status := report.HookPoints["sys_fchmod"]; status == nil || !status.Disabled || status.Attached {
+			t.Errorf("unexpected sys_fchmod status: %+v", status)
+		}
+	})
+
+	t.Run("enable", func(t *testing.T) {
+		// open is the event type with a policy table
+		rs := rules.NewRuleSet(&Model{}, func() eval.Event { return &Event{} }, rules.NewOptsWithParams(true, SECLConstants, nil))
+		addRuleExpr(t, rs, `open.filename == "/etc/shadow"`)
+
+		rsa := NewRuleSetApplier(&config.Config{})
+
+		if _, err := rsa.Apply(rs, &failingApplier{disabledHookPoints: map[string]bool{"sys_open": true}}); err != nil {
+			t.Fatal(err)
+		}
+
+		if !rsa.IsHookPointNeeded(rs, getHookPoint("sys_open")) {
+			t.Error("expected sys_open to be needed by the open rules")
+		}
+		if rsa.IsHookPointNeeded(rs, getHookPoint("sys_fchmod")) {
+			t.Error("sys_fchmod shouldn't be needed without chmod rules")
+		}
+
+		applier := &failingApplier{policies: make(map[eval.EventType]PolicyMode)}
+		attached, err := rsa.EnableHookPoint(rs, getHookPoint("sys_open"), applier)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !attached {
+			t.Error("expected sys_open to be attached")
+		}
+
+		if _, ok := applier.policies["open"]; !ok {
+			t.Error("expected the open policy to be applied before attaching sys_open")
+		}
+
+		if status := rsa.reporter.GetReport().HookPoints["sys_open"]; status == nil || status.Disabled || !status.Attached {
+			t.Errorf("unexpected sys_open status: %+v", status)
+		}
+
+		rsa.DisableHookPoint(getHookPoint("sys_open"))
+		if status := rsa.reporter.GetReport().HookPoints["sys_open"]; status == nil || !status.Disabled {
+			t.Errorf("unexpected sys_open status: %+v", status)
+		}
+	})
+}
+
+func TestExitPolicy(t *testing.T) {
+	t.Run("without-exit-rules", func(t *testing.T) {
+		rs := rules.NewRuleSet(&Model{}, func() eval.Event { return &Event{} }, rules.NewOptsWithParams(true, SECLConstants, nil))
+		addRuleExpr(t, rs, `chmod.filename == "/etc/passwd"`)
+
+		applier := &failingApplier{policies: make(map[eval.EventType]PolicyMode)}
+		if _, err := NewRuleSetApplier(&config.Config{}).Apply(rs, applier); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, ok := applier.policies["exit"]; ok {
+			t.Error("the exit policy shouldn't be set without exit rules")
+		}
+	})
+
+	t.Run("with-exit-rules", func(t *testing.T) {
+		rs := rules.NewRuleSet(&Model{}, func() eval.Event { return &Event{} }, rules.NewOptsWithParams(true, SECLConstants, nil))
+		addRuleExpr(t, rs, `exit.code == 1`)
+
+		applier := &failingApplier{policies: make(map[eval.EventType]PolicyMode)}
+		if _, err := NewRuleSetApplier(&config.Config{EnableKernelFilters: true, EnableApprovers: true}).Apply(rs, applier); err != nil {
+			t.Fatal(err)
+		}
+
+		if mode := applier.policies["exit"]; mode != PolicyModeAccept {
+			t.Errorf("expected the exit policy to accept the events, got `%s`", mode)
+		}
+	})
+}
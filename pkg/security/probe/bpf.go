@@ -0,0 +1,21 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// bpfHookPoints holds the list of hookpoints to track the usage of the bpf syscall
+var bpfHookPoints = []*HookPoint{
+	{
+		Name:       "sys_bpf",
+		KProbes:    syscallKprobe("bpf"),
+		EventTypes: []eval.EventType{"bpf"},
+	},
+}
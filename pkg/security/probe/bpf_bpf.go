@@ -0,0 +1,34 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"os"
+
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// bpfTables is the list of eBPF tables used by bpf's kProbes
+var bpfTables = []string{
+	"agent_pid",
+}
+
+// setAgentPid pushes the pid of the agent to the kernel so that the bpf calls made by the agent itself,
+// to load its own probes and to manage its maps, aren't reported. The kernel only knows the host pid of the agent.
+func (p *Probe) setAgentPid() error {
+	pid, err := utils.GetHostPid()
+	if err != nil {
+		log.Warnf("unable to resolve the host pid of the agent, using its namespaced pid: %s", err)
+		pid = uint32(os.Getpid())
+	}
+
+	table := p.Table("agent_pid")
+	return table.Set(ebpf.ZeroUint32TableItem, ebpf.Uint32TableItem(pid))
+}
@@ -70,4 +70,6 @@ func (e ErrCapabilityNotFound) Error() string {
 
 func init() {
 	allCapabilities["open"] = openCapabilities
+	// exit events can't be filtered kernel side, only whether they are sent at all
+	allCapabilities["exit"] = Capabilities{}
 }
@@ -0,0 +1,44 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// chrootHookPoints holds the list of hookpoints to track the changes of the root of processes. The pivot_root
+// ones are optional since security_sb_pivotroot is only available on kernels built with LSM support.
+var chrootHookPoints = []*HookPoint{
+	{
+		Name:       "sys_chroot",
+		KProbes:    syscallKprobe("chroot"),
+		EventTypes: []eval.EventType{"chroot"},
+	},
+	{
+		Name: "set_fs_root",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/set_fs_root",
+		}},
+		EventTypes: []eval.EventType{"chroot"},
+	},
+	{
+		Name:       "sys_pivot_root",
+		KProbes:    syscallKprobe("pivot_root"),
+		EventTypes: []eval.EventType{"pivot_root"},
+		Optional:   true,
+	},
+	{
+		Name: "security_sb_pivotroot",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/security_sb_pivotroot",
+		}},
+		EventTypes: []eval.EventType{"pivot_root"},
+		Optional:   true,
+	},
+}
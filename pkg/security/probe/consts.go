@@ -20,6 +20,7 @@ import (
 const (
 	// KERNEL_VERSION(a,b,c) = (a << 16) + (b << 8) + (c)
 	kernel4_13 = (4 << 16) + (13 << 8) //nolint:deadcode,unused
+	kernel5_11 = (5 << 16) + (11 << 8) //nolint:deadcode,unused
 )
 
 // EventType describes the type of an event sent from the kernel
@@ -54,6 +55,46 @@ const (
 	FileSetXAttrEventType
 	// FileRemoveXAttrEventType - Removexattr event
 	FileRemoveXAttrEventType
+	// SetuidEventType - Setuid event
+	SetuidEventType
+	// SetgidEventType - Setgid event
+	SetgidEventType
+	// PtraceEventType - Ptrace event
+	PtraceEventType
+	// ForkEventType - Fork event
+	ForkEventType
+	// ExitEventType - Exit event
+	ExitEventType
+	// CommitCredsEventType - Credentials change event
+	CommitCredsEventType
+	// InitModuleEventType - Kernel module load event
+	InitModuleEventType
+	// ConnectEventType - Socket connect event
+	ConnectEventType
+	// BindEventType - Socket bind event
+	BindEventType
+	// SetnsEventType - Setns event
+	SetnsEventType
+	// BPFEventType - BPF syscall event
+	BPFEventType
+	// ChrootEventType - Chroot event
+	ChrootEventType
+	// PivotRootEventType - Pivot root event
+	PivotRootEventType
+	// KillEventType - Kill event
+	KillEventType
+	// MemfdCreateEventType - Memfd create event
+	MemfdCreateEventType
+	// CapsetEventType - Capset event
+	CapsetEventType
+	// IOUringEventType - io_uring operation event
+	IOUringEventType
+	// PrctlEventType - Prctl event
+	PrctlEventType
+	// FileSymlinkEventType - Symbolic link creation event
+	FileSymlinkEventType
+	// FileTruncateEventType - Truncate event
+	FileTruncateEventType
 	// internalEventType - used internally to get the maximum number of event. Has to be the last one
 	maxEventType
 )
@@ -86,6 +127,46 @@ func (t EventType) String() string {
 		return "setxattr"
 	case FileRemoveXAttrEventType:
 		return "removexattr"
+	case SetuidEventType:
+		return "setuid"
+	case SetgidEventType:
+		return "setgid"
+	case PtraceEventType:
+		return "ptrace"
+	case ForkEventType:
+		return "fork"
+	case ExitEventType:
+		return "exit"
+	case CommitCredsEventType:
+		return "commit_creds"
+	case InitModuleEventType:
+		return "init_module"
+	case ConnectEventType:
+		return "connect"
+	case BindEventType:
+		return "bind"
+	case SetnsEventType:
+		return "setns"
+	case BPFEventType:
+		return "bpf"
+	case ChrootEventType:
+		return "chroot"
+	case PivotRootEventType:
+		return "pivot_root"
+	case KillEventType:
+		return "kill"
+	case MemfdCreateEventType:
+		return "memfd_create"
+	case CapsetEventType:
+		return "capset"
+	case IOUringEventType:
+		return "io_uring"
+	case PrctlEventType:
+		return "prctl"
+	case FileSymlinkEventType:
+		return "symlink"
+	case FileTruncateEventType:
+		return "truncate"
 	}
 	return "unknown"
 }
@@ -285,6 +366,211 @@ var (
 		"AT_REMOVEDIR": unix.AT_REMOVEDIR,
 	}
 
+	mountFlagsConstants = map[string]int{
+		"MS_RDONLY":      unix.MS_RDONLY,
+		"MS_NOSUID":      unix.MS_NOSUID,
+		"MS_NODEV":       unix.MS_NODEV,
+		"MS_NOEXEC":      unix.MS_NOEXEC,
+		"MS_SYNCHRONOUS": unix.MS_SYNCHRONOUS,
+		"MS_REMOUNT":     unix.MS_REMOUNT,
+		"MS_MANDLOCK":    unix.MS_MANDLOCK,
+		"MS_DIRSYNC":     unix.MS_DIRSYNC,
+		"MS_NOATIME":     unix.MS_NOATIME,
+		"MS_NODIRATIME":  unix.MS_NODIRATIME,
+		"MS_BIND":        unix.MS_BIND,
+		"MS_MOVE":        unix.MS_MOVE,
+		"MS_REC":         unix.MS_REC,
+		"MS_SILENT":      unix.MS_SILENT,
+		"MS_POSIXACL":    unix.MS_POSIXACL,
+		"MS_UNBINDABLE":  unix.MS_UNBINDABLE,
+		"MS_PRIVATE":     unix.MS_PRIVATE,
+		"MS_SLAVE":       unix.MS_SLAVE,
+		"MS_SHARED":      unix.MS_SHARED,
+		"MS_RELATIME":    unix.MS_RELATIME,
+		"MS_KERNMOUNT":   unix.MS_KERNMOUNT,
+		"MS_I_VERSION":   unix.MS_I_VERSION,
+		"MS_STRICTATIME": unix.MS_STRICTATIME,
+		"MS_LAZYTIME":    unix.MS_LAZYTIME,
+	}
+
+	addressFamilyConstants = map[string]int{
+		"AF_INET":  unix.AF_INET,
+		"AF_INET6": unix.AF_INET6,
+	}
+
+	socketTypeConstants = map[string]int{
+		"SOCK_STREAM":    unix.SOCK_STREAM,
+		"SOCK_DGRAM":     unix.SOCK_DGRAM,
+		"SOCK_RAW":       unix.SOCK_RAW,
+		"SOCK_SEQPACKET": unix.SOCK_SEQPACKET,
+	}
+
+	namespaceTypeConstants = map[string]int{
+		"CLONE_NEWNS":     unix.CLONE_NEWNS,
+		"CLONE_NEWCGROUP": unix.CLONE_NEWCGROUP,
+		"CLONE_NEWUTS":    unix.CLONE_NEWUTS,
+		"CLONE_NEWIPC":    unix.CLONE_NEWIPC,
+		"CLONE_NEWUSER":   unix.CLONE_NEWUSER,
+		"CLONE_NEWPID":    unix.CLONE_NEWPID,
+		"CLONE_NEWNET":    unix.CLONE_NEWNET,
+	}
+
+	// initial namespaces inode numbers, as defined in include/linux/proc_ns.h
+	namespaceInodeConstants = map[string]int{
+		"PROC_IPC_INIT_INO":    0xEFFFFFFF,
+		"PROC_UTS_INIT_INO":    0xEFFFFFFE,
+		"PROC_USER_INIT_INO":   0xEFFFFFFD,
+		"PROC_PID_INIT_INO":    0xEFFFFFFC,
+		"PROC_CGROUP_INIT_INO": 0xEFFFFFFB,
+	}
+
+	bpfCmdConstants = map[string]int{
+		"BPF_MAP_CREATE":          unix.BPF_MAP_CREATE,
+		"BPF_MAP_LOOKUP_ELEM":     unix.BPF_MAP_LOOKUP_ELEM,
+		"BPF_MAP_UPDATE_ELEM":     unix.BPF_MAP_UPDATE_ELEM,
+		"BPF_MAP_DELETE_ELEM":     unix.BPF_MAP_DELETE_ELEM,
+		"BPF_MAP_GET_NEXT_KEY":    unix.BPF_MAP_GET_NEXT_KEY,
+		"BPF_PROG_LOAD":           unix.BPF_PROG_LOAD,
+		"BPF_OBJ_PIN":             unix.BPF_OBJ_PIN,
+		"BPF_OBJ_GET":             unix.BPF_OBJ_GET,
+		"BPF_PROG_ATTACH":         unix.BPF_PROG_ATTACH,
+		"BPF_PROG_DETACH":         unix.BPF_PROG_DETACH,
+		"BPF_PROG_TEST_RUN":       unix.BPF_PROG_TEST_RUN,
+		"BPF_PROG_GET_NEXT_ID":    unix.BPF_PROG_GET_NEXT_ID,
+		"BPF_MAP_GET_NEXT_ID":     unix.BPF_MAP_GET_NEXT_ID,
+		"BPF_PROG_GET_FD_BY_ID":   unix.BPF_PROG_GET_FD_BY_ID,
+		"BPF_MAP_GET_FD_BY_ID":    unix.BPF_MAP_GET_FD_BY_ID,
+		"BPF_OBJ_GET_INFO_BY_FD":  unix.BPF_OBJ_GET_INFO_BY_FD,
+		"BPF_PROG_QUERY":          unix.BPF_PROG_QUERY,
+		"BPF_RAW_TRACEPOINT_OPEN": unix.BPF_RAW_TRACEPOINT_OPEN,
+		"BPF_BTF_LOAD":            unix.BPF_BTF_LOAD,
+		"BPF_BTF_GET_FD_BY_ID":    unix.BPF_BTF_GET_FD_BY_ID,
+		"BPF_TASK_FD_QUERY":       unix.BPF_TASK_FD_QUERY,
+	}
+
+	ptraceRequestConstants = map[string]int{
+		"PTRACE_TRACEME":     unix.PTRACE_TRACEME,
+		"PTRACE_PEEKTEXT":    unix.PTRACE_PEEKTEXT,
+		"PTRACE_PEEKDATA":    unix.PTRACE_PEEKDATA,
+		"PTRACE_POKETEXT":    unix.PTRACE_POKETEXT,
+		"PTRACE_POKEDATA":    unix.PTRACE_POKEDATA,
+		"PTRACE_CONT":        unix.PTRACE_CONT,
+		"PTRACE_KILL":        unix.PTRACE_KILL,
+		"PTRACE_SINGLESTEP":  unix.PTRACE_SINGLESTEP,
+		"PTRACE_ATTACH":      unix.PTRACE_ATTACH,
+		"PTRACE_DETACH":      unix.PTRACE_DETACH,
+		"PTRACE_SYSCALL":     unix.PTRACE_SYSCALL,
+		"PTRACE_SETOPTIONS":  unix.PTRACE_SETOPTIONS,
+		"PTRACE_GETEVENTMSG": unix.PTRACE_GETEVENTMSG,
+		"PTRACE_GETSIGINFO":  unix.PTRACE_GETSIGINFO,
+		"PTRACE_SETSIGINFO":  unix.PTRACE_SETSIGINFO,
+		"PTRACE_GETREGSET":   unix.PTRACE_GETREGSET,
+		"PTRACE_SETREGSET":   unix.PTRACE_SETREGSET,
+		"PTRACE_SEIZE":       unix.PTRACE_SEIZE,
+		"PTRACE_INTERRUPT":   unix.PTRACE_INTERRUPT,
+		"PTRACE_LISTEN":      unix.PTRACE_LISTEN,
+	}
+
+	exitCauseConstants = map[string]int{
+		"EXITED":     int(ExitExited),
+		"COREDUMPED": int(ExitCoreDumped),
+		"SIGNALED":   int(ExitSignaled),
+	}
+
+	prctlOptionConstants = map[string]int{
+		"PR_SET_PDEATHSIG":       unix.PR_SET_PDEATHSIG,
+		"PR_SET_DUMPABLE":        unix.PR_SET_DUMPABLE,
+		"PR_SET_KEEPCAPS":        unix.PR_SET_KEEPCAPS,
+		"PR_SET_NAME":            unix.PR_SET_NAME,
+		"PR_GET_NAME":            unix.PR_GET_NAME,
+		"PR_GET_SECCOMP":         unix.PR_GET_SECCOMP,
+		"PR_SET_SECCOMP":         unix.PR_SET_SECCOMP,
+		"PR_CAPBSET_DROP":        unix.PR_CAPBSET_DROP,
+		"PR_SET_SECUREBITS":      unix.PR_SET_SECUREBITS,
+		"PR_SET_MM":              unix.PR_SET_MM,
+		"PR_SET_CHILD_SUBREAPER": unix.PR_SET_CHILD_SUBREAPER,
+		"PR_SET_NO_NEW_PRIVS":    unix.PR_SET_NO_NEW_PRIVS,
+		"PR_CAP_AMBIENT":         unix.PR_CAP_AMBIENT,
+		"PR_SET_PTRACER":         unix.PR_SET_PTRACER,
+	}
+
+	seccompModeConstants = map[string]int{
+		"SECCOMP_MODE_DISABLED": unix.SECCOMP_MODE_DISABLED,
+		"SECCOMP_MODE_STRICT":   unix.SECCOMP_MODE_STRICT,
+		"SECCOMP_MODE_FILTER":   unix.SECCOMP_MODE_FILTER,
+	}
+
+	ioUringOperationConstants = map[string]int{
+		"IO_URING_OPEN":    int(IOUringOpen),
+		"IO_URING_READ":    int(IOUringRead),
+		"IO_URING_WRITE":   int(IOUringWrite),
+		"IO_URING_CONNECT": int(IOUringConnect),
+	}
+
+	signalConstants = map[string]int{
+		"SIGABRT": int(syscall.SIGABRT),
+		"SIGALRM": int(syscall.SIGALRM),
+		"SIGBUS":  int(syscall.SIGBUS),
+		"SIGCHLD": int(syscall.SIGCHLD),
+		"SIGCONT": int(syscall.SIGCONT),
+		"SIGFPE":  int(syscall.SIGFPE),
+		"SIGHUP":  int(syscall.SIGHUP),
+		"SIGILL":  int(syscall.SIGILL),
+		"SIGINT":  int(syscall.SIGINT),
+		"SIGKILL": int(syscall.SIGKILL),
+		"SIGPIPE": int(syscall.SIGPIPE),
+		"SIGQUIT": int(syscall.SIGQUIT),
+		"SIGSEGV": int(syscall.SIGSEGV),
+		"SIGSTOP": int(syscall.SIGSTOP),
+		"SIGSYS":  int(syscall.SIGSYS),
+		"SIGTERM": int(syscall.SIGTERM),
+		"SIGTRAP": int(syscall.SIGTRAP),
+		"SIGUSR1": int(syscall.SIGUSR1),
+		"SIGUSR2": int(syscall.SIGUSR2),
+	}
+
+	// capabilityConstants holds the capability bitmask values, as found in the capability sets of a process
+	capabilityConstants = map[string]int{
+		"CAP_CHOWN":            1 << unix.CAP_CHOWN,
+		"CAP_DAC_OVERRIDE":     1 << unix.CAP_DAC_OVERRIDE,
+		"CAP_DAC_READ_SEARCH":  1 << unix.CAP_DAC_READ_SEARCH,
+		"CAP_FOWNER":           1 << unix.CAP_FOWNER,
+		"CAP_FSETID":           1 << unix.CAP_FSETID,
+		"CAP_KILL":             1 << unix.CAP_KILL,
+		"CAP_SETGID":           1 << unix.CAP_SETGID,
+		"CAP_SETUID":           1 << unix.CAP_SETUID,
+		"CAP_SETPCAP":          1 << unix.CAP_SETPCAP,
+		"CAP_LINUX_IMMUTABLE":  1 << unix.CAP_LINUX_IMMUTABLE,
+		"CAP_NET_BIND_SERVICE": 1 << unix.CAP_NET_BIND_SERVICE,
+		"CAP_NET_BROADCAST":    1 << unix.CAP_NET_BROADCAST,
+		"CAP_NET_ADMIN":        1 << unix.CAP_NET_ADMIN,
+		"CAP_NET_RAW":          1 << unix.CAP_NET_RAW,
+		"CAP_IPC_LOCK":         1 << unix.CAP_IPC_LOCK,
+		"CAP_IPC_OWNER":        1 << unix.CAP_IPC_OWNER,
+		"CAP_SYS_MODULE":       1 << unix.CAP_SYS_MODULE,
+		"CAP_SYS_RAWIO":        1 << unix.CAP_SYS_RAWIO,
+		"CAP_SYS_CHROOT":       1 << unix.CAP_SYS_CHROOT,
+		"CAP_SYS_PTRACE":       1 << unix.CAP_SYS_PTRACE,
+		"CAP_SYS_PACCT":        1 << unix.CAP_SYS_PACCT,
+		"CAP_SYS_ADMIN":        1 << unix.CAP_SYS_ADMIN,
+		"CAP_SYS_BOOT":         1 << unix.CAP_SYS_BOOT,
+		"CAP_SYS_NICE":         1 << unix.CAP_SYS_NICE,
+		"CAP_SYS_RESOURCE":     1 << unix.CAP_SYS_RESOURCE,
+		"CAP_SYS_TIME":         1 << unix.CAP_SYS_TIME,
+		"CAP_SYS_TTY_CONFIG":   1 << unix.CAP_SYS_TTY_CONFIG,
+		"CAP_MKNOD":            1 << unix.CAP_MKNOD,
+		"CAP_LEASE":            1 << unix.CAP_LEASE,
+		"CAP_AUDIT_WRITE":      1 << unix.CAP_AUDIT_WRITE,
+		"CAP_AUDIT_CONTROL":    1 << unix.CAP_AUDIT_CONTROL,
+		"CAP_SETFCAP":          1 << unix.CAP_SETFCAP,
+		"CAP_MAC_OVERRIDE":     1 << unix.CAP_MAC_OVERRIDE,
+		"CAP_MAC_ADMIN":        1 << unix.CAP_MAC_ADMIN,
+		"CAP_SYSLOG":           1 << unix.CAP_SYSLOG,
+		"CAP_WAKE_ALARM":       1 << unix.CAP_WAKE_ALARM,
+		"CAP_BLOCK_SUSPEND":    1 << unix.CAP_BLOCK_SUSPEND,
+		"CAP_AUDIT_READ":       1 << unix.CAP_AUDIT_READ,
+	}
+
 	// SECLConstants are constants available in runtime security agent rules
 	SECLConstants = map[string]interface{}{
 		// boolean
@@ -294,9 +580,20 @@ var (
 )
 
 var (
-	openFlagsStrings   = map[int]string{}
-	chmodModeStrings   = map[int]string{}
-	unlinkFlagsStrings = map[int]string{}
+	openFlagsStrings        = map[int]string{}
+	chmodModeStrings        = map[int]string{}
+	unlinkFlagsStrings      = map[int]string{}
+	mountFlagsStrings       = map[int]string{}
+	addressFamilyStrings    = map[int]string{}
+	socketTypeStrings       = map[int]string{}
+	namespaceTypeStrings    = map[int]string{}
+	ptraceRequestStrings    = map[int]string{}
+	bpfCmdStrings           = map[int]string{}
+	exitCauseStrings        = map[int]string{}
+	signalStrings           = map[int]string{}
+	capabilityStrings       = map[int]string{}
+	ioUringOperationStrings = map[int]string{}
+	prctlOptionStrings      = map[int]string{}
 )
 
 func initOpenConstants() {
@@ -329,6 +626,120 @@ func initUnlinkConstanst() {
 	}
 }
 
+func initMountConstants() {
+	for k, v := range mountFlagsConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range mountFlagsConstants {
+		mountFlagsStrings[v] = k
+	}
+}
+
+func initSocketConstants() {
+	for k, v := range addressFamilyConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range addressFamilyConstants {
+		addressFamilyStrings[v] = k
+	}
+
+	for k, v := range socketTypeConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range socketTypeConstants {
+		socketTypeStrings[v] = k
+	}
+}
+
+func initNamespaceConstants() {
+	for k, v := range namespaceTypeConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range namespaceTypeConstants {
+		namespaceTypeStrings[v] = k
+	}
+
+	for k, v := range namespaceInodeConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+}
+
+func initPtraceConstants() {
+	for k, v := range ptraceRequestConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range ptraceRequestConstants {
+		ptraceRequestStrings[v] = k
+	}
+}
+
+func initBPFConstants() {
+	for k, v := range bpfCmdConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range bpfCmdConstants {
+		bpfCmdStrings[v] = k
+	}
+}
+
+func initExitConstants() {
+	for k, v := range exitCauseConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range exitCauseConstants {
+		exitCauseStrings[v] = k
+	}
+
+	for k, v := range signalConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range signalConstants {
+		signalStrings[v] = k
+	}
+}
+
+func initCapabilityConstants() {
+	for k, v := range capabilityConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range capabilityConstants {
+		capabilityStrings[v] = k
+	}
+}
+
+func initIOUringConstants() {
+	for k, v := range ioUringOperationConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range ioUringOperationConstants {
+		ioUringOperationStrings[v] = k
+	}
+}
+
+func initPrctlConstants() {
+	for k, v := range prctlOptionConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range prctlOptionConstants {
+		prctlOptionStrings[v] = k
+	}
+
+	for k, v := range seccompModeConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+}
+
 func initErrorConstants() {
 	for k, v := range errorConstants {
 		SECLConstants[k] = &eval.IntEvaluator{Value: v}
@@ -340,6 +751,15 @@ func initConstants() {
 	initOpenConstants()
 	initChmodConstants()
 	initUnlinkConstanst()
+	initMountConstants()
+	initSocketConstants()
+	initNamespaceConstants()
+	initPtraceConstants()
+	initBPFConstants()
+	initExitConstants()
+	initCapabilityConstants()
+	initIOUringConstants()
+	initPrctlConstants()
 }
 
 func bitmaskToString(bitmask int, intToStrMap map[int]string) string {
@@ -390,6 +810,40 @@ func (f UnlinkFlags) String() string {
 	return bitmaskToString(int(f), unlinkFlagsStrings)
 }
 
+// MountFlags represents a mount flags bitmask value
+type MountFlags int
+
+func (f MountFlags) String() string {
+	return bitmaskToString(int(f), mountFlagsStrings)
+}
+
+// AddressFamily represents the address family of a socket
+type AddressFamily int
+
+func (f AddressFamily) String() string {
+	if s, ok := addressFamilyStrings[int(f)]; ok {
+		return s
+	}
+	return fmt.Sprintf("%d", int(f))
+}
+
+// SocketType represents the type of a socket
+type SocketType int
+
+func (t SocketType) String() string {
+	if s, ok := socketTypeStrings[int(t)]; ok {
+		return s
+	}
+	return fmt.Sprintf("%d", int(t))
+}
+
+// NamespaceType represents a namespace type bitmask value
+type NamespaceType int
+
+func (t NamespaceType) String() string {
+	return bitmaskToString(int(t), namespaceTypeStrings)
+}
+
 // RetValError represents a syscall return error value
 type RetValError int
 
@@ -404,3 +858,87 @@ func (f RetValError) String() string {
 func init() {
 	initConstants()
 }
+
+// PtraceRequest represents a ptrace request value
+type PtraceRequest int
+
+func (r PtraceRequest) String() string {
+	if s, ok := ptraceRequestStrings[int(r)]; ok {
+		return s
+	}
+	return fmt.Sprintf("%d", int(r))
+}
+
+// BPFCmd represents a bpf command value
+type BPFCmd int
+
+func (c BPFCmd) String() string {
+	if s, ok := bpfCmdStrings[int(c)]; ok {
+		return s
+	}
+	return fmt.Sprintf("%d", int(c))
+}
+
+// ExitCause represents the cause of the termination of a process
+type ExitCause uint32
+
+const (
+	// ExitExited - Process exited normally
+	ExitExited ExitCause = iota
+	// ExitCoreDumped - Process was terminated by a signal and dumped its core
+	ExitCoreDumped
+	// ExitSignaled - Process was terminated by a signal
+	ExitSignaled
+)
+
+func (c ExitCause) String() string {
+	return exitCauseStrings[int(c)]
+}
+
+// Signal represents a signal number
+type Signal int
+
+func (s Signal) String() string {
+	if str, ok := signalStrings[int(s)]; ok {
+		return str
+	}
+	return fmt.Sprintf("%d", int(s))
+}
+
+// KernelCapability represents a set of kernel capabilities
+type KernelCapability uint64
+
+func (c KernelCapability) String() string {
+	return bitmaskToString(int(c), capabilityStrings)
+}
+
+// IOUringOperation represents the normalized operation of an io_uring request
+type IOUringOperation uint32
+
+const (
+	// IOUringOpen - IORING_OP_OPENAT and IORING_OP_OPENAT2 requests
+	IOUringOpen IOUringOperation = iota + 1
+	// IOUringRead - IORING_OP_READV, IORING_OP_READ_FIXED and IORING_OP_READ requests
+	IOUringRead
+	// IOUringWrite - IORING_OP_WRITEV, IORING_OP_WRITE_FIXED and IORING_OP_WRITE requests
+	IOUringWrite
+	// IOUringConnect - IORING_OP_CONNECT requests
+	IOUringConnect
+)
+
+func (o IOUringOperation) String() string {
+	if str, ok := ioUringOperationStrings[int(o)]; ok {
+		return str
+	}
+	return fmt.Sprintf("%d", int(o))
+}
+
+// PrctlOption represents a prctl option
+type PrctlOption int
+
+func (o PrctlOption) String() string {
+	if str, ok := prctlOptionStrings[int(o)]; ok {
+		return str
+	}
+	return fmt.Sprintf("%d", int(o))
+}
@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"syscall"
 	"testing"
+
+	"golang.org/x/sys/unix"
 )
 
 func TestFlagsToString(t *testing.T) {
@@ -34,3 +36,48 @@ func TestFlagsToString(t *testing.T) {
 		t.Errorf("expexted flags not found, got: %s", str)
 	}
 }
+
+func TestPtraceRequestToString(t *testing.T) {
+	if str := PtraceRequest(unix.PTRACE_ATTACH).String(); str != "PTRACE_ATTACH" {
+		t.Errorf("expected PTRACE_ATTACH, got: %s", str)
+	}
+
+	if str := PtraceRequest(12345).String(); str != "12345" {
+		t.Errorf("expected raw request value, got: %s", str)
+	}
+}
+
+func TestKernelCapabilityToString(t *testing.T) {
+	str := KernelCapability(1<<unix.CAP_SYS_ADMIN | 1<<unix.CAP_CHOWN).String()
+	if str != "CAP_CHOWN | CAP_SYS_ADMIN" {
+		t.Errorf("expected capabilities not found, got: %s", str)
+	}
+}
+
+func TestMountFlagsToString(t *testing.T) {
+	if str := MountFlags(unix.MS_BIND | unix.MS_REC).String(); str != "MS_BIND | MS_REC" {
+		t.Errorf("expected flags not found, got: %s", str)
+	}
+}
+
+func TestNamespaceTypeToString(t *testing.T) {
+	if str := NamespaceType(unix.CLONE_NEWNS | unix.CLONE_NEWPID).String(); str != "CLONE_NEWNS | CLONE_NEWPID" {
+		t.Errorf("expected namespace types not found, got: %s", str)
+	}
+}
+
+func TestBPFCmdToString(t *testing.T) {
+	if str := BPFCmd(unix.BPF_PROG_LOAD).String(); str != "BPF_PROG_LOAD" {
+		t.Errorf("expected bpf command not found, got: %s", str)
+	}
+
+	if str := BPFCmd(4242).String(); str != "4242" {
+		t.Errorf("expected unknown bpf command, got: %s", str)
+	}
+}
+
+func TestSignalToString(t *testing.T) {
+	if str := Signal(unix.SIGKILL).String(); str != "SIGKILL" {
+		t.Errorf("expected signal not found, got: %s", str)
+	}
+}
@@ -30,6 +30,26 @@ func (cr *ContainerResolver) GetContainerID(pid uint32) (*utils.ContainerID, err
 	return &containerID, nil
 }
 
+// GetCgroupPath returns the path of the control group holding the container ID of the given pid, or the path of its
+// first control group if it doesn't belong to a container
+func (cr *ContainerResolver) GetCgroupPath(pid uint32) (string, error) {
+	cgroups, err := utils.GetProcControlGroups(pid, pid)
+	if err != nil {
+		return "", err
+	}
+
+	for _, cgroup := range cgroups {
+		if containerID := cgroup.GetContainerID(); containerID != "" {
+			return cgroup.Path, nil
+		}
+	}
+
+	if len(cgroups) > 0 {
+		return cgroups[0].Path, nil
+	}
+	return "", nil
+}
+
 // ResolveLabels resolves the label of a container from its container ID
 func (cr *ContainerResolver) ResolveLabels(containerID string) ([]string, error) {
 	// Do not use the tagger for now
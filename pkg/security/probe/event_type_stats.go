@@ -0,0 +1,33 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"expvar"
+
+	"github.com/DataDog/datadog-agent/pkg/telemetry"
+)
+
+// The kernel doesn't report which hook point produced an event, so the events are accounted to their type. The
+// hook points producing each event type are listed in the hook points status of the module.
+var (
+	eventTypesExpvars      = expvar.NewMap("runtime_security_event_types")
+	eventTypeEventsExpvars = new(expvar.Map).Init()
+	tlmEventTypeEvents     = telemetry.NewCounter("runtime_security", "events",
+		[]string{"event_type"}, "Number of events decoded by the probe")
+)
+
+func init() {
+	eventTypesExpvars.Set("events", eventTypeEventsExpvars)
+}
+
+// countEventTypeEvent increments the events counters of the given event type
+func countEventTypeEvent(eventType string) {
+	eventTypeEventsExpvars.Add(eventType, 1)
+	tlmEventTypeEvents.Inc(eventType)
+}
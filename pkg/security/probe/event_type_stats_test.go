@@ -0,0 +1,28 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"testing"
+)
+
+func TestCountEventTypeEvent(t *testing.T) {
+	eventTypeEventsExpvars.Init()
+	defer eventTypeEventsExpvars.Init()
+
+	countEventTypeEvent("chmod")
+	countEventTypeEvent("chmod")
+
+	if value := eventTypeEventsExpvars.Get("chmod"); value == nil || value.String() != "2" {
+		t.Errorf("expected 2 chmod events, got %v", value)
+	}
+
+	if value := eventTypeEventsExpvars.Get("open"); value != nil {
+		t.Errorf("expected no open event, got %v", value)
+	}
+}
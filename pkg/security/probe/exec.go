@@ -12,34 +12,103 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
 )
 
+// maxMemfdNameLen is the size of the buffer of the name given to memfd_create, including the trailing NUL byte
+const maxMemfdNameLen = 64
+
 // execHookPoints holds the list of hookpoints to track processes execution
 var execHookPoints = []*HookPoint{
 	{
-		Name: "sys_execve",
-		KProbes: []*ebpf.KProbe{{
-			EntryFunc: "kprobe/" + getSyscallFnName("execve"),
-		}},
+		Name:       "sys_execve",
+		KProbes:    syscallKprobe("execve"),
+		EventTypes: []eval.EventType{"*"},
+	},
+	{
+		Name:       "sys_execveat",
+		KProbes:    syscallKprobe("execveat"),
 		EventTypes: []eval.EventType{"*"},
+		Optional:   true,
 	},
 	{
-		Name: "sys_execveat",
+		Name: "security_bprm_check",
 		KProbes: []*ebpf.KProbe{{
-			EntryFunc: "kprobe/" + getSyscallFnName("execveat"),
+			EntryFunc: "kprobe/security_bprm_check",
 		}},
 		EventTypes: []eval.EventType{"*"},
+	},
+	{
+		Name:       "sys_ptrace",
+		KProbes:    syscallKprobe("ptrace", true),
+		EventTypes: []eval.EventType{"ptrace"},
+		Optional:   true,
+	},
+	{
+		Name:       "sys_kill",
+		KProbes:    syscallKprobe("kill"),
+		EventTypes: []eval.EventType{"kill"},
+	},
+	{
+		Name:       "sys_tgkill",
+		KProbes:    syscallKprobe("tgkill"),
+		EventTypes: []eval.EventType{"kill"},
+		Optional:   true,
+	},
+	{
+		// memfd_create isn't available on kernels older than 3.17. The files it creates are tracked to
+		// report the processes executed from them as fileless.
+		Name:       "sys_memfd_create",
+		KProbes:    syscallKprobe("memfd_create"),
+		EventTypes: []eval.EventType{"*"},
+		Optional:   true,
+	},
+	{
+		// prctl options are architecture and kernel specific, the hook point is optional to be safe
+		Name:       "sys_prctl",
+		KProbes:    syscallKprobe("prctl"),
+		EventTypes: []eval.EventType{"prctl"},
+		Optional:   true,
+	},
+	{
+		// setns isn't available on kernels older than 3.0
+		Name:       "sys_setns",
+		KProbes:    syscallKprobe("setns"),
+		EventTypes: []eval.EventType{"setns"},
 		Optional:   true,
 	},
 	{
 		Name:       "sched_process_fork",
 		Tracepoint: "tracepoint/sched/sched_process_fork",
-		EventTypes: []eval.EventType{"*"},
+		EventTypes: []eval.EventType{"*", "fork"},
+	},
+	{
+		Name:       "sys_clone",
+		KProbes:    syscallKprobe("clone"),
+		EventTypes: []eval.EventType{"fork"},
+	},
+	{
+		Name:       "sys_clone3",
+		KProbes:    syscallKprobe("clone3"),
+		EventTypes: []eval.EventType{"fork"},
+		Optional:   true,
+	},
+	{
+		Name:       "sys_fork",
+		KProbes:    syscallKprobe("fork"),
+		EventTypes: []eval.EventType{"fork"},
+		Optional:   true,
 	},
 	{
+		Name:       "sys_vfork",
+		KProbes:    syscallKprobe("vfork"),
+		EventTypes: []eval.EventType{"fork"},
+		Optional:   true,
+	},
+	{
+		// always attached to clean up the process cache, the exit events are only sent when the exit policy is set
 		Name: "do_exit",
 		KProbes: []*ebpf.KProbe{{
 			ExitFunc: "kprobe/do_exit",
 		}},
-		EventTypes: []eval.EventType{"*"},
+		EventTypes: []eval.EventType{"*", "exit"},
 	},
 	{
 		Name: "cgroup_procs_write",
@@ -7,8 +7,74 @@
 
 package probe
 
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
 // execTables holds the list of eBPF tables used by the process kprobes
 var execTables = []string{
 	"proc_cache",
 	"pid_cookie",
+	"exec_args",
+	"exec_env_allowlist",
+	"memfd_names",
+	"exec_memfd",
+	"mnt_ns_inum_offset",
+}
+
+const (
+	// maxExecArgs is the maximum number of arguments captured by the exec probe
+	maxExecArgs = 12
+	// maxExecArgLen is the size of the buffer of each captured argument, including the trailing NUL byte
+	maxExecArgLen = 64
+	// maxExecEnvs is the maximum number of allowlisted environment variables captured by the exec probe
+	maxExecEnvs = 4
+	// maxExecEnvLen is the size of the buffer of each captured environment variable, including the trailing NUL byte
+	maxExecEnvLen = 128
+	// maxExecEnvNameLen is the size of the keys of the environment variables allowlist
+	maxExecEnvNameLen = 32
+	// maxExecEnvAllowlist is the maximum number of entries of the environment variables allowlist
+	maxExecEnvAllowlist = 16
+	// execEnvsOffset is the offset of the environment variables in the exec_args entries
+	execEnvsOffset = 8 + maxExecArgs*maxExecArgLen
+	// mntNsInumOffset is the offset of the inode number in struct mnt_namespace since 5.11
+	mntNsInumOffset = 16
+)
+
+// setMntNsInumOffset pushes the offset of the inode number of the mount namespaces to the kernel, the default offset
+// of the eBPF programs matches the kernels older than 5.11
+func (p *Probe) setMntNsInumOffset() error {
+	if p.kernelVersion != 0 && p.kernelVersion >= kernel5_11 {
+		table := p.Table("mnt_ns_inum_offset")
+		if err := table.Set(ebpf.ZeroUint32TableItem, ebpf.Uint32TableItem(mntNsInumOffset)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setExecEnvAllowlist pushes the list of the environment variables to capture to the kernel
+func (p *Probe) setExecEnvAllowlist() error {
+	table := p.Table("exec_env_allowlist")
+	count := 0
+	for _, name := range p.config.ExecEnvAllowlist {
+		if count == maxExecEnvAllowlist {
+			log.Warnf("Ignoring environment variable `%s`: only the first %d variables of the allowlist are captured", name, maxExecEnvAllowlist)
+			continue
+		}
+
+		if len(name) >= maxExecEnvNameLen {
+			log.Warnf("Ignoring environment variable `%s`: name longer than %d characters", name, maxExecEnvNameLen-1)
+			continue
+		}
+
+		if err := table.Set(ebpf.NewStringTableItem(name, maxExecEnvNameLen), ebpf.ZeroUint8TableItem); err != nil {
+			return err
+		}
+		count++
+	}
+
+	return nil
 }
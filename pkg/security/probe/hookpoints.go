@@ -8,6 +8,7 @@
 package probe
 
 import (
+	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
 )
@@ -27,12 +28,14 @@ var allHookPoints = []*HookPoint{
 		KProbes: []*ebpf.KProbe{{
 			EntryFunc: "kprobe/security_inode_setattr",
 		}},
-		EventTypes: []eval.EventType{"chmod", "chown", "utimes"},
+		EventTypes: []eval.EventType{"chmod", "chown", "utimes", "truncate"},
 	},
 	{
+		// chmod, chown and lchown are not available on architectures only exposing the *at variants, such as arm64
 		Name:       "sys_chmod",
 		KProbes:    syscallKprobe("chmod"),
 		EventTypes: []eval.EventType{"chmod"},
+		Optional:   true,
 	},
 	{
 		Name:       "sys_fchmod",
@@ -48,6 +51,7 @@ var allHookPoints = []*HookPoint{
 		Name:       "sys_chown",
 		KProbes:    append(syscallKprobe("chown"), syscallKprobe("chown16")...),
 		EventTypes: []eval.EventType{"chown"},
+		Optional:   true,
 	},
 	{
 		Name:       "sys_fchown",
@@ -63,6 +67,7 @@ var allHookPoints = []*HookPoint{
 		Name:       "sys_lchown",
 		KProbes:    append(syscallKprobe("lchown"), syscallKprobe("lchown16")...),
 		EventTypes: []eval.EventType{"chown"},
+		Optional:   true,
 	},
 	{
 		Name:       "sys_setxattr",
@@ -113,7 +118,7 @@ var allHookPoints = []*HookPoint{
 		KProbes: []*ebpf.KProbe{{
 			EntryFunc: "kprobe/mnt_want_write",
 		}},
-		EventTypes: []eval.EventType{"utimes", "chmod", "chown", "rmdir", "unlink", "rename", "setxattr", "removexattr"},
+		EventTypes: []eval.EventType{"utimes", "chmod", "chown", "rmdir", "unlink", "rename", "setxattr", "removexattr", "truncate"},
 	},
 	{
 		Name: "mnt_want_write_file",
@@ -174,6 +179,16 @@ var allHookPoints = []*HookPoint{
 		EventTypes: []eval.EventType{"utimes"},
 		Optional:   true,
 	},
+	{
+		Name:       "sys_truncate",
+		KProbes:    syscallKprobe("truncate"),
+		EventTypes: []eval.EventType{"truncate"},
+	},
+	{
+		Name:       "sys_ftruncate",
+		KProbes:    syscallKprobe("ftruncate"),
+		EventTypes: []eval.EventType{"truncate"},
+	},
 	{
 		Name: "vfs_mkdir",
 		KProbes: []*ebpf.KProbe{{
@@ -186,7 +201,7 @@ var allHookPoints = []*HookPoint{
 		KProbes: []*ebpf.KProbe{{
 			EntryFunc: "kprobe/filename_create",
 		}},
-		EventTypes: []eval.EventType{"mkdir", "link"},
+		EventTypes: []eval.EventType{"mkdir", "link", "symlink"},
 	},
 	{
 		Name:       "sys_mkdir",
@@ -228,9 +243,11 @@ var allHookPoints = []*HookPoint{
 		EventTypes: []eval.EventType{"rename"},
 	},
 	{
+		// renameat2 isn't available on kernels older than 3.15
 		Name:       "sys_renameat2",
 		KProbes:    syscallKprobe("renameat2"),
 		EventTypes: []eval.EventType{"rename"},
+		Optional:   true,
 	},
 	{
 		Name: "vfs_link",
@@ -249,6 +266,35 @@ var allHookPoints = []*HookPoint{
 		KProbes:    syscallKprobe("linkat"),
 		EventTypes: []eval.EventType{"link"},
 	},
+	{
+		Name: "vfs_symlink",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/vfs_symlink",
+		}},
+		EventTypes: []eval.EventType{"symlink"},
+	},
+	{
+		// symlink is not available on architectures only exposing the *at variant, such as arm64
+		Name:       "sys_symlink",
+		KProbes:    syscallKprobe("symlink"),
+		EventTypes: []eval.EventType{"symlink"},
+		Optional:   true,
+	},
+	{
+		Name:       "sys_symlinkat",
+		KProbes:    syscallKprobe("symlinkat"),
+		EventTypes: []eval.EventType{"symlink"},
+	},
+}
+
+// getHookPoint returns the hook point with the given name
+func getHookPoint(name string) *HookPoint {
+	for _, hookPoint := range allHookPoints {
+		if hookPoint.Name == name {
+			return hookPoint
+		}
+	}
+	return nil
 }
 
 func init() {
@@ -256,4 +302,14 @@ func init() {
 	allHookPoints = append(allHookPoints, mountHookPoints...)
 	allHookPoints = append(allHookPoints, execHookPoints...)
 	allHookPoints = append(allHookPoints, UnlinkHookPoints...)
+	allHookPoints = append(allHookPoints, setuidHookPoints...)
+	allHookPoints = append(allHookPoints, kernelModuleHookPoints...)
+	allHookPoints = append(allHookPoints, socketHookPoints...)
+	allHookPoints = append(allHookPoints, bpfHookPoints...)
+	allHookPoints = append(allHookPoints, chrootHookPoints...)
+	allHookPoints = append(allHookPoints, ioUringHookPoints...)
+
+	for _, hookPoint := range allHookPoints {
+		config.RegisterHookPoint(hookPoint.Name)
+	}
 }
@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// ioUringHookPoints holds the list of hookpoints to track the requests submitted through io_uring, which don't go
+// through the syscalls hooked for the other events. They are optional since io_uring is only available on kernels
+// 5.1 and above, and io_openat2 on kernels 5.6 and above.
+var ioUringHookPoints = []*HookPoint{
+	{
+		Name: "io_openat2",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/io_openat2",
+			ExitFunc:  "kretprobe/io_openat2",
+		}},
+		EventTypes: []eval.EventType{"io_uring"},
+		Optional:   true,
+	},
+	{
+		Name: "io_read",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/io_read",
+			ExitFunc:  "kretprobe/io_read",
+		}},
+		EventTypes: []eval.EventType{"io_uring"},
+		Optional:   true,
+	},
+	{
+		Name: "io_write",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/io_write",
+			ExitFunc:  "kretprobe/io_write",
+		}},
+		EventTypes: []eval.EventType{"io_uring"},
+		Optional:   true,
+	},
+	{
+		Name: "io_connect",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/io_connect",
+			ExitFunc:  "kretprobe/io_connect",
+		}},
+		EventTypes: []eval.EventType{"io_uring"},
+		Optional:   true,
+	},
+}
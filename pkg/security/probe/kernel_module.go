@@ -0,0 +1,46 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// kernelModuleHookPoints holds the list of hookpoints to track kernel module loading. They are all optional
+// since module loading may be disabled or stripped from the kernel.
+var kernelModuleHookPoints = []*HookPoint{
+	{
+		Name:       "sys_init_module",
+		KProbes:    syscallKprobe("init_module"),
+		EventTypes: []eval.EventType{"init_module"},
+		Optional:   true,
+	},
+	{
+		Name:       "sys_finit_module",
+		KProbes:    syscallKprobe("finit_module"),
+		EventTypes: []eval.EventType{"init_module"},
+		Optional:   true,
+	},
+	{
+		Name: "security_kernel_read_file",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/security_kernel_read_file",
+		}},
+		EventTypes: []eval.EventType{"init_module"},
+		Optional:   true,
+	},
+	{
+		Name: "do_init_module",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/do_init_module",
+		}},
+		EventTypes: []eval.EventType{"init_module"},
+		Optional:   true,
+	},
+}
@@ -3,6 +3,7 @@
 // This product includes software developed at Datadog (https://www.datadoghq.com/).
 // Copyright 2016-2020 Datadog, Inc.
 
+//go:build linux
 // +build linux
 
 //go:generate go run github.com/DataDog/datadog-agent/pkg/security/secl/generators/accessors -tags linux -output model_accessors.go
@@ -14,6 +15,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"net"
 	"os/user"
 	"path"
 	"strconv"
@@ -348,6 +350,634 @@ func (e *SetXAttrEvent) GetNamespace(resolvers *Resolvers) string {
 	return e.Namespace
 }
 
+// Credentials represents the user and group identifiers of a process
+type Credentials struct {
+	UID   uint32 `field:"uid"`
+	GID   uint32 `field:"gid"`
+	EUID  uint32 `field:"euid"`
+	EGID  uint32 `field:"egid"`
+	FSUID uint32 `field:"fsuid"`
+	FSGID uint32 `field:"fsgid"`
+}
+
+func (c *Credentials) marshalJSON() []byte {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"uid":%d,`, c.UID)
+	fmt.Fprintf(&buf, `"gid":%d,`, c.GID)
+	fmt.Fprintf(&buf, `"euid":%d,`, c.EUID)
+	fmt.Fprintf(&buf, `"egid":%d,`, c.EGID)
+	fmt.Fprintf(&buf, `"fsuid":%d,`, c.FSUID)
+	fmt.Fprintf(&buf, `"fsgid":%d`, c.FSGID)
+	buf.WriteRune('}')
+
+	return buf.Bytes()
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (c *Credentials) UnmarshalBinary(data []byte) (int, error) {
+	if len(data) < 24 {
+		return 0, ErrNotEnoughData
+	}
+
+	c.UID = byteOrder.Uint32(data[0:4])
+	c.GID = byteOrder.Uint32(data[4:8])
+	c.EUID = byteOrder.Uint32(data[8:12])
+	c.EGID = byteOrder.Uint32(data[12:16])
+	c.FSUID = byteOrder.Uint32(data[16:20])
+	c.FSGID = byteOrder.Uint32(data[20:24])
+	return 24, nil
+}
+
+// SetIDEvent represents a setuid or setgid event, holding the credentials
+// of the process before and after the syscall
+type SetIDEvent struct {
+	BaseEvent
+	Old Credentials `field:"old"`
+	New Credentials `field:"new"`
+}
+
+func (e *SetIDEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"old":%s,`, e.Old.marshalJSON())
+	fmt.Fprintf(&buf, `"new":%s`, e.New.marshalJSON())
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *SetIDEvent) UnmarshalBinary(data []byte) (int, error) {
+	return unmarshalBinary(data, &e.BaseEvent, &e.Old, &e.New)
+}
+
+// CapabilitySets represents the effective, permitted and inheritable capability sets of a process
+type CapabilitySets struct {
+	Effective   uint64 `field:"cap_effective"`
+	Permitted   uint64 `field:"cap_permitted"`
+	Inheritable uint64 `field:"cap_inheritable"`
+}
+
+func (c *CapabilitySets) marshalJSON() []byte {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"cap_effective":"%s",`, KernelCapability(c.Effective))
+	fmt.Fprintf(&buf, `"cap_permitted":"%s",`, KernelCapability(c.Permitted))
+	fmt.Fprintf(&buf, `"cap_inheritable":"%s"`, KernelCapability(c.Inheritable))
+	buf.WriteRune('}')
+
+	return buf.Bytes()
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (c *CapabilitySets) UnmarshalBinary(data []byte) (int, error) {
+	if len(data) < 24 {
+		return 0, ErrNotEnoughData
+	}
+
+	c.Effective = byteOrder.Uint64(data[0:8])
+	c.Permitted = byteOrder.Uint64(data[8:16])
+	c.Inheritable = byteOrder.Uint64(data[16:24])
+	return 24, nil
+}
+
+// CapsetEvent represents a capset event, holding the capability sets of the thread before and after the change.
+// Gained holds the capabilities added to the effective or permitted sets.
+type CapsetEvent struct {
+	BaseEvent
+	Old    CapabilitySets `field:"old"`
+	New    CapabilitySets `field:"new"`
+	Gained uint64         `field:"cap_gained"`
+}
+
+func (e *CapsetEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"old":%s,`, e.Old.marshalJSON())
+	fmt.Fprintf(&buf, `"new":%s,`, e.New.marshalJSON())
+	fmt.Fprintf(&buf, `"cap_gained":"%s"`, KernelCapability(e.Gained))
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *CapsetEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent, &e.Old, &e.New)
+	if err != nil {
+		return n, err
+	}
+
+	e.Gained = (e.New.Effective | e.New.Permitted) &^ (e.Old.Effective | e.Old.Permitted)
+	return n, nil
+}
+
+// TaskCredentials represents the identifiers and the capabilities of a process
+type TaskCredentials struct {
+	Credentials
+	CapabilitySets
+}
+
+func (c *TaskCredentials) marshalJSON() []byte {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"ids":%s,`, c.Credentials.marshalJSON())
+	fmt.Fprintf(&buf, `"capabilities":%s`, c.CapabilitySets.marshalJSON())
+	buf.WriteRune('}')
+
+	return buf.Bytes()
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (c *TaskCredentials) UnmarshalBinary(data []byte) (int, error) {
+	return unmarshalBinary(data, &c.Credentials, &c.CapabilitySets)
+}
+
+// CommitCredsEvent represents a change of the credentials of a process, holding
+// the credentials before and after the change
+type CommitCredsEvent struct {
+	BaseEvent
+	Old TaskCredentials `field:"old"`
+	New TaskCredentials `field:"new"`
+}
+
+func (e *CommitCredsEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"old":%s,`, e.Old.marshalJSON())
+	fmt.Fprintf(&buf, `"new":%s`, e.New.marshalJSON())
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *CommitCredsEvent) UnmarshalBinary(data []byte) (int, error) {
+	return unmarshalBinary(data, &e.BaseEvent, &e.Old, &e.New)
+}
+
+// InitModuleEvent represents a kernel module load event. The file is only set when the module was
+// loaded from a file descriptor, with finit_module.
+type InitModuleEvent struct {
+	BaseEvent
+	FileEvent
+	Name string `field:"name"`
+}
+
+func (e *InitModuleEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	if e.Inode != 0 {
+		fmt.Fprintf(&buf, `"filename":"%s",`, e.ResolveInode(resolvers))
+		fmt.Fprintf(&buf, `"container_path":"%s",`, e.ResolveContainerPath(resolvers))
+		fmt.Fprintf(&buf, `"inode":%d,`, e.Inode)
+		fmt.Fprintf(&buf, `"mount_id":%d,`, e.MountID)
+		fmt.Fprintf(&buf, `"overlay_numlower":%d,`, e.OverlayNumLower)
+	}
+	fmt.Fprintf(&buf, `"name":"%s"`, e.Name)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *InitModuleEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent, &e.FileEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 56 {
+		return n, ErrNotEnoughData
+	}
+
+	name := data[0:56]
+	if i := bytes.IndexByte(name, 0); i >= 0 {
+		name = name[:i]
+	}
+	e.Name = string(name)
+
+	return n + 56, nil
+}
+
+// IPPortContext represents an IP address and a port
+type IPPortContext struct {
+	IP   string `field:"ip"`
+	Port uint32 `field:"port"`
+}
+
+func (c *IPPortContext) marshalJSON() []byte {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"ip":"%s",`, c.IP)
+	fmt.Fprintf(&buf, `"port":%d`, c.Port)
+	buf.WriteRune('}')
+
+	return buf.Bytes()
+}
+
+// SocketEvent represents a connect or a bind event on an internet socket
+type SocketEvent struct {
+	BaseEvent
+	Family      uint32        `field:"family"`
+	Type        uint32        `field:"type"`
+	Source      IPPortContext `field:"source"`
+	Destination IPPortContext `field:"destination"`
+}
+
+func (e *SocketEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"family":"%s",`, AddressFamily(e.Family))
+	fmt.Fprintf(&buf, `"type":"%s",`, SocketType(e.Type))
+	fmt.Fprintf(&buf, `"source":%s,`, e.Source.marshalJSON())
+	fmt.Fprintf(&buf, `"destination":%s`, e.Destination.marshalJSON())
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *SocketEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 40 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Family = uint32(byteOrder.Uint16(data[0:2]))
+	e.Type = uint32(byteOrder.Uint16(data[2:4]))
+	e.Source.Port = uint32(byteOrder.Uint16(data[4:6]))
+	// the destination port is sent in network byte order
+	e.Destination.Port = uint32(binary.BigEndian.Uint16(data[6:8]))
+
+	ipLen := net.IPv6len
+	if e.Family == syscall.AF_INET {
+		ipLen = net.IPv4len
+	}
+	e.Source.IP = net.IP(data[8 : 8+ipLen]).String()
+	e.Destination.IP = net.IP(data[24 : 24+ipLen]).String()
+
+	return n + 40, nil
+}
+
+// SetnsEvent represents a setns event. NSInode holds the inode of the namespace referred by the file descriptor.
+type SetnsEvent struct {
+	BaseEvent
+	NSInode uint64 `field:"ns_inode"`
+	FD      uint32 `field:"fd"`
+	NSType  uint32 `field:"nstype"`
+}
+
+func (e *SetnsEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"ns_inode":%d,`, e.NSInode)
+	fmt.Fprintf(&buf, `"fd":%d,`, e.FD)
+	fmt.Fprintf(&buf, `"nstype":"%s"`, NamespaceType(e.NSType))
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *SetnsEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 16 {
+		return n, ErrNotEnoughData
+	}
+
+	e.NSInode = byteOrder.Uint64(data[0:8])
+	e.FD = byteOrder.Uint32(data[8:12])
+	e.NSType = byteOrder.Uint32(data[12:16])
+	return n + 16, nil
+}
+
+// BPFEvent represents a bpf event
+type BPFEvent struct {
+	BaseEvent
+	Cmd uint32 `field:"cmd"`
+}
+
+func (e *BPFEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"cmd":"%s"`, BPFCmd(e.Cmd))
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *BPFEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Cmd = byteOrder.Uint32(data[0:4])
+	return n + 8, nil
+}
+
+// PtraceEvent represents a ptrace event
+type PtraceEvent struct {
+	BaseEvent
+	Request uint32 `field:"request"`
+	PID     uint32 `field:"pid"`
+}
+
+func (e *PtraceEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"request":"%s",`, PtraceRequest(e.Request))
+	fmt.Fprintf(&buf, `"pid":%d`, e.PID)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *PtraceEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Request = byteOrder.Uint32(data[0:4])
+	e.PID = byteOrder.Uint32(data[4:8])
+	return n + 8, nil
+}
+
+// KillEvent represents a kill or a tgkill event. PID is the target process, or thread group.
+type KillEvent struct {
+	BaseEvent
+	PID    uint32 `field:"pid"`
+	Signal uint32 `field:"signal"`
+}
+
+func (e *KillEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"pid":%d,`, e.PID)
+	fmt.Fprintf(&buf, `"signal":"%s"`, Signal(e.Signal))
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *KillEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.PID = byteOrder.Uint32(data[0:4])
+	e.Signal = byteOrder.Uint32(data[4:8])
+	return n + 8, nil
+}
+
+// MemfdCreateEvent represents a memfd_create event. The file descriptor of the anonymous memory file is the return
+// value, its name is truncated to maxMemfdNameLen-1 bytes.
+type MemfdCreateEvent struct {
+	BaseEvent
+	Flags uint32 `field:"flags"`
+	Name  string `field:"name"`
+}
+
+func (e *MemfdCreateEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	name, err := json.Marshal(e.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"name":%s,`, name)
+	fmt.Fprintf(&buf, `"flags":%d`, e.Flags)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *MemfdCreateEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8+maxMemfdNameLen {
+		return n, ErrNotEnoughData
+	}
+
+	e.Flags = byteOrder.Uint32(data[0:4])
+	name := data[8 : 8+maxMemfdNameLen]
+	if i := bytes.IndexByte(name, 0); i >= 0 {
+		name = name[:i]
+	}
+	e.Name = string(name)
+
+	return n + 8 + maxMemfdNameLen, nil
+}
+
+// IOUringEvent represents an io_uring request issued by a process. The requests are not going through the syscalls
+// of the other events, Operation reports their normalized type. The file is the one targeted by the request, or the
+// one it opened for an open request that succeeded.
+type IOUringEvent struct {
+	BaseEvent
+	FileEvent
+	Operation uint32 `field:"operation"`
+}
+
+func (e *IOUringEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	if e.Inode != 0 {
+		fmt.Fprintf(&buf, `"filename":"%s",`, e.ResolveInode(resolvers))
+		fmt.Fprintf(&buf, `"container_path":"%s",`, e.ResolveContainerPath(resolvers))
+		fmt.Fprintf(&buf, `"inode":%d,`, e.Inode)
+		fmt.Fprintf(&buf, `"mount_id":%d,`, e.MountID)
+		fmt.Fprintf(&buf, `"overlay_numlower":%d,`, e.OverlayNumLower)
+	}
+	fmt.Fprintf(&buf, `"operation":"%s"`, IOUringOperation(e.Operation))
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *IOUringEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent, &e.FileEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Operation = byteOrder.Uint32(data[0:4])
+	return n + 8, nil
+}
+
+// PrctlEvent represents a prctl event. OldName is the name of the process when prctl was called, NewName the one
+// set by a PR_SET_NAME option. Arg is the second argument of prctl, such as the mode of PR_SET_SECCOMP.
+type PrctlEvent struct {
+	BaseEvent
+	Option  uint32 `field:"option"`
+	Arg     uint64 `field:"arg"`
+	OldName string `field:"old_name"`
+	NewName string `field:"new_name"`
+}
+
+func (e *PrctlEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	oldName, err := json.Marshal(e.OldName)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"option":"%s",`, PrctlOption(e.Option))
+	fmt.Fprintf(&buf, `"arg":%d,`, e.Arg)
+	fmt.Fprintf(&buf, `"old_name":%s`, oldName)
+	if e.NewName != "" {
+		newName, err := json.Marshal(e.NewName)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, `,"new_name":%s`, newName)
+	}
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *PrctlEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 48 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Arg = byteOrder.Uint64(data[0:8])
+	e.Option = byteOrder.Uint32(data[8:12])
+	e.OldName = string(bytes.Trim(data[16:32], "\x00"))
+	e.NewName = string(bytes.Trim(data[32:48], "\x00"))
+	return n + 48, nil
+}
+
+// ForkEvent represents the creation of a new process. The parent process is described by the process context
+type ForkEvent struct {
+	BaseEvent
+	ChildPID uint32 `field:"child_pid"`
+}
+
+func (e *ForkEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"child_pid":%d`, e.ChildPID)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *ForkEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.ChildPID = byteOrder.Uint32(data[0:4])
+	return n + 8, nil
+}
+
+// ExitEvent represents the termination of a process. Code holds the exit status when the process
+// exited normally, or the signal that terminated it otherwise.
+type ExitEvent struct {
+	BaseEvent
+	Cause uint32 `field:"cause"`
+	Code  uint32 `field:"code"`
+}
+
+func (e *ExitEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"cause":"%s",`, ExitCause(e.Cause))
+	fmt.Fprintf(&buf, `"code":%d`, e.Code)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *ExitEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	// the kernel exit code follows the wait status encoding
+	status := syscall.WaitStatus(byteOrder.Uint32(data[0:4]))
+	switch {
+	case status.Exited():
+		e.Cause = uint32(ExitExited)
+		e.Code = uint32(status.ExitStatus())
+	case status.CoreDump():
+		e.Cause = uint32(ExitCoreDumped)
+		e.Code = uint32(status.Signal())
+	default:
+		e.Cause = uint32(ExitSignaled)
+		e.Code = uint32(status.Signal())
+	}
+	return n + 8, nil
+}
+
 // OpenEvent represents an open event
 type OpenEvent struct {
 	BaseEvent
@@ -425,6 +1055,46 @@ func (e *MkdirEvent) UnmarshalBinary(data []byte) (int, error) {
 	return n + 4, nil
 }
 
+// TruncateEvent represents a truncate or a ftruncate event. Wipe is set when the file is truncated to zero bytes.
+type TruncateEvent struct {
+	BaseEvent
+	FileEvent
+	Length uint64 `field:"length"`
+	Wipe   bool   `field:"wipe"`
+}
+
+func (e *TruncateEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"filename":"%s",`, e.ResolveInode(resolvers))
+	fmt.Fprintf(&buf, `"container_path":"%s",`, e.ResolveContainerPath(resolvers))
+	fmt.Fprintf(&buf, `"inode":%d,`, e.Inode)
+	fmt.Fprintf(&buf, `"mount_id":%d,`, e.MountID)
+	fmt.Fprintf(&buf, `"overlay_numlower":%d,`, e.OverlayNumLower)
+	fmt.Fprintf(&buf, `"length":%d,`, e.Length)
+	fmt.Fprintf(&buf, `"wipe":%t`, e.Wipe)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *TruncateEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent, &e.FileEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Length = byteOrder.Uint64(data[0:8])
+	e.Wipe = e.Length == 0
+	return n + 8, nil
+}
+
 // RmdirEvent represents a rmdir event
 type RmdirEvent struct {
 	BaseEvent
@@ -489,6 +1159,18 @@ func (e *RenameEvent) UnmarshalBinary(data []byte) (int, error) {
 	return unmarshalBinary(data, &e.BaseEvent, &e.Old, &e.New)
 }
 
+// ChrootEvent represents a chroot or a pivot_root event. OldRoot holds the root of the process before the change.
+type ChrootEvent struct {
+	BaseEvent
+	Root    FileEvent `field:"root"`
+	OldRoot FileEvent `field:"old_root"`
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *ChrootEvent) UnmarshalBinary(data []byte) (int, error) {
+	return unmarshalBinary(data, &e.BaseEvent, &e.Root, &e.OldRoot)
+}
+
 // UtimesEvent represents a utime event
 type UtimesEvent struct {
 	BaseEvent
@@ -547,21 +1229,69 @@ func (e *LinkEvent) UnmarshalBinary(data []byte) (int, error) {
 	return unmarshalBinary(data, &e.BaseEvent, &e.Source, &e.Target)
 }
 
+// maxSymlinkTargetLen is the size of the buffer of the target of a symbolic link, including the trailing NUL byte
+const maxSymlinkTargetLen = 128
+
+// SymlinkEvent represents the creation of a symbolic link. Target is the path the link points to, as given to the
+// syscall, truncated to maxSymlinkTargetLen-1 bytes. It doesn't have to exist.
+type SymlinkEvent struct {
+	BaseEvent
+	Link   FileEvent `field:"linkpath"`
+	Target string    `field:"target"`
+}
+
+func (e *SymlinkEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	target, err := json.Marshal(e.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"target":%s`, target)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *SymlinkEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent, &e.Link)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < maxSymlinkTargetLen {
+		return n, ErrNotEnoughData
+	}
+
+	target := data[:maxSymlinkTargetLen]
+	if i := bytes.IndexByte(target, 0); i >= 0 {
+		target = target[:i]
+	}
+	e.Target = string(target)
+
+	return n + maxSymlinkTargetLen, nil
+}
+
 // MountEvent represents a mount event
 type MountEvent struct {
 	BaseEvent
-	NewMountID    uint32
-	NewGroupID    uint32
-	NewDevice     uint32
-	ParentMountID uint32
-	ParentInode   uint64
-	FSType        string
-	MountPointStr string
-	RootMountID   uint32
-	RootInode     uint64
-	RootStr       string
-
-	FSTypeRaw [16]byte
+	NewMountID    uint32 `field:"-"`
+	NewGroupID    uint32 `field:"-"`
+	NewDevice     uint32 `field:"-"`
+	ParentMountID uint32 `field:"-"`
+	ParentInode   uint64 `field:"-"`
+	FSType        string `field:"fs_type" handler:"ResolveFSType,string"`
+	MountPointStr string `field:"target" handler:"ResolveMountPoint,string"`
+	RootMountID   uint32 `field:"-"`
+	RootInode     uint64 `field:"-"`
+	RootStr       string `field:"root" handler:"ResolveRoot,string"`
+	SourceStr     string `field:"source"`
+	Flags         uint64 `field:"flags"`
+
+	FSTypeRaw [16]byte `field:"-"`
 }
 
 func (e *MountEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
@@ -576,7 +1306,9 @@ func (e *MountEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
 	fmt.Fprintf(&buf, `"new_mount_id":%d,`, e.NewMountID)
 	fmt.Fprintf(&buf, `"new_group_id":%d,`, e.NewGroupID)
 	fmt.Fprintf(&buf, `"new_device":%d,`, e.NewDevice)
-	fmt.Fprintf(&buf, `"fstype":"%s"`, e.GetFSType())
+	fmt.Fprintf(&buf, `"fstype":"%s",`, e.GetFSType())
+	fmt.Fprintf(&buf, `"source":"%s",`, e.SourceStr)
+	fmt.Fprintf(&buf, `"flags":"%s"`, MountFlags(e.Flags))
 	buf.WriteRune('}')
 
 	return buf.Bytes(), nil
@@ -590,7 +1322,7 @@ func (e *MountEvent) UnmarshalBinary(data []byte) (int, error) {
 	}
 
 	data = data[n:]
-	if len(data) < 56 {
+	if len(data) < 128 {
 		return 0, ErrNotEnoughData
 	}
 
@@ -606,7 +1338,15 @@ func (e *MountEvent) UnmarshalBinary(data []byte) (int, error) {
 		return 40, err
 	}
 
-	return 56, nil
+	e.Flags = byteOrder.Uint64(data[56:64])
+
+	source := data[64:128]
+	if n := bytes.IndexByte(source, 0); n >= 0 {
+		source = source[:n]
+	}
+	e.SourceStr = string(source)
+
+	return 128, nil
 }
 
 // ResolveMountPoint resolves the mountpoint to a full path
@@ -625,6 +1365,11 @@ func (e *MountEvent) ResolveRoot(resolvers *Resolvers) string {
 	return e.RootStr
 }
 
+// ResolveFSType resolves the filesystem type of the mountpoint
+func (e *MountEvent) ResolveFSType(resolvers *Resolvers) string {
+	return e.GetFSType()
+}
+
 // GetFSType returns the filesystem type of the mountpoint
 func (e *MountEvent) GetFSType() string {
 	if len(e.FSType) == 0 {
@@ -636,13 +1381,15 @@ func (e *MountEvent) GetFSType() string {
 // UmountEvent represents an umount event
 type UmountEvent struct {
 	BaseEvent
-	MountID uint32
+	MountID   uint32 `field:"-"`
+	TargetStr string `field:"target" handler:"ResolveTarget,string"`
 }
 
 func (e *UmountEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
 	var buf bytes.Buffer
 	buf.WriteRune('{')
-	fmt.Fprintf(&buf, `"mount_id":%d`, e.MountID)
+	fmt.Fprintf(&buf, `"mount_id":%d,`, e.MountID)
+	fmt.Fprintf(&buf, `"target":"%s"`, e.ResolveTarget(resolvers))
 	buf.WriteRune('}')
 
 	return buf.Bytes(), nil
@@ -664,11 +1411,24 @@ func (e *UmountEvent) UnmarshalBinary(data []byte) (int, error) {
 	return 4, nil
 }
 
+// ResolveTarget resolves the path of the unmounted mountpoint. It has to be called before the mount point
+// is removed from the mount resolver cache.
+func (e *UmountEvent) ResolveTarget(resolvers *Resolvers) string {
+	if len(e.TargetStr) == 0 {
+		_, e.TargetStr, _, _ = resolvers.MountResolver.GetMountPath(e.MountID, 0)
+	}
+	return e.TargetStr
+}
+
 // ContainerEvent holds the container context of an event
 type ContainerEvent struct {
-	ID string `field:"id" handler:"ResolveContainerID,string"`
+	ID         string `field:"id" handler:"ResolveContainerID,string"`
+	CgroupPath string `field:"cgroup_path" handler:"ResolveCgroupPath,string"`
 
-	IDRaw [64]byte `field:"-"`
+	IDRaw         [64]byte `field:"-"`
+	CgroupInode   uint64   `field:"-"`
+	CgroupMountID uint32   `field:"-"`
+	Pid           uint32   `field:"-"`
 }
 
 func (e *ContainerEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
@@ -677,6 +1437,12 @@ func (e *ContainerEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
 	if id := e.GetContainerID(); len(id) > 0 {
 		fmt.Fprintf(&buf, `"container_id":"%s"`, id)
 	}
+	if cgroupPath := e.ResolveCgroupPath(resolvers); len(cgroupPath) > 0 {
+		if buf.Len() > 1 {
+			buf.WriteRune(',')
+		}
+		fmt.Fprintf(&buf, `"cgroup_path":"%s"`, cgroupPath)
+	}
 	buf.WriteRune('}')
 
 	return buf.Bytes(), nil
@@ -684,13 +1450,16 @@ func (e *ContainerEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
 
 // UnmarshalBinary unmarshals a binary representation of itself
 func (e *ContainerEvent) UnmarshalBinary(data []byte) (int, error) {
-	if len(data) < 64 {
+	if len(data) < 80 {
 		return 0, ErrNotEnoughData
 	}
 	if err := binary.Read(bytes.NewBuffer(data[0:64]), byteOrder, &e.IDRaw); err != nil {
 		return 0, err
 	}
-	return 64, nil
+	e.CgroupInode = byteOrder.Uint64(data[64:72])
+	e.CgroupMountID = byteOrder.Uint32(data[72:76])
+	// the overlay numlower of the cgroup directory, at 76:80, isn't used
+	return 80, nil
 }
 
 // ResolveContainerID resolves the container ID of the event
@@ -698,6 +1467,24 @@ func (e *ContainerEvent) ResolveContainerID(resolvers *Resolvers) string {
 	return e.GetContainerID()
 }
 
+// ResolveCgroupPath resolves the path of the control group of the process of the event, relative to the root of its
+// hierarchy. The control group directory is captured by the kernel when the process, or one of its ancestors, is
+// moved to it, so that it's resolved even once the process exited. The processes that were already running when the
+// probe started fall back to procfs.
+func (e *ContainerEvent) ResolveCgroupPath(resolvers *Resolvers) string {
+	if len(e.CgroupPath) == 0 && resolvers != nil {
+		if e.CgroupInode != 0 {
+			if path := resolvers.DentryResolver.Resolve(e.CgroupMountID, e.CgroupInode); path != dentryPathKeyNotFound {
+				e.CgroupPath = path
+			}
+		}
+		if len(e.CgroupPath) == 0 && e.Pid != 0 {
+			e.CgroupPath, _ = resolvers.ContainerResolver.GetCgroupPath(e.Pid)
+		}
+	}
+	return e.CgroupPath
+}
+
 // GetContainerID returns the container ID of the event
 func (e *ContainerEvent) GetContainerID() string {
 	if len(e.ID) == 0 {
@@ -712,24 +1499,42 @@ func (e *ContainerEvent) GetContainerID() string {
 // ProcessEvent holds the process context of an event
 type ProcessEvent struct {
 	FileEvent
-	Pidns   uint64 `field:"pidns"`
-	Comm    string `field:"name" handler:"ResolveComm,string"`
-	TTYName string `field:"tty_name" handler:"ResolveTTY,string"`
-	Pid     uint32 `field:"pid"`
-	Tid     uint32 `field:"tid"`
-	UID     uint32 `field:"uid"`
-	GID     uint32 `field:"gid"`
-	User    string `field:"user" handler:"ResolveUser,string"`
-	Group   string `field:"group" handler:"ResolveGroup,string"`
-
-	CommRaw    [16]byte `field:"-"`
-	TTYNameRaw [64]byte `field:"-"`
+	Pidns   uint64    `field:"pidns"`
+	Comm    string    `field:"name" handler:"ResolveComm,string"`
+	TTYName string    `field:"tty_name" handler:"ResolveTTY,string"`
+	Pid     uint32    `field:"pid"`
+	Tid     uint32    `field:"tid"`
+	UID     uint32    `field:"uid"`
+	GID     uint32    `field:"gid"`
+	User    string    `field:"user" handler:"ResolveUser,string"`
+	Group   string    `field:"group" handler:"ResolveGroup,string"`
+	Args    string    `field:"args" handler:"ResolveArgs,string"`
+	Envs    string    `field:"envs" handler:"ResolveEnvs,string"`
+	Script  FileEvent `field:"script"`
+	// Fileless is set when the process was executed from a file created by memfd_create, MemfdName is its name
+	Fileless  bool   `field:"fileless" handler:"ResolveFileless,bool"`
+	MemfdName string `field:"memfd_name" handler:"ResolveMemfdName,string"`
+	// Mntns and Netns are the inode numbers of the mount and network namespaces of the process, a process of the host
+	// reports the namespaces of the init process
+	Mntns uint32 `field:"mntns"`
+	Netns uint32 `field:"netns"`
+
+	Cookie        uint32   `field:"-"`
+	CommRaw       [16]byte `field:"-"`
+	TTYNameRaw    [64]byte `field:"-"`
+	ArgsRaw       []string `field:"-"`
+	ArgsTruncated bool     `field:"-"`
+	EnvsRaw       []string `field:"-"`
+	EnvsTruncated bool     `field:"-"`
+	MemfdResolved bool     `field:"-"`
 }
 
 func (p *ProcessEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
 	var buf bytes.Buffer
 	buf.WriteRune('{')
 	fmt.Fprintf(&buf, `"pidns":%d,`, p.Pidns)
+	fmt.Fprintf(&buf, `"mntns":%d,`, p.Mntns)
+	fmt.Fprintf(&buf, `"netns":%d,`, p.Netns)
 	fmt.Fprintf(&buf, `"name":"%s",`, p.GetComm())
 	if tty := p.GetTTY(); tty != "" {
 		fmt.Fprintf(&buf, `"tty_name":"%s",`, tty)
@@ -738,11 +1543,76 @@ func (p *ProcessEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
 	fmt.Fprintf(&buf, `"tid":%d,`, p.Tid)
 	fmt.Fprintf(&buf, `"uid":%d,`, p.UID)
 	fmt.Fprintf(&buf, `"gid":%d`, p.GID)
+	if p.Script.Inode != 0 {
+		script, err := p.Script.marshalJSON(resolvers)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, `,"script":%s`, script)
+	}
+	if p.ResolveFileless(resolvers) {
+		name, err := json.Marshal(p.MemfdName)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, `,"fileless":true,"memfd_name":%s`, name)
+	}
+	p.ResolveArgs(resolvers)
+	if len(p.ArgsRaw) > 0 {
+		args, err := json.Marshal(p.ArgsRaw)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, `,"args":%s`, args)
+		fmt.Fprintf(&buf, `,"args_truncated":%t`, p.ArgsTruncated)
+	}
+	p.ResolveEnvs(resolvers)
+	if len(p.EnvsRaw) > 0 {
+		envs, err := json.Marshal(p.EnvsRaw)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, `,"envs":%s`, envs)
+		fmt.Fprintf(&buf, `,"envs_truncated":%t`, p.EnvsTruncated)
+	}
 	buf.WriteRune('}')
 
 	return buf.Bytes(), nil
 }
 
+// ResolveArgs resolves the arguments of the process, as captured when it was executed
+func (p *ProcessEvent) ResolveArgs(resolvers *Resolvers) string {
+	if len(p.Args) == 0 && p.Cookie != 0 {
+		p.ArgsRaw, p.ArgsTruncated = resolvers.ResolveProcessArgs(p.Cookie)
+		p.Args = strings.Join(p.ArgsRaw, " ")
+	}
+	return p.Args
+}
+
+// ResolveEnvs resolves the allowlisted environment variables of the process, as captured when it was executed
+func (p *ProcessEvent) ResolveEnvs(resolvers *Resolvers) string {
+	if len(p.Envs) == 0 && p.Cookie != 0 {
+		p.EnvsRaw, p.EnvsTruncated = resolvers.ResolveProcessEnvs(p.Cookie)
+		p.Envs = strings.Join(p.EnvsRaw, " ")
+	}
+	return p.Envs
+}
+
+// ResolveFileless resolves whether the process was executed from a file created by memfd_create
+func (p *ProcessEvent) ResolveFileless(resolvers *Resolvers) bool {
+	if !p.MemfdResolved && p.Cookie != 0 {
+		p.MemfdName, p.Fileless = resolvers.ResolveProcessMemfd(p.Cookie)
+		p.MemfdResolved = true
+	}
+	return p.Fileless
+}
+
+// ResolveMemfdName resolves the name given to memfd_create for the file the process was executed from
+func (p *ProcessEvent) ResolveMemfdName(resolvers *Resolvers) string {
+	p.ResolveFileless(resolvers)
+	return p.MemfdName
+}
+
 // ResolveTTY resolves the name of the process tty
 func (p *ProcessEvent) ResolveTTY(resolvers *Resolvers) string {
 	return p.GetTTY()
@@ -789,7 +1659,7 @@ func (p *ProcessEvent) ResolveGroup(resolvers *Resolvers) string {
 
 // UnmarshalBinary unmarshals a binary representation of itself
 func (p *ProcessEvent) UnmarshalBinary(data []byte) (int, error) {
-	if len(data) < 108 {
+	if len(data) < 112 {
 		return 0, ErrNotEnoughData
 	}
 	p.Pidns = byteOrder.Uint64(data[0:8])
@@ -803,12 +1673,21 @@ func (p *ProcessEvent) UnmarshalBinary(data []byte) (int, error) {
 	p.Tid = byteOrder.Uint32(data[92:96])
 	p.UID = byteOrder.Uint32(data[96:100])
 	p.GID = byteOrder.Uint32(data[100:104])
+	p.Cookie = byteOrder.Uint32(data[104:108])
 
-	read, err := p.FileEvent.UnmarshalBinary(data[104:])
+	read, err := unmarshalBinary(data[112:], &p.FileEvent, &p.Script)
 	if err != nil {
-		return 104 + read, err
+		return 112 + read, err
+	}
+	read += 112
+
+	if len(data[read:]) < 8 {
+		return read, ErrNotEnoughData
 	}
-	return 104 + read, nil
+	p.Mntns = byteOrder.Uint32(data[read : read+4])
+	p.Netns = byteOrder.Uint32(data[read+4 : read+8])
+
+	return read + 8, nil
 }
 
 // Event represents an event sent from the kernel
@@ -817,21 +1696,41 @@ type Event struct {
 	ID   string `field:"-"`
 	Type uint64 `field:"-"`
 
-	Process     ProcessEvent   `yaml:"process" field:"process" event:"*"`
-	Container   ContainerEvent `yaml:"container" field:"container"`
-	Chmod       ChmodEvent     `yaml:"chmod" field:"chmod" event:"chmod"`
-	Chown       ChownEvent     `yaml:"chown" field:"chown" event:"chown"`
-	Open        OpenEvent      `yaml:"open" field:"open" event:"open"`
-	Mkdir       MkdirEvent     `yaml:"mkdir" field:"mkdir" event:"mkdir"`
-	Rmdir       RmdirEvent     `yaml:"rmdir" field:"rmdir" event:"rmdir"`
-	Rename      RenameEvent    `yaml:"rename" field:"rename" event:"rename"`
-	Unlink      UnlinkEvent    `yaml:"unlink" field:"unlink" event:"unlink"`
-	Utimes      UtimesEvent    `yaml:"utimes" field:"utimes" event:"utimes"`
-	Link        LinkEvent      `yaml:"link" field:"link" event:"link"`
-	SetXAttr    SetXAttrEvent  `yaml:"setxattr" field:"setxattr" event:"setxattr"`
-	RemoveXAttr SetXAttrEvent  `yaml:"removexattr" field:"removexattr" event:"removexattr"`
-	Mount       MountEvent     `yaml:"mount" field:"-"`
-	Umount      UmountEvent    `yaml:"umount" field:"-"`
+	Process     ProcessEvent     `yaml:"process" field:"process" event:"*"`
+	Container   ContainerEvent   `yaml:"container" field:"container"`
+	Chmod       ChmodEvent       `yaml:"chmod" field:"chmod" event:"chmod"`
+	Chown       ChownEvent       `yaml:"chown" field:"chown" event:"chown"`
+	Open        OpenEvent        `yaml:"open" field:"open" event:"open"`
+	Mkdir       MkdirEvent       `yaml:"mkdir" field:"mkdir" event:"mkdir"`
+	Rmdir       RmdirEvent       `yaml:"rmdir" field:"rmdir" event:"rmdir"`
+	Rename      RenameEvent      `yaml:"rename" field:"rename" event:"rename"`
+	Unlink      UnlinkEvent      `yaml:"unlink" field:"unlink" event:"unlink"`
+	Utimes      UtimesEvent      `yaml:"utimes" field:"utimes" event:"utimes"`
+	Link        LinkEvent        `yaml:"link" field:"link" event:"link"`
+	Symlink     SymlinkEvent     `yaml:"symlink" field:"symlink" event:"symlink"`
+	Truncate    TruncateEvent    `yaml:"truncate" field:"truncate" event:"truncate"`
+	SetXAttr    SetXAttrEvent    `yaml:"setxattr" field:"setxattr" event:"setxattr"`
+	RemoveXAttr SetXAttrEvent    `yaml:"removexattr" field:"removexattr" event:"removexattr"`
+	SetUID      SetIDEvent       `yaml:"setuid" field:"setuid" event:"setuid"`
+	SetGID      SetIDEvent       `yaml:"setgid" field:"setgid" event:"setgid"`
+	Ptrace      PtraceEvent      `yaml:"ptrace" field:"ptrace" event:"ptrace"`
+	Fork        ForkEvent        `yaml:"fork" field:"fork" event:"fork"`
+	Exit        ExitEvent        `yaml:"exit" field:"exit" event:"exit"`
+	CommitCreds CommitCredsEvent `yaml:"commit_creds" field:"commit_creds" event:"commit_creds"`
+	InitModule  InitModuleEvent  `yaml:"init_module" field:"init_module" event:"init_module"`
+	Connect     SocketEvent      `yaml:"connect" field:"connect" event:"connect"`
+	Bind        SocketEvent      `yaml:"bind" field:"bind" event:"bind"`
+	Setns       SetnsEvent       `yaml:"setns" field:"setns" event:"setns"`
+	BPF         BPFEvent         `yaml:"bpf" field:"bpf" event:"bpf"`
+	Chroot      ChrootEvent      `yaml:"chroot" field:"chroot" event:"chroot"`
+	PivotRoot   ChrootEvent      `yaml:"pivot_root" field:"pivot_root" event:"pivot_root"`
+	Kill        KillEvent        `yaml:"kill" field:"kill" event:"kill"`
+	MemfdCreate MemfdCreateEvent `yaml:"memfd_create" field:"memfd_create" event:"memfd_create"`
+	Capset      CapsetEvent      `yaml:"capset" field:"capset" event:"capset"`
+	IOUring     IOUringEvent     `yaml:"io_uring" field:"io_uring" event:"io_uring"`
+	Prctl       PrctlEvent       `yaml:"prctl" field:"prctl" event:"prctl"`
+	Mount       MountEvent       `yaml:"mount" field:"mount" event:"mount"`
+	Umount      UmountEvent      `yaml:"umount" field:"umount" event:"umount"`
 
 	resolvers *Resolvers `field:"-"`
 }
@@ -978,6 +1877,30 @@ func (e *Event) MarshalJSON() ([]byte, error) {
 				field:      "target",
 				marshalFnc: e.Link.Target.marshalJSON,
 			})
+	case FileTruncateEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Truncate.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "file",
+				marshalFnc: e.Truncate.marshalJSON,
+			})
+	case FileSymlinkEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Symlink.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "file",
+				marshalFnc: e.Symlink.Link.marshalJSON,
+			},
+			eventMarshaler{
+				field:      "symlink",
+				marshalFnc: e.Symlink.marshalJSON,
+			})
 	case FileMountEventType:
 		entries = append(entries,
 			eventMarshaler{
@@ -1018,6 +1941,194 @@ func (e *Event) MarshalJSON() ([]byte, error) {
 				field:      "file",
 				marshalFnc: e.RemoveXAttr.marshalJSON,
 			})
+	case SetuidEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.SetUID.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "credentials",
+				marshalFnc: e.SetUID.marshalJSON,
+			})
+	case SetgidEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.SetGID.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "credentials",
+				marshalFnc: e.SetGID.marshalJSON,
+			})
+	case PtraceEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Ptrace.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "ptrace",
+				marshalFnc: e.Ptrace.marshalJSON,
+			})
+	case ForkEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Fork.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "fork",
+				marshalFnc: e.Fork.marshalJSON,
+			})
+	case ExitEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Exit.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "exit",
+				marshalFnc: e.Exit.marshalJSON,
+			})
+	case CommitCredsEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.CommitCreds.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "credentials",
+				marshalFnc: e.CommitCreds.marshalJSON,
+			})
+	case InitModuleEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.InitModule.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "module",
+				marshalFnc: e.InitModule.marshalJSON,
+			})
+	case ConnectEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Connect.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "socket",
+				marshalFnc: e.Connect.marshalJSON,
+			})
+	case BindEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Bind.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "socket",
+				marshalFnc: e.Bind.marshalJSON,
+			})
+	case SetnsEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Setns.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "setns",
+				marshalFnc: e.Setns.marshalJSON,
+			})
+	case BPFEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.BPF.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "bpf",
+				marshalFnc: e.BPF.marshalJSON,
+			})
+	case ChrootEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Chroot.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "root",
+				marshalFnc: e.Chroot.Root.marshalJSON,
+			},
+			eventMarshaler{
+				field:      "old_root",
+				marshalFnc: e.Chroot.OldRoot.marshalJSON,
+			})
+	case PivotRootEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.PivotRoot.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "root",
+				marshalFnc: e.PivotRoot.Root.marshalJSON,
+			},
+			eventMarshaler{
+				field:      "old_root",
+				marshalFnc: e.PivotRoot.OldRoot.marshalJSON,
+			})
+	case KillEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Kill.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "kill",
+				marshalFnc: e.Kill.marshalJSON,
+			})
+	case MemfdCreateEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.MemfdCreate.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "memfd_create",
+				marshalFnc: e.MemfdCreate.marshalJSON,
+			})
+	case CapsetEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Capset.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "capset",
+				marshalFnc: e.Capset.marshalJSON,
+			})
+	case IOUringEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.IOUring.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "io_uring",
+				marshalFnc: e.IOUring.marshalJSON,
+			})
+	case PrctlEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Prctl.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "prctl",
+				marshalFnc: e.Prctl.marshalJSON,
+			})
 	}
 
 	var prev bool
@@ -1064,6 +2175,7 @@ func (e *Event) UnmarshalBinary(data []byte) (int, error) {
 	e.Type = byteOrder.Uint64(data[0:8])
 
 	n, err := unmarshalBinary(data[8:], &e.Process, &e.Container)
+	e.Container.Pid = e.Process.Pid
 	return n + 8, err
 }
 
@@ -13,6 +13,142 @@ import (
 func (m *Model) GetEvaluator(field eval.Field) (eval.Evaluator, error) {
 	switch field {
 
+	case "bind.destination.ip":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string { return (*Event)(ctx.Object).Bind.Destination.IP },
+
+			Field: field,
+		}, nil
+
+	case "bind.destination.port":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Bind.Destination.Port) },
+
+			Field: field,
+		}, nil
+
+	case "bind.family":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Bind.Family) },
+
+			Field: field,
+		}, nil
+
+	case "bind.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Bind.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "bind.source.ip":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string { return (*Event)(ctx.Object).Bind.Source.IP },
+
+			Field: field,
+		}, nil
+
+	case "bind.source.port":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Bind.Source.Port) },
+
+			Field: field,
+		}, nil
+
+	case "bind.type":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Bind.Type) },
+
+			Field: field,
+		}, nil
+
+	case "bpf.cmd":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).BPF.Cmd) },
+
+			Field: field,
+		}, nil
+
+	case "bpf.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).BPF.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "capset.cap_gained":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Capset.Gained) },
+
+			Field: field,
+		}, nil
+
+	case "capset.new.cap_effective":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Capset.New.Effective) },
+
+			Field: field,
+		}, nil
+
+	case "capset.new.cap_inheritable":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Capset.New.Inheritable) },
+
+			Field: field,
+		}, nil
+
+	case "capset.new.cap_permitted":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Capset.New.Permitted) },
+
+			Field: field,
+		}, nil
+
+	case "capset.old.cap_effective":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Capset.Old.Effective) },
+
+			Field: field,
+		}, nil
+
+	case "capset.old.cap_inheritable":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Capset.Old.Inheritable) },
+
+			Field: field,
+		}, nil
+
+	case "capset.old.cap_permitted":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Capset.Old.Permitted) },
+
+			Field: field,
+		}, nil
+
+	case "capset.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Capset.Retval) },
+
+			Field: field,
+		}, nil
+
 	case "chmod.basename":
 
 		return &eval.StringEvaluator{
@@ -145,2656 +281,7062 @@ func (m *Model) GetEvaluator(field eval.Field) (eval.Evaluator, error) {
 			Field: field,
 		}, nil
 
-	case "container.id":
+	case "chroot.old_root.basename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Container.ResolveContainerID((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Chroot.OldRoot.ResolveBasename((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "link.retval":
-
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Retval) },
-
-			Field: field,
-		}, nil
-
-	case "link.source.basename":
+	case "chroot.old_root.container_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Source.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Chroot.OldRoot.ResolveContainerPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "link.source.container_path":
+	case "chroot.old_root.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Source.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Chroot.OldRoot.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "link.source.filename":
+	case "chroot.old_root.inode":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Source.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Chroot.OldRoot.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "link.source.inode":
+	case "chroot.old_root.overlay_numlower":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Source.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Chroot.OldRoot.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "link.source.overlay_numlower":
+	case "chroot.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Source.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Chroot.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "link.target.basename":
+	case "chroot.root.basename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Target.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Chroot.Root.ResolveBasename((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "link.target.container_path":
+	case "chroot.root.container_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Target.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Chroot.Root.ResolveContainerPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "link.target.filename":
+	case "chroot.root.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Target.ResolveInode((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Chroot.Root.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "link.target.inode":
+	case "chroot.root.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Target.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Chroot.Root.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "link.target.overlay_numlower":
+	case "chroot.root.overlay_numlower":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Target.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Chroot.Root.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "mkdir.basename":
+	case "commit_creds.new.cap_effective":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Mkdir.ResolveBasename((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.New.Effective) },
 
 			Field: field,
 		}, nil
 
-	case "mkdir.container_path":
+	case "commit_creds.new.cap_inheritable":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Mkdir.ResolveContainerPath((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.New.Inheritable) },
 
 			Field: field,
 		}, nil
 
-	case "mkdir.filename":
+	case "commit_creds.new.cap_permitted":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Mkdir.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.New.Permitted) },
 
 			Field: field,
 		}, nil
 
-	case "mkdir.inode":
+	case "commit_creds.new.egid":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.New.EGID) },
 
 			Field: field,
 		}, nil
 
-	case "mkdir.mode":
+	case "commit_creds.new.euid":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Mode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.New.EUID) },
 
 			Field: field,
 		}, nil
 
-	case "mkdir.overlay_numlower":
+	case "commit_creds.new.fsgid":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.New.FSGID) },
 
 			Field: field,
 		}, nil
 
-	case "mkdir.retval":
+	case "commit_creds.new.fsuid":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.New.FSUID) },
 
 			Field: field,
 		}, nil
 
-	case "open.basename":
+	case "commit_creds.new.gid":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Open.ResolveBasename((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.New.GID) },
 
 			Field: field,
 		}, nil
 
-	case "open.container_path":
+	case "commit_creds.new.uid":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Open.ResolveContainerPath((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.New.UID) },
 
 			Field: field,
 		}, nil
 
-	case "open.filename":
+	case "commit_creds.old.cap_effective":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Open.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.Old.Effective) },
 
 			Field: field,
 		}, nil
 
-	case "open.flags":
+	case "commit_creds.old.cap_inheritable":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Flags) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.Old.Inheritable) },
 
 			Field: field,
 		}, nil
 
-	case "open.inode":
+	case "commit_creds.old.cap_permitted":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.Old.Permitted) },
 
 			Field: field,
 		}, nil
 
-	case "open.mode":
+	case "commit_creds.old.egid":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Mode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.Old.EGID) },
 
 			Field: field,
 		}, nil
 
-	case "open.overlay_numlower":
+	case "commit_creds.old.euid":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.Old.EUID) },
 
 			Field: field,
 		}, nil
 
-	case "open.retval":
+	case "commit_creds.old.fsgid":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.Old.FSGID) },
 
 			Field: field,
 		}, nil
 
-	case "process.basename":
+	case "commit_creds.old.fsuid":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveBasename((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.Old.FSUID) },
 
 			Field: field,
 		}, nil
 
-	case "process.container_path":
+	case "commit_creds.old.gid":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveContainerPath((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.Old.GID) },
 
 			Field: field,
 		}, nil
 
-	case "process.filename":
+	case "commit_creds.old.uid":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.Old.UID) },
 
 			Field: field,
 		}, nil
 
-	case "process.gid":
+	case "commit_creds.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.GID) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).CommitCreds.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "process.group":
+	case "connect.destination.ip":
 
 		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveGroup((*Event)(ctx.Object).resolvers)
-			},
+			EvalFnc: func(ctx *eval.Context) string { return (*Event)(ctx.Object).Connect.Destination.IP },
 
 			Field: field,
 		}, nil
 
-	case "process.inode":
+	case "connect.destination.port":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Connect.Destination.Port) },
 
 			Field: field,
 		}, nil
 
-	case "process.name":
+	case "connect.family":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveComm((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Connect.Family) },
 
 			Field: field,
 		}, nil
 
-	case "process.overlay_numlower":
+	case "connect.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Connect.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "process.pid":
+	case "connect.source.ip":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Pid) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string { return (*Event)(ctx.Object).Connect.Source.IP },
 
 			Field: field,
 		}, nil
 
-	case "process.pidns":
+	case "connect.source.port":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Pidns) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Connect.Source.Port) },
 
 			Field: field,
 		}, nil
 
-	case "process.tid":
+	case "connect.type":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Tid) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Connect.Type) },
 
 			Field: field,
 		}, nil
 
-	case "process.tty_name":
+	case "container.cgroup_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveTTY((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Container.ResolveCgroupPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "process.uid":
+	case "container.id":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.UID) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Container.ResolveContainerID((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "process.user":
+	case "exit.cause":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveUser((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Exit.Cause) },
 
 			Field: field,
 		}, nil
 
-	case "removexattr.basename":
+	case "exit.code":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).RemoveXAttr.ResolveBasename((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Exit.Code) },
 
 			Field: field,
 		}, nil
 
-	case "removexattr.container_path":
+	case "exit.retval":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).RemoveXAttr.ResolveContainerPath((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Exit.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "removexattr.filename":
+	case "fork.child_pid":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).RemoveXAttr.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Fork.ChildPID) },
 
 			Field: field,
 		}, nil
 
-	case "removexattr.inode":
+	case "fork.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).RemoveXAttr.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Fork.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "removexattr.name":
+	case "init_module.basename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).RemoveXAttr.GetName((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).InitModule.ResolveBasename((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "removexattr.namespace":
+	case "init_module.container_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).RemoveXAttr.GetNamespace((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).InitModule.ResolveContainerPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "removexattr.overlay_numlower":
+	case "init_module.filename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).InitModule.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "init_module.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).RemoveXAttr.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).InitModule.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "removexattr.retval":
+	case "init_module.name":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string { return (*Event)(ctx.Object).InitModule.Name },
+
+			Field: field,
+		}, nil
+
+	case "init_module.overlay_numlower":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).RemoveXAttr.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).InitModule.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "rename.new.basename":
+	case "init_module.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).InitModule.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "io_uring.basename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.New.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).IOUring.ResolveBasename((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rename.new.container_path":
+	case "io_uring.container_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.New.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).IOUring.ResolveContainerPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rename.new.filename":
+	case "io_uring.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.New.ResolveInode((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).IOUring.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rename.new.inode":
+	case "io_uring.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.New.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).IOUring.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "rename.new.overlay_numlower":
+	case "io_uring.operation":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.New.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).IOUring.Operation) },
 
 			Field: field,
 		}, nil
 
-	case "rename.old.basename":
+	case "io_uring.overlay_numlower":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.Old.ResolveBasename((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).IOUring.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "rename.old.container_path":
+	case "io_uring.retval":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.Old.ResolveContainerPath((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).IOUring.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "rename.old.filename":
+	case "kill.pid":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.Old.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Kill.PID) },
 
 			Field: field,
 		}, nil
 
-	case "rename.old.inode":
+	case "kill.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Old.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Kill.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "rename.old.overlay_numlower":
+	case "kill.signal":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Old.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Kill.Signal) },
 
 			Field: field,
 		}, nil
 
-	case "rename.retval":
+	case "link.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "rmdir.basename":
+	case "link.source.basename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rmdir.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Link.Source.ResolveBasename((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rmdir.container_path":
+	case "link.source.container_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rmdir.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Link.Source.ResolveContainerPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rmdir.filename":
+	case "link.source.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rmdir.ResolveInode((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Link.Source.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rmdir.inode":
-
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.Inode) },
-
-			Field: field,
-		}, nil
-
-	case "rmdir.overlay_numlower":
+	case "link.source.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Source.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "rmdir.retval":
+	case "link.source.overlay_numlower":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Source.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "setxattr.basename":
+	case "link.target.basename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).SetXAttr.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Link.Target.ResolveBasename((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "setxattr.container_path":
+	case "link.target.container_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).SetXAttr.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Link.Target.ResolveContainerPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "setxattr.filename":
+	case "link.target.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).SetXAttr.ResolveInode((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Link.Target.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "setxattr.inode":
+	case "link.target.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetXAttr.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Target.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "setxattr.name":
+	case "link.target.overlay_numlower":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).SetXAttr.GetName((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Target.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "setxattr.namespace":
+	case "memfd_create.flags":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).SetXAttr.GetNamespace((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).MemfdCreate.Flags) },
 
 			Field: field,
 		}, nil
 
-	case "setxattr.overlay_numlower":
+	case "memfd_create.name":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetXAttr.OverlayNumLower) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string { return (*Event)(ctx.Object).MemfdCreate.Name },
 
 			Field: field,
 		}, nil
 
-	case "setxattr.retval":
+	case "memfd_create.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetXAttr.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).MemfdCreate.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "unlink.basename":
+	case "mkdir.basename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Unlink.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Mkdir.ResolveBasename((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "unlink.container_path":
+	case "mkdir.container_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Unlink.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Mkdir.ResolveContainerPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "unlink.filename":
+	case "mkdir.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Unlink.ResolveInode((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Mkdir.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "unlink.flags":
+	case "mkdir.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Flags) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "unlink.inode":
+	case "mkdir.mode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Mode) },
 
 			Field: field,
 		}, nil
 
-	case "unlink.overlay_numlower":
+	case "mkdir.overlay_numlower":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "unlink.retval":
+	case "mkdir.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "utimes.basename":
+	case "mount.flags":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Utimes.ResolveBasename((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mount.Flags) },
 
 			Field: field,
 		}, nil
 
-	case "utimes.container_path":
+	case "mount.fs_type":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Utimes.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Mount.ResolveFSType((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "utimes.filename":
+	case "mount.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mount.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "mount.root":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Utimes.ResolveInode((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Mount.ResolveRoot((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "utimes.inode":
+	case "mount.source":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.Inode) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string { return (*Event)(ctx.Object).Mount.SourceStr },
 
 			Field: field,
 		}, nil
 
-	case "utimes.overlay_numlower":
+	case "mount.target":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.OverlayNumLower) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Mount.ResolveMountPoint((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "utimes.retval":
+	case "open.basename":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.Retval) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Open.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	}
+	case "open.container_path":
 
-	return nil, &eval.ErrFieldNotFound{Field: field}
-}
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Open.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-func (e *Event) GetFieldValue(field eval.Field) (interface{}, error) {
-	switch field {
+			Field: field,
+		}, nil
 
-	case "chmod.basename":
+	case "open.filename":
 
-		return e.Chmod.ResolveBasename(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Open.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-	case "chmod.container_path":
+			Field: field,
+		}, nil
 
-		return e.Chmod.ResolveContainerPath(e.resolvers), nil
+	case "open.flags":
 
-	case "chmod.filename":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Flags) },
 
-		return e.Chmod.ResolveInode(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "chmod.inode":
+	case "open.inode":
 
-		return int(e.Chmod.Inode), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Inode) },
 
-	case "chmod.mode":
+			Field: field,
+		}, nil
 
-		return int(e.Chmod.Mode), nil
+	case "open.mode":
 
-	case "chmod.overlay_numlower":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Mode) },
 
-		return int(e.Chmod.OverlayNumLower), nil
+			Field: field,
+		}, nil
 
-	case "chmod.retval":
+	case "open.overlay_numlower":
 
-		return int(e.Chmod.Retval), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.OverlayNumLower) },
 
-	case "chown.basename":
+			Field: field,
+		}, nil
 
-		return e.Chown.ResolveBasename(e.resolvers), nil
+	case "open.retval":
 
-	case "chown.container_path":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Retval) },
 
-		return e.Chown.ResolveContainerPath(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "chown.filename":
+	case "pivot_root.old_root.basename":
 
-		return e.Chown.ResolveInode(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).PivotRoot.OldRoot.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
-	case "chown.gid":
+			Field: field,
+		}, nil
 
-		return int(e.Chown.GID), nil
+	case "pivot_root.old_root.container_path":
 
-	case "chown.inode":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).PivotRoot.OldRoot.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-		return int(e.Chown.Inode), nil
+			Field: field,
+		}, nil
 
-	case "chown.overlay_numlower":
+	case "pivot_root.old_root.filename":
 
-		return int(e.Chown.OverlayNumLower), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).PivotRoot.OldRoot.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-	case "chown.retval":
+			Field: field,
+		}, nil
 
-		return int(e.Chown.Retval), nil
+	case "pivot_root.old_root.inode":
 
-	case "chown.uid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).PivotRoot.OldRoot.Inode) },
 
-		return int(e.Chown.UID), nil
+			Field: field,
+		}, nil
 
-	case "container.id":
+	case "pivot_root.old_root.overlay_numlower":
 
-		return e.Container.ResolveContainerID(e.resolvers), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).PivotRoot.OldRoot.OverlayNumLower) },
 
-	case "link.retval":
+			Field: field,
+		}, nil
 
-		return int(e.Link.Retval), nil
+	case "pivot_root.retval":
 
-	case "link.source.basename":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).PivotRoot.Retval) },
 
-		return e.Link.Source.ResolveBasename(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "link.source.container_path":
+	case "pivot_root.root.basename":
 
-		return e.Link.Source.ResolveContainerPath(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).PivotRoot.Root.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
-	case "link.source.filename":
+			Field: field,
+		}, nil
 
-		return e.Link.Source.ResolveInode(e.resolvers), nil
+	case "pivot_root.root.container_path":
 
-	case "link.source.inode":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).PivotRoot.Root.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-		return int(e.Link.Source.Inode), nil
+			Field: field,
+		}, nil
 
-	case "link.source.overlay_numlower":
+	case "pivot_root.root.filename":
 
-		return int(e.Link.Source.OverlayNumLower), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).PivotRoot.Root.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-	case "link.target.basename":
+			Field: field,
+		}, nil
 
-		return e.Link.Target.ResolveBasename(e.resolvers), nil
+	case "pivot_root.root.inode":
 
-	case "link.target.container_path":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).PivotRoot.Root.Inode) },
 
-		return e.Link.Target.ResolveContainerPath(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "link.target.filename":
+	case "pivot_root.root.overlay_numlower":
 
-		return e.Link.Target.ResolveInode(e.resolvers), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).PivotRoot.Root.OverlayNumLower) },
 
-	case "link.target.inode":
+			Field: field,
+		}, nil
 
-		return int(e.Link.Target.Inode), nil
+	case "prctl.arg":
 
-	case "link.target.overlay_numlower":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Prctl.Arg) },
 
-		return int(e.Link.Target.OverlayNumLower), nil
+			Field: field,
+		}, nil
 
-	case "mkdir.basename":
+	case "prctl.new_name":
 
-		return e.Mkdir.ResolveBasename(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string { return (*Event)(ctx.Object).Prctl.NewName },
 
-	case "mkdir.container_path":
+			Field: field,
+		}, nil
 
-		return e.Mkdir.ResolveContainerPath(e.resolvers), nil
+	case "prctl.old_name":
 
-	case "mkdir.filename":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string { return (*Event)(ctx.Object).Prctl.OldName },
 
-		return e.Mkdir.ResolveInode(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "mkdir.inode":
+	case "prctl.option":
 
-		return int(e.Mkdir.Inode), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Prctl.Option) },
 
-	case "mkdir.mode":
+			Field: field,
+		}, nil
 
-		return int(e.Mkdir.Mode), nil
+	case "prctl.retval":
 
-	case "mkdir.overlay_numlower":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Prctl.Retval) },
 
-		return int(e.Mkdir.OverlayNumLower), nil
+			Field: field,
+		}, nil
 
-	case "mkdir.retval":
+	case "process.args":
 
-		return int(e.Mkdir.Retval), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveArgs((*Event)(ctx.Object).resolvers)
+			},
 
-	case "open.basename":
+			Field: field,
+		}, nil
 
-		return e.Open.ResolveBasename(e.resolvers), nil
+	case "process.basename":
 
-	case "open.container_path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
-		return e.Open.ResolveContainerPath(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "open.filename":
+	case "process.container_path":
 
-		return e.Open.ResolveInode(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-	case "open.flags":
+			Field: field,
+		}, nil
 
-		return int(e.Open.Flags), nil
+	case "process.envs":
 
-	case "open.inode":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveEnvs((*Event)(ctx.Object).resolvers)
+			},
 
-		return int(e.Open.Inode), nil
+			Field: field,
+		}, nil
 
-	case "open.mode":
+	case "process.fileless":
 
-		return int(e.Open.Mode), nil
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				return (*Event)(ctx.Object).Process.ResolveFileless((*Event)(ctx.Object).resolvers)
+			},
 
-	case "open.overlay_numlower":
+			Field: field,
+		}, nil
 
-		return int(e.Open.OverlayNumLower), nil
+	case "process.filename":
 
-	case "open.retval":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-		return int(e.Open.Retval), nil
+			Field: field,
+		}, nil
 
-	case "process.basename":
+	case "process.gid":
 
-		return e.Process.ResolveBasename(e.resolvers), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.GID) },
 
-	case "process.container_path":
+			Field: field,
+		}, nil
 
-		return e.Process.ResolveContainerPath(e.resolvers), nil
+	case "process.group":
 
-	case "process.filename":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveGroup((*Event)(ctx.Object).resolvers)
+			},
 
-		return e.Process.ResolveInode(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "process.gid":
+	case "process.inode":
 
-		return int(e.Process.GID), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Inode) },
 
-	case "process.group":
+			Field: field,
+		}, nil
 
-		return e.Process.ResolveGroup(e.resolvers), nil
+	case "process.memfd_name":
 
-	case "process.inode":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveMemfdName((*Event)(ctx.Object).resolvers)
+			},
 
-		return int(e.Process.Inode), nil
+			Field: field,
+		}, nil
 
-	case "process.name":
+	case "process.mntns":
 
-		return e.Process.ResolveComm(e.resolvers), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Mntns) },
 
-	case "process.overlay_numlower":
+			Field: field,
+		}, nil
 
-		return int(e.Process.OverlayNumLower), nil
+	case "process.name":
 
-	case "process.pid":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveComm((*Event)(ctx.Object).resolvers)
+			},
 
-		return int(e.Process.Pid), nil
+			Field: field,
+		}, nil
 
-	case "process.pidns":
+	case "process.netns":
 
-		return int(e.Process.Pidns), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Netns) },
 
-	case "process.tid":
+			Field: field,
+		}, nil
 
-		return int(e.Process.Tid), nil
+	case "process.overlay_numlower":
 
-	case "process.tty_name":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.OverlayNumLower) },
 
-		return e.Process.ResolveTTY(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "process.uid":
+	case "process.pid":
 
-		return int(e.Process.UID), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Pid) },
 
-	case "process.user":
+			Field: field,
+		}, nil
 
-		return e.Process.ResolveUser(e.resolvers), nil
+	case "process.pidns":
 
-	case "removexattr.basename":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Pidns) },
 
-		return e.RemoveXAttr.ResolveBasename(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "removexattr.container_path":
+	case "process.script.basename":
 
-		return e.RemoveXAttr.ResolveContainerPath(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.Script.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
-	case "removexattr.filename":
+			Field: field,
+		}, nil
 
-		return e.RemoveXAttr.ResolveInode(e.resolvers), nil
+	case "process.script.container_path":
 
-	case "removexattr.inode":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.Script.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-		return int(e.RemoveXAttr.Inode), nil
+			Field: field,
+		}, nil
 
-	case "removexattr.name":
+	case "process.script.filename":
 
-		return e.RemoveXAttr.GetName(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.Script.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-	case "removexattr.namespace":
+			Field: field,
+		}, nil
 
-		return e.RemoveXAttr.GetNamespace(e.resolvers), nil
+	case "process.script.inode":
 
-	case "removexattr.overlay_numlower":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Script.Inode) },
 
-		return int(e.RemoveXAttr.OverlayNumLower), nil
+			Field: field,
+		}, nil
 
-	case "removexattr.retval":
+	case "process.script.overlay_numlower":
 
-		return int(e.RemoveXAttr.Retval), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Script.OverlayNumLower) },
 
-	case "rename.new.basename":
+			Field: field,
+		}, nil
 
-		return e.Rename.New.ResolveBasename(e.resolvers), nil
+	case "process.tid":
 
-	case "rename.new.container_path":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Tid) },
 
-		return e.Rename.New.ResolveContainerPath(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "rename.new.filename":
+	case "process.tty_name":
 
-		return e.Rename.New.ResolveInode(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveTTY((*Event)(ctx.Object).resolvers)
+			},
 
-	case "rename.new.inode":
+			Field: field,
+		}, nil
 
-		return int(e.Rename.New.Inode), nil
+	case "process.uid":
 
-	case "rename.new.overlay_numlower":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.UID) },
 
-		return int(e.Rename.New.OverlayNumLower), nil
+			Field: field,
+		}, nil
 
-	case "rename.old.basename":
+	case "process.user":
 
-		return e.Rename.Old.ResolveBasename(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveUser((*Event)(ctx.Object).resolvers)
+			},
 
-	case "rename.old.container_path":
+			Field: field,
+		}, nil
 
-		return e.Rename.Old.ResolveContainerPath(e.resolvers), nil
+	case "ptrace.pid":
 
-	case "rename.old.filename":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Ptrace.PID) },
 
-		return e.Rename.Old.ResolveInode(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "rename.old.inode":
+	case "ptrace.request":
 
-		return int(e.Rename.Old.Inode), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Ptrace.Request) },
 
-	case "rename.old.overlay_numlower":
+			Field: field,
+		}, nil
 
-		return int(e.Rename.Old.OverlayNumLower), nil
+	case "ptrace.retval":
 
-	case "rename.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Ptrace.Retval) },
 
-		return int(e.Rename.Retval), nil
+			Field: field,
+		}, nil
 
-	case "rmdir.basename":
+	case "removexattr.basename":
 
-		return e.Rmdir.ResolveBasename(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).RemoveXAttr.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
-	case "rmdir.container_path":
+			Field: field,
+		}, nil
 
-		return e.Rmdir.ResolveContainerPath(e.resolvers), nil
+	case "removexattr.container_path":
 
-	case "rmdir.filename":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).RemoveXAttr.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-		return e.Rmdir.ResolveInode(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "rmdir.inode":
+	case "removexattr.filename":
 
-		return int(e.Rmdir.Inode), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).RemoveXAttr.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-	case "rmdir.overlay_numlower":
+			Field: field,
+		}, nil
 
-		return int(e.Rmdir.OverlayNumLower), nil
+	case "removexattr.inode":
 
-	case "rmdir.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).RemoveXAttr.Inode) },
 
-		return int(e.Rmdir.Retval), nil
+			Field: field,
+		}, nil
 
-	case "setxattr.basename":
+	case "removexattr.name":
 
-		return e.SetXAttr.ResolveBasename(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).RemoveXAttr.GetName((*Event)(ctx.Object).resolvers)
+			},
 
-	case "setxattr.container_path":
+			Field: field,
+		}, nil
 
-		return e.SetXAttr.ResolveContainerPath(e.resolvers), nil
+	case "removexattr.namespace":
 
-	case "setxattr.filename":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).RemoveXAttr.GetNamespace((*Event)(ctx.Object).resolvers)
+			},
 
-		return e.SetXAttr.ResolveInode(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "setxattr.inode":
+	case "removexattr.overlay_numlower":
 
-		return int(e.SetXAttr.Inode), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).RemoveXAttr.OverlayNumLower) },
 
-	case "setxattr.name":
+			Field: field,
+		}, nil
 
-		return e.SetXAttr.GetName(e.resolvers), nil
+	case "removexattr.retval":
 
-	case "setxattr.namespace":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).RemoveXAttr.Retval) },
 
-		return e.SetXAttr.GetNamespace(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "setxattr.overlay_numlower":
+	case "rename.new.basename":
 
-		return int(e.SetXAttr.OverlayNumLower), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.New.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
-	case "setxattr.retval":
+			Field: field,
+		}, nil
 
-		return int(e.SetXAttr.Retval), nil
+	case "rename.new.container_path":
 
-	case "unlink.basename":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.New.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-		return e.Unlink.ResolveBasename(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "unlink.container_path":
+	case "rename.new.filename":
 
-		return e.Unlink.ResolveContainerPath(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.New.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-	case "unlink.filename":
+			Field: field,
+		}, nil
 
-		return e.Unlink.ResolveInode(e.resolvers), nil
+	case "rename.new.inode":
 
-	case "unlink.flags":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.New.Inode) },
 
-		return int(e.Unlink.Flags), nil
+			Field: field,
+		}, nil
 
-	case "unlink.inode":
+	case "rename.new.overlay_numlower":
 
-		return int(e.Unlink.Inode), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.New.OverlayNumLower) },
 
-	case "unlink.overlay_numlower":
+			Field: field,
+		}, nil
 
-		return int(e.Unlink.OverlayNumLower), nil
+	case "rename.old.basename":
 
-	case "unlink.retval":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.Old.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
-		return int(e.Unlink.Retval), nil
+			Field: field,
+		}, nil
 
-	case "utimes.basename":
+	case "rename.old.container_path":
 
-		return e.Utimes.ResolveBasename(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.Old.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-	case "utimes.container_path":
+			Field: field,
+		}, nil
 
-		return e.Utimes.ResolveContainerPath(e.resolvers), nil
+	case "rename.old.filename":
 
-	case "utimes.filename":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.Old.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-		return e.Utimes.ResolveInode(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "utimes.inode":
+	case "rename.old.inode":
 
-		return int(e.Utimes.Inode), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Old.Inode) },
 
-	case "utimes.overlay_numlower":
+			Field: field,
+		}, nil
 
-		return int(e.Utimes.OverlayNumLower), nil
+	case "rename.old.overlay_numlower":
 
-	case "utimes.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Old.OverlayNumLower) },
 
-		return int(e.Utimes.Retval), nil
+			Field: field,
+		}, nil
 
-	}
+	case "rename.retval":
 
-	return nil, &eval.ErrFieldNotFound{Field: field}
-}
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Retval) },
 
-func (e *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
-	switch field {
+			Field: field,
+		}, nil
 
-	case "chmod.basename":
-		return "chmod", nil
+	case "rmdir.basename":
 
-	case "chmod.container_path":
-		return "chmod", nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rmdir.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
-	case "chmod.filename":
-		return "chmod", nil
+			Field: field,
+		}, nil
 
-	case "chmod.inode":
-		return "chmod", nil
+	case "rmdir.container_path":
 
-	case "chmod.mode":
-		return "chmod", nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rmdir.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-	case "chmod.overlay_numlower":
-		return "chmod", nil
+			Field: field,
+		}, nil
 
-	case "chmod.retval":
-		return "chmod", nil
+	case "rmdir.filename":
 
-	case "chown.basename":
-		return "chown", nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rmdir.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-	case "chown.container_path":
-		return "chown", nil
+			Field: field,
+		}, nil
 
-	case "chown.filename":
-		return "chown", nil
+	case "rmdir.inode":
 
-	case "chown.gid":
-		return "chown", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.Inode) },
 
-	case "chown.inode":
-		return "chown", nil
+			Field: field,
+		}, nil
 
-	case "chown.overlay_numlower":
-		return "chown", nil
+	case "rmdir.overlay_numlower":
 
-	case "chown.retval":
-		return "chown", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.OverlayNumLower) },
 
-	case "chown.uid":
-		return "chown", nil
+			Field: field,
+		}, nil
 
-	case "container.id":
-		return "*", nil
+	case "rmdir.retval":
 
-	case "link.retval":
-		return "link", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.Retval) },
 
-	case "link.source.basename":
-		return "link", nil
+			Field: field,
+		}, nil
 
-	case "link.source.container_path":
-		return "link", nil
+	case "setgid.new.egid":
 
-	case "link.source.filename":
-		return "link", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.New.EGID) },
 
-	case "link.source.inode":
-		return "link", nil
+			Field: field,
+		}, nil
 
-	case "link.source.overlay_numlower":
-		return "link", nil
+	case "setgid.new.euid":
 
-	case "link.target.basename":
-		return "link", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.New.EUID) },
 
-	case "link.target.container_path":
-		return "link", nil
+			Field: field,
+		}, nil
 
-	case "link.target.filename":
-		return "link", nil
+	case "setgid.new.fsgid":
 
-	case "link.target.inode":
-		return "link", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.New.FSGID) },
 
-	case "link.target.overlay_numlower":
-		return "link", nil
+			Field: field,
+		}, nil
 
-	case "mkdir.basename":
-		return "mkdir", nil
+	case "setgid.new.fsuid":
 
-	case "mkdir.container_path":
-		return "mkdir", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.New.FSUID) },
 
-	case "mkdir.filename":
-		return "mkdir", nil
+			Field: field,
+		}, nil
 
-	case "mkdir.inode":
-		return "mkdir", nil
+	case "setgid.new.gid":
 
-	case "mkdir.mode":
-		return "mkdir", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.New.GID) },
 
-	case "mkdir.overlay_numlower":
-		return "mkdir", nil
+			Field: field,
+		}, nil
 
-	case "mkdir.retval":
-		return "mkdir", nil
+	case "setgid.new.uid":
 
-	case "open.basename":
-		return "open", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.New.UID) },
 
-	case "open.container_path":
-		return "open", nil
+			Field: field,
+		}, nil
 
-	case "open.filename":
-		return "open", nil
+	case "setgid.old.egid":
 
-	case "open.flags":
-		return "open", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.Old.EGID) },
 
-	case "open.inode":
-		return "open", nil
+			Field: field,
+		}, nil
 
-	case "open.mode":
-		return "open", nil
+	case "setgid.old.euid":
 
-	case "open.overlay_numlower":
-		return "open", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.Old.EUID) },
 
-	case "open.retval":
-		return "open", nil
+			Field: field,
+		}, nil
 
-	case "process.basename":
-		return "*", nil
+	case "setgid.old.fsgid":
 
-	case "process.container_path":
-		return "*", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.Old.FSGID) },
 
-	case "process.filename":
-		return "*", nil
+			Field: field,
+		}, nil
 
-	case "process.gid":
-		return "*", nil
+	case "setgid.old.fsuid":
 
-	case "process.group":
-		return "*", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.Old.FSUID) },
 
-	case "process.inode":
-		return "*", nil
+			Field: field,
+		}, nil
 
-	case "process.name":
-		return "*", nil
+	case "setgid.old.gid":
 
-	case "process.overlay_numlower":
-		return "*", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.Old.GID) },
 
-	case "process.pid":
-		return "*", nil
+			Field: field,
+		}, nil
 
-	case "process.pidns":
-		return "*", nil
+	case "setgid.old.uid":
 
-	case "process.tid":
-		return "*", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.Old.UID) },
 
-	case "process.tty_name":
-		return "*", nil
+			Field: field,
+		}, nil
 
-	case "process.uid":
-		return "*", nil
+	case "setgid.retval":
 
-	case "process.user":
-		return "*", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetGID.Retval) },
 
-	case "removexattr.basename":
-		return "removexattr", nil
+			Field: field,
+		}, nil
 
-	case "removexattr.container_path":
-		return "removexattr", nil
+	case "setns.fd":
 
-	case "removexattr.filename":
-		return "removexattr", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setns.FD) },
 
-	case "removexattr.inode":
-		return "removexattr", nil
+			Field: field,
+		}, nil
 
-	case "removexattr.name":
-		return "removexattr", nil
+	case "setns.ns_inode":
 
-	case "removexattr.namespace":
-		return "removexattr", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setns.NSInode) },
 
-	case "removexattr.overlay_numlower":
-		return "removexattr", nil
+			Field: field,
+		}, nil
 
-	case "removexattr.retval":
-		return "removexattr", nil
+	case "setns.nstype":
 
-	case "rename.new.basename":
-		return "rename", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setns.NSType) },
 
-	case "rename.new.container_path":
-		return "rename", nil
+			Field: field,
+		}, nil
 
-	case "rename.new.filename":
-		return "rename", nil
+	case "setns.retval":
 
-	case "rename.new.inode":
-		return "rename", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setns.Retval) },
 
-	case "rename.new.overlay_numlower":
-		return "rename", nil
+			Field: field,
+		}, nil
 
-	case "rename.old.basename":
-		return "rename", nil
+	case "setuid.new.egid":
 
-	case "rename.old.container_path":
-		return "rename", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.New.EGID) },
 
-	case "rename.old.filename":
-		return "rename", nil
+			Field: field,
+		}, nil
 
-	case "rename.old.inode":
-		return "rename", nil
+	case "setuid.new.euid":
 
-	case "rename.old.overlay_numlower":
-		return "rename", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.New.EUID) },
 
-	case "rename.retval":
-		return "rename", nil
+			Field: field,
+		}, nil
 
-	case "rmdir.basename":
-		return "rmdir", nil
+	case "setuid.new.fsgid":
 
-	case "rmdir.container_path":
-		return "rmdir", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.New.FSGID) },
 
-	case "rmdir.filename":
-		return "rmdir", nil
+			Field: field,
+		}, nil
 
-	case "rmdir.inode":
-		return "rmdir", nil
+	case "setuid.new.fsuid":
 
-	case "rmdir.overlay_numlower":
-		return "rmdir", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.New.FSUID) },
 
-	case "rmdir.retval":
-		return "rmdir", nil
+			Field: field,
+		}, nil
+
+	case "setuid.new.gid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.New.GID) },
+
+			Field: field,
+		}, nil
+
+	case "setuid.new.uid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.New.UID) },
+
+			Field: field,
+		}, nil
+
+	case "setuid.old.egid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.Old.EGID) },
+
+			Field: field,
+		}, nil
+
+	case "setuid.old.euid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.Old.EUID) },
+
+			Field: field,
+		}, nil
+
+	case "setuid.old.fsgid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.Old.FSGID) },
+
+			Field: field,
+		}, nil
+
+	case "setuid.old.fsuid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.Old.FSUID) },
+
+			Field: field,
+		}, nil
+
+	case "setuid.old.gid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.Old.GID) },
+
+			Field: field,
+		}, nil
+
+	case "setuid.old.uid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.Old.UID) },
+
+			Field: field,
+		}, nil
+
+	case "setuid.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetUID.Retval) },
+
+			Field: field,
+		}, nil
 
 	case "setxattr.basename":
-		return "setxattr", nil
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).SetXAttr.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
 
 	case "setxattr.container_path":
-		return "setxattr", nil
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).SetXAttr.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
 
 	case "setxattr.filename":
-		return "setxattr", nil
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).SetXAttr.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
 
 	case "setxattr.inode":
-		return "setxattr", nil
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetXAttr.Inode) },
+
+			Field: field,
+		}, nil
 
 	case "setxattr.name":
-		return "setxattr", nil
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).SetXAttr.GetName((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
 
 	case "setxattr.namespace":
-		return "setxattr", nil
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).SetXAttr.GetNamespace((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
 
 	case "setxattr.overlay_numlower":
-		return "setxattr", nil
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetXAttr.OverlayNumLower) },
+
+			Field: field,
+		}, nil
 
 	case "setxattr.retval":
-		return "setxattr", nil
 
-	case "unlink.basename":
-		return "unlink", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).SetXAttr.Retval) },
 
-	case "unlink.container_path":
-		return "unlink", nil
+			Field: field,
+		}, nil
 
-	case "unlink.filename":
-		return "unlink", nil
+	case "symlink.linkpath.basename":
 
-	case "unlink.flags":
-		return "unlink", nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Symlink.Link.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
-	case "unlink.inode":
-		return "unlink", nil
+			Field: field,
+		}, nil
 
-	case "unlink.overlay_numlower":
-		return "unlink", nil
+	case "symlink.linkpath.container_path":
 
-	case "unlink.retval":
-		return "unlink", nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Symlink.Link.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-	case "utimes.basename":
-		return "utimes", nil
+			Field: field,
+		}, nil
 
-	case "utimes.container_path":
-		return "utimes", nil
+	case "symlink.linkpath.filename":
 
-	case "utimes.filename":
-		return "utimes", nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Symlink.Link.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-	case "utimes.inode":
-		return "utimes", nil
+			Field: field,
+		}, nil
 
-	case "utimes.overlay_numlower":
-		return "utimes", nil
+	case "symlink.linkpath.inode":
 
-	case "utimes.retval":
-		return "utimes", nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Symlink.Link.Inode) },
 
-	}
+			Field: field,
+		}, nil
 
-	return "", &eval.ErrFieldNotFound{Field: field}
-}
+	case "symlink.linkpath.overlay_numlower":
 
-func (e *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
-	switch field {
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Symlink.Link.OverlayNumLower) },
 
-	case "chmod.basename":
+			Field: field,
+		}, nil
 
-		return reflect.String, nil
+	case "symlink.retval":
 
-	case "chmod.container_path":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Symlink.Retval) },
 
-		return reflect.String, nil
+			Field: field,
+		}, nil
 
-	case "chmod.filename":
+	case "symlink.target":
 
-		return reflect.String, nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string { return (*Event)(ctx.Object).Symlink.Target },
 
-	case "chmod.inode":
+			Field: field,
+		}, nil
 
-		return reflect.Int, nil
+	case "truncate.basename":
 
-	case "chmod.mode":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Truncate.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
-		return reflect.Int, nil
+			Field: field,
+		}, nil
 
-	case "chmod.overlay_numlower":
+	case "truncate.container_path":
 
-		return reflect.Int, nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Truncate.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-	case "chmod.retval":
+			Field: field,
+		}, nil
 
-		return reflect.Int, nil
+	case "truncate.filename":
 
-	case "chown.basename":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Truncate.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-		return reflect.String, nil
+			Field: field,
+		}, nil
 
-	case "chown.container_path":
+	case "truncate.inode":
 
-		return reflect.String, nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Truncate.Inode) },
 
-	case "chown.filename":
+			Field: field,
+		}, nil
 
-		return reflect.String, nil
+	case "truncate.length":
 
-	case "chown.gid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Truncate.Length) },
 
-		return reflect.Int, nil
+			Field: field,
+		}, nil
 
-	case "chown.inode":
+	case "truncate.overlay_numlower":
 
-		return reflect.Int, nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Truncate.OverlayNumLower) },
 
-	case "chown.overlay_numlower":
+			Field: field,
+		}, nil
 
-		return reflect.Int, nil
+	case "truncate.retval":
 
-	case "chown.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Truncate.Retval) },
 
-		return reflect.Int, nil
+			Field: field,
+		}, nil
 
-	case "chown.uid":
+	case "truncate.wipe":
 
-		return reflect.Int, nil
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool { return (*Event)(ctx.Object).Truncate.Wipe },
 
-	case "container.id":
+			Field: field,
+		}, nil
 
-		return reflect.String, nil
+	case "umount.retval":
 
-	case "link.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Umount.Retval) },
 
-		return reflect.Int, nil
+			Field: field,
+		}, nil
+
+	case "umount.target":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Umount.ResolveTarget((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "unlink.basename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Unlink.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "unlink.container_path":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Unlink.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "unlink.filename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Unlink.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "unlink.flags":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Flags) },
+
+			Field: field,
+		}, nil
+
+	case "unlink.inode":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Inode) },
+
+			Field: field,
+		}, nil
+
+	case "unlink.overlay_numlower":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.OverlayNumLower) },
+
+			Field: field,
+		}, nil
+
+	case "unlink.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "utimes.basename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Utimes.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "utimes.container_path":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Utimes.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "utimes.filename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Utimes.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "utimes.inode":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.Inode) },
+
+			Field: field,
+		}, nil
+
+	case "utimes.overlay_numlower":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.OverlayNumLower) },
+
+			Field: field,
+		}, nil
+
+	case "utimes.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.Retval) },
+
+			Field: field,
+		}, nil
+
+	}
+
+	return nil, &eval.ErrFieldNotFound{Field: field}
+}
+
+func (e *Event) GetFieldValue(field eval.Field) (interface{}, error) {
+	switch field {
+
+	case "bind.destination.ip":
+
+		return e.Bind.Destination.IP, nil
+
+	case "bind.destination.port":
+
+		return int(e.Bind.Destination.Port), nil
+
+	case "bind.family":
+
+		return int(e.Bind.Family), nil
+
+	case "bind.retval":
+
+		return int(e.Bind.Retval), nil
+
+	case "bind.source.ip":
+
+		return e.Bind.Source.IP, nil
+
+	case "bind.source.port":
+
+		return int(e.Bind.Source.Port), nil
+
+	case "bind.type":
+
+		return int(e.Bind.Type), nil
+
+	case "bpf.cmd":
+
+		return int(e.BPF.Cmd), nil
+
+	case "bpf.retval":
+
+		return int(e.BPF.Retval), nil
+
+	case "capset.cap_gained":
+
+		return int(e.Capset.Gained), nil
+
+	case "capset.new.cap_effective":
+
+		return int(e.Capset.New.Effective), nil
+
+	case "capset.new.cap_inheritable":
+
+		return int(e.Capset.New.Inheritable), nil
+
+	case "capset.new.cap_permitted":
+
+		return int(e.Capset.New.Permitted), nil
+
+	case "capset.old.cap_effective":
+
+		return int(e.Capset.Old.Effective), nil
+
+	case "capset.old.cap_inheritable":
+
+		return int(e.Capset.Old.Inheritable), nil
+
+	case "capset.old.cap_permitted":
+
+		return int(e.Capset.Old.Permitted), nil
+
+	case "capset.retval":
+
+		return int(e.Capset.Retval), nil
+
+	case "chmod.basename":
+
+		return e.Chmod.ResolveBasename(e.resolvers), nil
+
+	case "chmod.container_path":
+
+		return e.Chmod.ResolveContainerPath(e.resolvers), nil
+
+	case "chmod.filename":
+
+		return e.Chmod.ResolveInode(e.resolvers), nil
+
+	case "chmod.inode":
+
+		return int(e.Chmod.Inode), nil
+
+	case "chmod.mode":
+
+		return int(e.Chmod.Mode), nil
+
+	case "chmod.overlay_numlower":
+
+		return int(e.Chmod.OverlayNumLower), nil
+
+	case "chmod.retval":
+
+		return int(e.Chmod.Retval), nil
+
+	case "chown.basename":
+
+		return e.Chown.ResolveBasename(e.resolvers), nil
+
+	case "chown.container_path":
+
+		return e.Chown.ResolveContainerPath(e.resolvers), nil
+
+	case "chown.filename":
+
+		return e.Chown.ResolveInode(e.resolvers), nil
+
+	case "chown.gid":
+
+		return int(e.Chown.GID), nil
+
+	case "chown.inode":
+
+		return int(e.Chown.Inode), nil
+
+	case "chown.overlay_numlower":
+
+		return int(e.Chown.OverlayNumLower), nil
+
+	case "chown.retval":
+
+		return int(e.Chown.Retval), nil
+
+	case "chown.uid":
+
+		return int(e.Chown.UID), nil
+
+	case "chroot.old_root.basename":
+
+		return e.Chroot.OldRoot.ResolveBasename(e.resolvers), nil
+
+	case "chroot.old_root.container_path":
+
+		return e.Chroot.OldRoot.ResolveContainerPath(e.resolvers), nil
+
+	case "chroot.old_root.filename":
+
+		return e.Chroot.OldRoot.ResolveInode(e.resolvers), nil
+
+	case "chroot.old_root.inode":
+
+		return int(e.Chroot.OldRoot.Inode), nil
+
+	case "chroot.old_root.overlay_numlower":
+
+		return int(e.Chroot.OldRoot.OverlayNumLower), nil
+
+	case "chroot.retval":
+
+		return int(e.Chroot.Retval), nil
+
+	case "chroot.root.basename":
+
+		return e.Chroot.Root.ResolveBasename(e.resolvers), nil
+
+	case "chroot.root.container_path":
+
+		return e.Chroot.Root.ResolveContainerPath(e.resolvers), nil
+
+	case "chroot.root.filename":
+
+		return e.Chroot.Root.ResolveInode(e.resolvers), nil
+
+	case "chroot.root.inode":
+
+		return int(e.Chroot.Root.Inode), nil
+
+	case "chroot.root.overlay_numlower":
+
+		return int(e.Chroot.Root.OverlayNumLower), nil
+
+	case "commit_creds.new.cap_effective":
+
+		return int(e.CommitCreds.New.Effective), nil
+
+	case "commit_creds.new.cap_inheritable":
+
+		return int(e.CommitCreds.New.Inheritable), nil
+
+	case "commit_creds.new.cap_permitted":
+
+		return int(e.CommitCreds.New.Permitted), nil
+
+	case "commit_creds.new.egid":
+
+		return int(e.CommitCreds.New.EGID), nil
+
+	case "commit_creds.new.euid":
+
+		return int(e.CommitCreds.New.EUID), nil
+
+	case "commit_creds.new.fsgid":
+
+		return int(e.CommitCreds.New.FSGID), nil
+
+	case "commit_creds.new.fsuid":
+
+		return int(e.CommitCreds.New.FSUID), nil
+
+	case "commit_creds.new.gid":
+
+		return int(e.CommitCreds.New.GID), nil
+
+	case "commit_creds.new.uid":
+
+		return int(e.CommitCreds.New.UID), nil
+
+	case "commit_creds.old.cap_effective":
+
+		return int(e.CommitCreds.Old.Effective), nil
+
+	case "commit_creds.old.cap_inheritable":
+
+		return int(e.CommitCreds.Old.Inheritable), nil
+
+	case "commit_creds.old.cap_permitted":
+
+		return int(e.CommitCreds.Old.Permitted), nil
+
+	case "commit_creds.old.egid":
+
+		return int(e.CommitCreds.Old.EGID), nil
+
+	case "commit_creds.old.euid":
+
+		return int(e.CommitCreds.Old.EUID), nil
+
+	case "commit_creds.old.fsgid":
+
+		return int(e.CommitCreds.Old.FSGID), nil
+
+	case "commit_creds.old.fsuid":
+
+		return int(e.CommitCreds.Old.FSUID), nil
+
+	case "commit_creds.old.gid":
+
+		return int(e.CommitCreds.Old.GID), nil
+
+	case "commit_creds.old.uid":
+
+		return int(e.CommitCreds.Old.UID), nil
+
+	case "commit_creds.retval":
+
+		return int(e.CommitCreds.Retval), nil
+
+	case "connect.destination.ip":
+
+		return e.Connect.Destination.IP, nil
+
+	case "connect.destination.port":
+
+		return int(e.Connect.Destination.Port), nil
+
+	case "connect.family":
+
+		return int(e.Connect.Family), nil
+
+	case "connect.retval":
+
+		return int(e.Connect.Retval), nil
+
+	case "connect.source.ip":
+
+		return e.Connect.Source.IP, nil
+
+	case "connect.source.port":
+
+		return int(e.Connect.Source.Port), nil
+
+	case "connect.type":
+
+		return int(e.Connect.Type), nil
+
+	case "container.cgroup_path":
+
+		return e.Container.ResolveCgroupPath(e.resolvers), nil
+
+	case "container.id":
+
+		return e.Container.ResolveContainerID(e.resolvers), nil
+
+	case "exit.cause":
+
+		return int(e.Exit.Cause), nil
+
+	case "exit.code":
+
+		return int(e.Exit.Code), nil
+
+	case "exit.retval":
+
+		return int(e.Exit.Retval), nil
+
+	case "fork.child_pid":
+
+		return int(e.Fork.ChildPID), nil
+
+	case "fork.retval":
+
+		return int(e.Fork.Retval), nil
+
+	case "init_module.basename":
+
+		return e.InitModule.ResolveBasename(e.resolvers), nil
+
+	case "init_module.container_path":
+
+		return e.InitModule.ResolveContainerPath(e.resolvers), nil
+
+	case "init_module.filename":
+
+		return e.InitModule.ResolveInode(e.resolvers), nil
+
+	case "init_module.inode":
+
+		return int(e.InitModule.Inode), nil
+
+	case "init_module.name":
+
+		return e.InitModule.Name, nil
+
+	case "init_module.overlay_numlower":
+
+		return int(e.InitModule.OverlayNumLower), nil
+
+	case "init_module.retval":
+
+		return int(e.InitModule.Retval), nil
+
+	case "io_uring.basename":
+
+		return e.IOUring.ResolveBasename(e.resolvers), nil
+
+	case "io_uring.container_path":
+
+		return e.IOUring.ResolveContainerPath(e.resolvers), nil
+
+	case "io_uring.filename":
+
+		return e.IOUring.ResolveInode(e.resolvers), nil
+
+	case "io_uring.inode":
+
+		return int(e.IOUring.Inode), nil
+
+	case "io_uring.operation":
+
+		return int(e.IOUring.Operation), nil
+
+	case "io_uring.overlay_numlower":
+
+		return int(e.IOUring.OverlayNumLower), nil
+
+	case "io_uring.retval":
+
+		return int(e.IOUring.Retval), nil
+
+	case "kill.pid":
+
+		return int(e.Kill.PID), nil
+
+	case "kill.retval":
+
+		return int(e.Kill.Retval), nil
+
+	case "kill.signal":
+
+		return int(e.Kill.Signal), nil
+
+	case "link.retval":
+
+		return int(e.Link.Retval), nil
+
+	case "link.source.basename":
+
+		return e.Link.Source.ResolveBasename(e.resolvers), nil
+
+	case "link.source.container_path":
+
+		return e.Link.Source.ResolveContainerPath(e.resolvers), nil
+
+	case "link.source.filename":
+
+		return e.Link.Source.ResolveInode(e.resolvers), nil
+
+	case "link.source.inode":
+
+		return int(e.Link.Source.Inode), nil
+
+	case "link.source.overlay_numlower":
+
+		return int(e.Link.Source.OverlayNumLower), nil
+
+	case "link.target.basename":
+
+		return e.Link.Target.ResolveBasename(e.resolvers), nil
+
+	case "link.target.container_path":
+
+		return e.Link.Target.ResolveContainerPath(e.resolvers), nil
+
+	case "link.target.filename":
+
+		return e.Link.Target.ResolveInode(e.resolvers), nil
+
+	case "link.target.inode":
+
+		return int(e.Link.Target.Inode), nil
+
+	case "link.target.overlay_numlower":
+
+		return int(e.Link.Target.OverlayNumLower), nil
+
+	case "memfd_create.flags":
+
+		return int(e.MemfdCreate.Flags), nil
+
+	case "memfd_create.name":
+
+		return e.MemfdCreate.Name, nil
+
+	case "memfd_create.retval":
+
+		return int(e.MemfdCreate.Retval), nil
+
+	case "mkdir.basename":
+
+		return e.Mkdir.ResolveBasename(e.resolvers), nil
+
+	case "mkdir.container_path":
+
+		return e.Mkdir.ResolveContainerPath(e.resolvers), nil
+
+	case "mkdir.filename":
+
+		return e.Mkdir.ResolveInode(e.resolvers), nil
+
+	case "mkdir.inode":
+
+		return int(e.Mkdir.Inode), nil
+
+	case "mkdir.mode":
+
+		return int(e.Mkdir.Mode), nil
+
+	case "mkdir.overlay_numlower":
+
+		return int(e.Mkdir.OverlayNumLower), nil
+
+	case "mkdir.retval":
+
+		return int(e.Mkdir.Retval), nil
+
+	case "mount.flags":
+
+		return int(e.Mount.Flags), nil
+
+	case "mount.fs_type":
+
+		return e.Mount.ResolveFSType(e.resolvers), nil
+
+	case "mount.retval":
+
+		return int(e.Mount.Retval), nil
+
+	case "mount.root":
+
+		return e.Mount.ResolveRoot(e.resolvers), nil
+
+	case "mount.source":
+
+		return e.Mount.SourceStr, nil
+
+	case "mount.target":
+
+		return e.Mount.ResolveMountPoint(e.resolvers), nil
+
+	case "open.basename":
+
+		return e.Open.ResolveBasename(e.resolvers), nil
+
+	case "open.container_path":
+
+		return e.Open.ResolveContainerPath(e.resolvers), nil
+
+	case "open.filename":
+
+		return e.Open.ResolveInode(e.resolvers), nil
+
+	case "open.flags":
+
+		return int(e.Open.Flags), nil
+
+	case "open.inode":
+
+		return int(e.Open.Inode), nil
+
+	case "open.mode":
+
+		return int(e.Open.Mode), nil
+
+	case "open.overlay_numlower":
+
+		return int(e.Open.OverlayNumLower), nil
+
+	case "open.retval":
+
+		return int(e.Open.Retval), nil
+
+	case "pivot_root.old_root.basename":
+
+		return e.PivotRoot.OldRoot.ResolveBasename(e.resolvers), nil
+
+	case "pivot_root.old_root.container_path":
+
+		return e.PivotRoot.OldRoot.ResolveContainerPath(e.resolvers), nil
+
+	case "pivot_root.old_root.filename":
+
+		return e.PivotRoot.OldRoot.ResolveInode(e.resolvers), nil
+
+	case "pivot_root.old_root.inode":
+
+		return int(e.PivotRoot.OldRoot.Inode), nil
+
+	case "pivot_root.old_root.overlay_numlower":
+
+		return int(e.PivotRoot.OldRoot.OverlayNumLower), nil
+
+	case "pivot_root.retval":
+
+		return int(e.PivotRoot.Retval), nil
+
+	case "pivot_root.root.basename":
+
+		return e.PivotRoot.Root.ResolveBasename(e.resolvers), nil
+
+	case "pivot_root.root.container_path":
+
+		return e.PivotRoot.Root.ResolveContainerPath(e.resolvers), nil
+
+	case "pivot_root.root.filename":
+
+		return e.PivotRoot.Root.ResolveInode(e.resolvers), nil
+
+	case "pivot_root.root.inode":
+
+		return int(e.PivotRoot.Root.Inode), nil
+
+	case "pivot_root.root.overlay_numlower":
+
+		return int(e.PivotRoot.Root.OverlayNumLower), nil
+
+	case "prctl.arg":
+
+		return int(e.Prctl.Arg), nil
+
+	case "prctl.new_name":
+
+		return e.Prctl.NewName, nil
+
+	case "prctl.old_name":
+
+		return e.Prctl.OldName, nil
+
+	case "prctl.option":
+
+		return int(e.Prctl.Option), nil
+
+	case "prctl.retval":
+
+		return int(e.Prctl.Retval), nil
+
+	case "process.args":
+
+		return e.Process.ResolveArgs(e.resolvers), nil
+
+	case "process.basename":
+
+		return e.Process.ResolveBasename(e.resolvers), nil
+
+	case "process.container_path":
+
+		return e.Process.ResolveContainerPath(e.resolvers), nil
+
+	case "process.envs":
+
+		return e.Process.ResolveEnvs(e.resolvers), nil
+
+	case "process.fileless":
+
+		return e.Process.ResolveFileless(e.resolvers), nil
+
+	case "process.filename":
+
+		return e.Process.ResolveInode(e.resolvers), nil
+
+	case "process.gid":
+
+		return int(e.Process.GID), nil
+
+	case "process.group":
+
+		return e.Process.ResolveGroup(e.resolvers), nil
+
+	case "process.inode":
+
+		return int(e.Process.Inode), nil
+
+	case "process.memfd_name":
+
+		return e.Process.ResolveMemfdName(e.resolvers), nil
+
+	case "process.mntns":
+
+		return int(e.Process.Mntns), nil
+
+	case "process.name":
+
+		return e.Process.ResolveComm(e.resolvers), nil
+
+	case "process.netns":
+
+		return int(e.Process.Netns), nil
+
+	case "process.overlay_numlower":
+
+		return int(e.Process.OverlayNumLower), nil
+
+	case "process.pid":
+
+		return int(e.Process.Pid), nil
+
+	case "process.pidns":
+
+		return int(e.Process.Pidns), nil
+
+	case "process.script.basename":
+
+		return e.Process.Script.ResolveBasename(e.resolvers), nil
+
+	case "process.script.container_path":
+
+		return e.Process.Script.ResolveContainerPath(e.resolvers), nil
+
+	case "process.script.filename":
+
+		return e.Process.Script.ResolveInode(e.resolvers), nil
+
+	case "process.script.inode":
+
+		return int(e.Process.Script.Inode), nil
+
+	case "process.script.overlay_numlower":
+
+		return int(e.Process.Script.OverlayNumLower), nil
+
+	case "process.tid":
+
+		return int(e.Process.Tid), nil
+
+	case "process.tty_name":
+
+		return e.Process.ResolveTTY(e.resolvers), nil
+
+	case "process.uid":
+
+		return int(e.Process.UID), nil
+
+	case "process.user":
+
+		return e.Process.ResolveUser(e.resolvers), nil
+
+	case "ptrace.pid":
+
+		return int(e.Ptrace.PID), nil
+
+	case "ptrace.request":
+
+		return int(e.Ptrace.Request), nil
+
+	case "ptrace.retval":
+
+		return int(e.Ptrace.Retval), nil
+
+	case "removexattr.basename":
+
+		return e.RemoveXAttr.ResolveBasename(e.resolvers), nil
+
+	case "removexattr.container_path":
+
+		return e.RemoveXAttr.ResolveContainerPath(e.resolvers), nil
+
+	case "removexattr.filename":
+
+		return e.RemoveXAttr.ResolveInode(e.resolvers), nil
+
+	case "removexattr.inode":
+
+		return int(e.RemoveXAttr.Inode), nil
+
+	case "removexattr.name":
+
+		return e.RemoveXAttr.GetName(e.resolvers), nil
+
+	case "removexattr.namespace":
+
+		return e.RemoveXAttr.GetNamespace(e.resolvers), nil
+
+	case "removexattr.overlay_numlower":
+
+		return int(e.RemoveXAttr.OverlayNumLower), nil
+
+	case "removexattr.retval":
+
+		return int(e.RemoveXAttr.Retval), nil
+
+	case "rename.new.basename":
+
+		return e.Rename.New.ResolveBasename(e.resolvers), nil
+
+	case "rename.new.container_path":
+
+		return e.Rename.New.ResolveContainerPath(e.resolvers), nil
+
+	case "rename.new.filename":
+
+		return e.Rename.New.ResolveInode(e.resolvers), nil
+
+	case "rename.new.inode":
+
+		return int(e.Rename.New.Inode), nil
+
+	case "rename.new.overlay_numlower":
+
+		return int(e.Rename.New.OverlayNumLower), nil
+
+	case "rename.old.basename":
+
+		return e.Rename.Old.ResolveBasename(e.resolvers), nil
+
+	case "rename.old.container_path":
+
+		return e.Rename.Old.ResolveContainerPath(e.resolvers), nil
+
+	case "rename.old.filename":
+
+		return e.Rename.Old.ResolveInode(e.resolvers), nil
+
+	case "rename.old.inode":
+
+		return int(e.Rename.Old.Inode), nil
+
+	case "rename.old.overlay_numlower":
+
+		return int(e.Rename.Old.OverlayNumLower), nil
+
+	case "rename.retval":
+
+		return int(e.Rename.Retval), nil
+
+	case "rmdir.basename":
+
+		return e.Rmdir.ResolveBasename(e.resolvers), nil
+
+	case "rmdir.container_path":
+
+		return e.Rmdir.ResolveContainerPath(e.resolvers), nil
+
+	case "rmdir.filename":
+
+		return e.Rmdir.ResolveInode(e.resolvers), nil
+
+	case "rmdir.inode":
+
+		return int(e.Rmdir.Inode), nil
+
+	case "rmdir.overlay_numlower":
+
+		return int(e.Rmdir.OverlayNumLower), nil
+
+	case "rmdir.retval":
+
+		return int(e.Rmdir.Retval), nil
+
+	case "setgid.new.egid":
+
+		return int(e.SetGID.New.EGID), nil
+
+	case "setgid.new.euid":
+
+		return int(e.SetGID.New.EUID), nil
+
+	case "setgid.new.fsgid":
+
+		return int(e.SetGID.New.FSGID), nil
+
+	case "setgid.new.fsuid":
+
+		return int(e.SetGID.New.FSUID), nil
+
+	case "setgid.new.gid":
+
+		return int(e.SetGID.New.GID), nil
+
+	case "setgid.new.uid":
+
+		return int(e.SetGID.New.UID), nil
+
+	case "setgid.old.egid":
+
+		return int(e.SetGID.Old.EGID), nil
+
+	case "setgid.old.euid":
+
+		return int(e.SetGID.Old.EUID), nil
+
+	case "setgid.old.fsgid":
+
+		return int(e.SetGID.Old.FSGID), nil
+
+	case "setgid.old.fsuid":
+
+		return int(e.SetGID.Old.FSUID), nil
+
+	case "setgid.old.gid":
+
+		return int(e.SetGID.Old.GID), nil
+
+	case "setgid.old.uid":
+
+		return int(e.SetGID.Old.UID), nil
+
+	case "setgid.retval":
+
+		return int(e.SetGID.Retval), nil
+
+	case "setns.fd":
+
+		return int(e.Setns.FD), nil
+
+	case "setns.ns_inode":
+
+		return int(e.Setns.NSInode), nil
+
+	case "setns.nstype":
+
+		return int(e.Setns.NSType), nil
+
+	case "setns.retval":
+
+		return int(e.Setns.Retval), nil
+
+	case "setuid.new.egid":
+
+		return int(e.SetUID.New.EGID), nil
+
+	case "setuid.new.euid":
+
+		return int(e.SetUID.New.EUID), nil
+
+	case "setuid.new.fsgid":
+
+		return int(e.SetUID.New.FSGID), nil
+
+	case "setuid.new.fsuid":
+
+		return int(e.SetUID.New.FSUID), nil
+
+	case "setuid.new.gid":
+
+		return int(e.SetUID.New.GID), nil
+
+	case "setuid.new.uid":
+
+		return int(e.SetUID.New.UID), nil
+
+	case "setuid.old.egid":
+
+		return int(e.SetUID.Old.EGID), nil
+
+	case "setuid.old.euid":
+
+		return int(e.SetUID.Old.EUID), nil
+
+	case "setuid.old.fsgid":
+
+		return int(e.SetUID.Old.FSGID), nil
+
+	case "setuid.old.fsuid":
+
+		return int(e.SetUID.Old.FSUID), nil
+
+	case "setuid.old.gid":
+
+		return int(e.SetUID.Old.GID), nil
+
+	case "setuid.old.uid":
+
+		return int(e.SetUID.Old.UID), nil
+
+	case "setuid.retval":
+
+		return int(e.SetUID.Retval), nil
+
+	case "setxattr.basename":
+
+		return e.SetXAttr.ResolveBasename(e.resolvers), nil
+
+	case "setxattr.container_path":
+
+		return e.SetXAttr.ResolveContainerPath(e.resolvers), nil
+
+	case "setxattr.filename":
+
+		return e.SetXAttr.ResolveInode(e.resolvers), nil
+
+	case "setxattr.inode":
+
+		return int(e.SetXAttr.Inode), nil
+
+	case "setxattr.name":
+
+		return e.SetXAttr.GetName(e.resolvers), nil
+
+	case "setxattr.namespace":
+
+		return e.SetXAttr.GetNamespace(e.resolvers), nil
+
+	case "setxattr.overlay_numlower":
+
+		return int(e.SetXAttr.OverlayNumLower), nil
+
+	case "setxattr.retval":
+
+		return int(e.SetXAttr.Retval), nil
+
+	case "symlink.linkpath.basename":
+
+		return e.Symlink.Link.ResolveBasename(e.resolvers), nil
+
+	case "symlink.linkpath.container_path":
+
+		return e.Symlink.Link.ResolveContainerPath(e.resolvers), nil
+
+	case "symlink.linkpath.filename":
+
+		return e.Symlink.Link.ResolveInode(e.resolvers), nil
+
+	case "symlink.linkpath.inode":
+
+		return int(e.Symlink.Link.Inode), nil
+
+	case "symlink.linkpath.overlay_numlower":
+
+		return int(e.Symlink.Link.OverlayNumLower), nil
+
+	case "symlink.retval":
+
+		return int(e.Symlink.Retval), nil
+
+	case "symlink.target":
+
+		return e.Symlink.Target, nil
+
+	case "truncate.basename":
+
+		return e.Truncate.ResolveBasename(e.resolvers), nil
+
+	case "truncate.container_path":
+
+		return e.Truncate.ResolveContainerPath(e.resolvers), nil
+
+	case "truncate.filename":
+
+		return e.Truncate.ResolveInode(e.resolvers), nil
+
+	case "truncate.inode":
+
+		return int(e.Truncate.Inode), nil
+
+	case "truncate.length":
+
+		return int(e.Truncate.Length), nil
+
+	case "truncate.overlay_numlower":
+
+		return int(e.Truncate.OverlayNumLower), nil
+
+	case "truncate.retval":
+
+		return int(e.Truncate.Retval), nil
+
+	case "truncate.wipe":
+
+		return e.Truncate.Wipe, nil
+
+	case "umount.retval":
+
+		return int(e.Umount.Retval), nil
+
+	case "umount.target":
+
+		return e.Umount.ResolveTarget(e.resolvers), nil
+
+	case "unlink.basename":
+
+		return e.Unlink.ResolveBasename(e.resolvers), nil
+
+	case "unlink.container_path":
+
+		return e.Unlink.ResolveContainerPath(e.resolvers), nil
+
+	case "unlink.filename":
+
+		return e.Unlink.ResolveInode(e.resolvers), nil
+
+	case "unlink.flags":
+
+		return int(e.Unlink.Flags), nil
+
+	case "unlink.inode":
+
+		return int(e.Unlink.Inode), nil
+
+	case "unlink.overlay_numlower":
+
+		return int(e.Unlink.OverlayNumLower), nil
+
+	case "unlink.retval":
+
+		return int(e.Unlink.Retval), nil
+
+	case "utimes.basename":
+
+		return e.Utimes.ResolveBasename(e.resolvers), nil
+
+	case "utimes.container_path":
+
+		return e.Utimes.ResolveContainerPath(e.resolvers), nil
+
+	case "utimes.filename":
+
+		return e.Utimes.ResolveInode(e.resolvers), nil
+
+	case "utimes.inode":
+
+		return int(e.Utimes.Inode), nil
+
+	case "utimes.overlay_numlower":
+
+		return int(e.Utimes.OverlayNumLower), nil
+
+	case "utimes.retval":
+
+		return int(e.Utimes.Retval), nil
+
+	}
+
+	return nil, &eval.ErrFieldNotFound{Field: field}
+}
+
+func (e *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
+	switch field {
+
+	case "bind.destination.ip":
+		return "bind", nil
+
+	case "bind.destination.port":
+		return "bind", nil
+
+	case "bind.family":
+		return "bind", nil
+
+	case "bind.retval":
+		return "bind", nil
+
+	case "bind.source.ip":
+		return "bind", nil
+
+	case "bind.source.port":
+		return "bind", nil
+
+	case "bind.type":
+		return "bind", nil
+
+	case "bpf.cmd":
+		return "bpf", nil
+
+	case "bpf.retval":
+		return "bpf", nil
+
+	case "capset.cap_gained":
+		return "capset", nil
+
+	case "capset.new.cap_effective":
+		return "capset", nil
+
+	case "capset.new.cap_inheritable":
+		return "capset", nil
+
+	case "capset.new.cap_permitted":
+		return "capset", nil
+
+	case "capset.old.cap_effective":
+		return "capset", nil
+
+	case "capset.old.cap_inheritable":
+		return "capset", nil
+
+	case "capset.old.cap_permitted":
+		return "capset", nil
+
+	case "capset.retval":
+		return "capset", nil
+
+	case "chmod.basename":
+		return "chmod", nil
+
+	case "chmod.container_path":
+		return "chmod", nil
+
+	case "chmod.filename":
+		return "chmod", nil
+
+	case "chmod.inode":
+		return "chmod", nil
+
+	case "chmod.mode":
+		return "chmod", nil
+
+	case "chmod.overlay_numlower":
+		return "chmod", nil
+
+	case "chmod.retval":
+		return "chmod", nil
+
+	case "chown.basename":
+		return "chown", nil
+
+	case "chown.container_path":
+		return "chown", nil
+
+	case "chown.filename":
+		return "chown", nil
+
+	case "chown.gid":
+		return "chown", nil
+
+	case "chown.inode":
+		return "chown", nil
+
+	case "chown.overlay_numlower":
+		return "chown", nil
+
+	case "chown.retval":
+		return "chown", nil
+
+	case "chown.uid":
+		return "chown", nil
+
+	case "chroot.old_root.basename":
+		return "chroot", nil
+
+	case "chroot.old_root.container_path":
+		return "chroot", nil
+
+	case "chroot.old_root.filename":
+		return "chroot", nil
+
+	case "chroot.old_root.inode":
+		return "chroot", nil
+
+	case "chroot.old_root.overlay_numlower":
+		return "chroot", nil
+
+	case "chroot.retval":
+		return "chroot", nil
+
+	case "chroot.root.basename":
+		return "chroot", nil
+
+	case "chroot.root.container_path":
+		return "chroot", nil
+
+	case "chroot.root.filename":
+		return "chroot", nil
+
+	case "chroot.root.inode":
+		return "chroot", nil
+
+	case "chroot.root.overlay_numlower":
+		return "chroot", nil
+
+	case "commit_creds.new.cap_effective":
+		return "commit_creds", nil
+
+	case "commit_creds.new.cap_inheritable":
+		return "commit_creds", nil
+
+	case "commit_creds.new.cap_permitted":
+		return "commit_creds", nil
+
+	case "commit_creds.new.egid":
+		return "commit_creds", nil
+
+	case "commit_creds.new.euid":
+		return "commit_creds", nil
+
+	case "commit_creds.new.fsgid":
+		return "commit_creds", nil
+
+	case "commit_creds.new.fsuid":
+		return "commit_creds", nil
+
+	case "commit_creds.new.gid":
+		return "commit_creds", nil
+
+	case "commit_creds.new.uid":
+		return "commit_creds", nil
+
+	case "commit_creds.old.cap_effective":
+		return "commit_creds", nil
+
+	case "commit_creds.old.cap_inheritable":
+		return "commit_creds", nil
+
+	case "commit_creds.old.cap_permitted":
+		return "commit_creds", nil
+
+	case "commit_creds.old.egid":
+		return "commit_creds", nil
+
+	case "commit_creds.old.euid":
+		return "commit_creds", nil
+
+	case "commit_creds.old.fsgid":
+		return "commit_creds", nil
+
+	case "commit_creds.old.fsuid":
+		return "commit_creds", nil
+
+	case "commit_creds.old.gid":
+		return "commit_creds", nil
+
+	case "commit_creds.old.uid":
+		return "commit_creds", nil
+
+	case "commit_creds.retval":
+		return "commit_creds", nil
+
+	case "connect.destination.ip":
+		return "connect", nil
+
+	case "connect.destination.port":
+		return "connect", nil
+
+	case "connect.family":
+		return "connect", nil
+
+	case "connect.retval":
+		return "connect", nil
+
+	case "connect.source.ip":
+		return "connect", nil
+
+	case "connect.source.port":
+		return "connect", nil
+
+	case "connect.type":
+		return "connect", nil
+
+	case "container.cgroup_path":
+		return "*", nil
+
+	case "container.id":
+		return "*", nil
+
+	case "exit.cause":
+		return "exit", nil
+
+	case "exit.code":
+		return "exit", nil
+
+	case "exit.retval":
+		return "exit", nil
+
+	case "fork.child_pid":
+		return "fork", nil
+
+	case "fork.retval":
+		return "fork", nil
+
+	case "init_module.basename":
+		return "init_module", nil
+
+	case "init_module.container_path":
+		return "init_module", nil
+
+	case "init_module.filename":
+		return "init_module", nil
+
+	case "init_module.inode":
+		return "init_module", nil
+
+	case "init_module.name":
+		return "init_module", nil
+
+	case "init_module.overlay_numlower":
+		return "init_module", nil
+
+	case "init_module.retval":
+		return "init_module", nil
+
+	case "io_uring.basename":
+		return "io_uring", nil
+
+	case "io_uring.container_path":
+		return "io_uring", nil
+
+	case "io_uring.filename":
+		return "io_uring", nil
+
+	case "io_uring.inode":
+		return "io_uring", nil
+
+	case "io_uring.operation":
+		return "io_uring", nil
+
+	case "io_uring.overlay_numlower":
+		return "io_uring", nil
+
+	case "io_uring.retval":
+		return "io_uring", nil
+
+	case "kill.pid":
+		return "kill", nil
+
+	case "kill.retval":
+		return "kill", nil
+
+	case "kill.signal":
+		return "kill", nil
+
+	case "link.retval":
+		return "link", nil
+
+	case "link.source.basename":
+		return "link", nil
+
+	case "link.source.container_path":
+		return "link", nil
+
+	case "link.source.filename":
+		return "link", nil
+
+	case "link.source.inode":
+		return "link", nil
+
+	case "link.source.overlay_numlower":
+		return "link", nil
+
+	case "link.target.basename":
+		return "link", nil
+
+	case "link.target.container_path":
+		return "link", nil
+
+	case "link.target.filename":
+		return "link", nil
+
+	case "link.target.inode":
+		return "link", nil
+
+	case "link.target.overlay_numlower":
+		return "link", nil
+
+	case "memfd_create.flags":
+		return "memfd_create", nil
+
+	case "memfd_create.name":
+		return "memfd_create", nil
+
+	case "memfd_create.retval":
+		return "memfd_create", nil
+
+	case "mkdir.basename":
+		return "mkdir", nil
+
+	case "mkdir.container_path":
+		return "mkdir", nil
+
+	case "mkdir.filename":
+		return "mkdir", nil
+
+	case "mkdir.inode":
+		return "mkdir", nil
+
+	case "mkdir.mode":
+		return "mkdir", nil
+
+	case "mkdir.overlay_numlower":
+		return "mkdir", nil
+
+	case "mkdir.retval":
+		return "mkdir", nil
+
+	case "mount.flags":
+		return "mount", nil
+
+	case "mount.fs_type":
+		return "mount", nil
+
+	case "mount.retval":
+		return "mount", nil
+
+	case "mount.root":
+		return "mount", nil
+
+	case "mount.source":
+		return "mount", nil
+
+	case "mount.target":
+		return "mount", nil
+
+	case "open.basename":
+		return "open", nil
+
+	case "open.container_path":
+		return "open", nil
+
+	case "open.filename":
+		return "open", nil
+
+	case "open.flags":
+		return "open", nil
+
+	case "open.inode":
+		return "open", nil
+
+	case "open.mode":
+		return "open", nil
+
+	case "open.overlay_numlower":
+		return "open", nil
+
+	case "open.retval":
+		return "open", nil
+
+	case "pivot_root.old_root.basename":
+		return "pivot_root", nil
+
+	case "pivot_root.old_root.container_path":
+		return "pivot_root", nil
+
+	case "pivot_root.old_root.filename":
+		return "pivot_root", nil
+
+	case "pivot_root.old_root.inode":
+		return "pivot_root", nil
+
+	case "pivot_root.old_root.overlay_numlower":
+		return "pivot_root", nil
+
+	case "pivot_root.retval":
+		return "pivot_root", nil
+
+	case "pivot_root.root.basename":
+		return "pivot_root", nil
+
+	case "pivot_root.root.container_path":
+		return "pivot_root", nil
+
+	case "pivot_root.root.filename":
+		return "pivot_root", nil
+
+	case "pivot_root.root.inode":
+		return "pivot_root", nil
+
+	case "pivot_root.root.overlay_numlower":
+		return "pivot_root", nil
+
+	case "prctl.arg":
+		return "prctl", nil
+
+	case "prctl.new_name":
+		return "prctl", nil
+
+	case "prctl.old_name":
+		return "prctl", nil
+
+	case "prctl.option":
+		return "prctl", nil
+
+	case "prctl.retval":
+		return "prctl", nil
+
+	case "process.args":
+		return "*", nil
+
+	case "process.basename":
+		return "*", nil
+
+	case "process.container_path":
+		return "*", nil
+
+	case "process.envs":
+		return "*", nil
+
+	case "process.fileless":
+		return "*", nil
+
+	case "process.filename":
+		return "*", nil
+
+	case "process.gid":
+		return "*", nil
+
+	case "process.group":
+		return "*", nil
+
+	case "process.inode":
+		return "*", nil
+
+	case "process.memfd_name":
+		return "*", nil
+
+	case "process.mntns":
+		return "*", nil
+
+	case "process.name":
+		return "*", nil
+
+	case "process.netns":
+		return "*", nil
+
+	case "process.overlay_numlower":
+		return "*", nil
+
+	case "process.pid":
+		return "*", nil
+
+	case "process.pidns":
+		return "*", nil
+
+	case "process.script.basename":
+		return "*", nil
+
+	case "process.script.container_path":
+		return "*", nil
+
+	case "process.script.filename":
+		return "*", nil
+
+	case "process.script.inode":
+		return "*", nil
+
+	case "process.script.overlay_numlower":
+		return "*", nil
+
+	case "process.tid":
+		return "*", nil
+
+	case "process.tty_name":
+		return "*", nil
+
+	case "process.uid":
+		return "*", nil
+
+	case "process.user":
+		return "*", nil
+
+	case "ptrace.pid":
+		return "ptrace", nil
+
+	case "ptrace.request":
+		return "ptrace", nil
+
+	case "ptrace.retval":
+		return "ptrace", nil
+
+	case "removexattr.basename":
+		return "removexattr", nil
+
+	case "removexattr.container_path":
+		return "removexattr", nil
+
+	case "removexattr.filename":
+		return "removexattr", nil
+
+	case "removexattr.inode":
+		return "removexattr", nil
+
+	case "removexattr.name":
+		return "removexattr", nil
+
+	case "removexattr.namespace":
+		return "removexattr", nil
+
+	case "removexattr.overlay_numlower":
+		return "removexattr", nil
+
+	case "removexattr.retval":
+		return "removexattr", nil
+
+	case "rename.new.basename":
+		return "rename", nil
+
+	case "rename.new.container_path":
+		return "rename", nil
+
+	case "rename.new.filename":
+		return "rename", nil
+
+	case "rename.new.inode":
+		return "rename", nil
+
+	case "rename.new.overlay_numlower":
+		return "rename", nil
+
+	case "rename.old.basename":
+		return "rename", nil
+
+	case "rename.old.container_path":
+		return "rename", nil
+
+	case "rename.old.filename":
+		return "rename", nil
+
+	case "rename.old.inode":
+		return "rename", nil
+
+	case "rename.old.overlay_numlower":
+		return "rename", nil
+
+	case "rename.retval":
+		return "rename", nil
+
+	case "rmdir.basename":
+		return "rmdir", nil
+
+	case "rmdir.container_path":
+		return "rmdir", nil
+
+	case "rmdir.filename":
+		return "rmdir", nil
+
+	case "rmdir.inode":
+		return "rmdir", nil
+
+	case "rmdir.overlay_numlower":
+		return "rmdir", nil
+
+	case "rmdir.retval":
+		return "rmdir", nil
+
+	case "setgid.new.egid":
+		return "setgid", nil
+
+	case "setgid.new.euid":
+		return "setgid", nil
+
+	case "setgid.new.fsgid":
+		return "setgid", nil
+
+	case "setgid.new.fsuid":
+		return "setgid", nil
+
+	case "setgid.new.gid":
+		return "setgid", nil
+
+	case "setgid.new.uid":
+		return "setgid", nil
+
+	case "setgid.old.egid":
+		return "setgid", nil
+
+	case "setgid.old.euid":
+		return "setgid", nil
+
+	case "setgid.old.fsgid":
+		return "setgid", nil
+
+	case "setgid.old.fsuid":
+		return "setgid", nil
+
+	case "setgid.old.gid":
+		return "setgid", nil
+
+	case "setgid.old.uid":
+		return "setgid", nil
+
+	case "setgid.retval":
+		return "setgid", nil
+
+	case "setns.fd":
+		return "setns", nil
+
+	case "setns.ns_inode":
+		return "setns", nil
+
+	case "setns.nstype":
+		return "setns", nil
+
+	case "setns.retval":
+		return "setns", nil
+
+	case "setuid.new.egid":
+		return "setuid", nil
+
+	case "setuid.new.euid":
+		return "setuid", nil
+
+	case "setuid.new.fsgid":
+		return "setuid", nil
+
+	case "setuid.new.fsuid":
+		return "setuid", nil
+
+	case "setuid.new.gid":
+		return "setuid", nil
+
+	case "setuid.new.uid":
+		return "setuid", nil
+
+	case "setuid.old.egid":
+		return "setuid", nil
+
+	case "setuid.old.euid":
+		return "setuid", nil
+
+	case "setuid.old.fsgid":
+		return "setuid", nil
+
+	case "setuid.old.fsuid":
+		return "setuid", nil
+
+	case "setuid.old.gid":
+		return "setuid", nil
+
+	case "setuid.old.uid":
+		return "setuid", nil
+
+	case "setuid.retval":
+		return "setuid", nil
+
+	case "setxattr.basename":
+		return "setxattr", nil
+
+	case "setxattr.container_path":
+		return "setxattr", nil
+
+	case "setxattr.filename":
+		return "setxattr", nil
+
+	case "setxattr.inode":
+		return "setxattr", nil
+
+	case "setxattr.name":
+		return "setxattr", nil
+
+	case "setxattr.namespace":
+		return "setxattr", nil
+
+	case "setxattr.overlay_numlower":
+		return "setxattr", nil
+
+	case "setxattr.retval":
+		return "setxattr", nil
+
+	case "symlink.linkpath.basename":
+		return "symlink", nil
+
+	case "symlink.linkpath.container_path":
+		return "symlink", nil
+
+	case "symlink.linkpath.filename":
+		return "symlink", nil
+
+	case "symlink.linkpath.inode":
+		return "symlink", nil
+
+	case "symlink.linkpath.overlay_numlower":
+		return "symlink", nil
+
+	case "symlink.retval":
+		return "symlink", nil
+
+	case "symlink.target":
+		return "symlink", nil
+
+	case "truncate.basename":
+		return "truncate", nil
+
+	case "truncate.container_path":
+		return "truncate", nil
+
+	case "truncate.filename":
+		return "truncate", nil
+
+	case "truncate.inode":
+		return "truncate", nil
+
+	case "truncate.length":
+		return "truncate", nil
+
+	case "truncate.overlay_numlower":
+		return "truncate", nil
+
+	case "truncate.retval":
+		return "truncate", nil
+
+	case "truncate.wipe":
+		return "truncate", nil
+
+	case "umount.retval":
+		return "umount", nil
+
+	case "umount.target":
+		return "umount", nil
+
+	case "unlink.basename":
+		return "unlink", nil
+
+	case "unlink.container_path":
+		return "unlink", nil
+
+	case "unlink.filename":
+		return "unlink", nil
+
+	case "unlink.flags":
+		return "unlink", nil
+
+	case "unlink.inode":
+		return "unlink", nil
+
+	case "unlink.overlay_numlower":
+		return "unlink", nil
+
+	case "unlink.retval":
+		return "unlink", nil
+
+	case "utimes.basename":
+		return "utimes", nil
+
+	case "utimes.container_path":
+		return "utimes", nil
+
+	case "utimes.filename":
+		return "utimes", nil
+
+	case "utimes.inode":
+		return "utimes", nil
+
+	case "utimes.overlay_numlower":
+		return "utimes", nil
+
+	case "utimes.retval":
+		return "utimes", nil
+
+	}
+
+	return "", &eval.ErrFieldNotFound{Field: field}
+}
+
+func (e *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
+	switch field {
+
+	case "bind.destination.ip":
+
+		return reflect.String, nil
+
+	case "bind.destination.port":
+
+		return reflect.Int, nil
+
+	case "bind.family":
+
+		return reflect.Int, nil
+
+	case "bind.retval":
+
+		return reflect.Int, nil
+
+	case "bind.source.ip":
+
+		return reflect.String, nil
+
+	case "bind.source.port":
+
+		return reflect.Int, nil
+
+	case "bind.type":
+
+		return reflect.Int, nil
+
+	case "bpf.cmd":
+
+		return reflect.Int, nil
+
+	case "bpf.retval":
+
+		return reflect.Int, nil
+
+	case "capset.cap_gained":
+
+		return reflect.Int, nil
+
+	case "capset.new.cap_effective":
+
+		return reflect.Int, nil
+
+	case "capset.new.cap_inheritable":
+
+		return reflect.Int, nil
+
+	case "capset.new.cap_permitted":
+
+		return reflect.Int, nil
+
+	case "capset.old.cap_effective":
+
+		return reflect.Int, nil
+
+	case "capset.old.cap_inheritable":
+
+		return reflect.Int, nil
+
+	case "capset.old.cap_permitted":
+
+		return reflect.Int, nil
+
+	case "capset.retval":
+
+		return reflect.Int, nil
+
+	case "chmod.basename":
+
+		return reflect.String, nil
+
+	case "chmod.container_path":
+
+		return reflect.String, nil
+
+	case "chmod.filename":
+
+		return reflect.String, nil
+
+	case "chmod.inode":
+
+		return reflect.Int, nil
+
+	case "chmod.mode":
+
+		return reflect.Int, nil
+
+	case "chmod.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "chmod.retval":
+
+		return reflect.Int, nil
+
+	case "chown.basename":
+
+		return reflect.String, nil
+
+	case "chown.container_path":
+
+		return reflect.String, nil
+
+	case "chown.filename":
+
+		return reflect.String, nil
+
+	case "chown.gid":
+
+		return reflect.Int, nil
+
+	case "chown.inode":
+
+		return reflect.Int, nil
+
+	case "chown.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "chown.retval":
+
+		return reflect.Int, nil
+
+	case "chown.uid":
+
+		return reflect.Int, nil
+
+	case "chroot.old_root.basename":
+
+		return reflect.String, nil
+
+	case "chroot.old_root.container_path":
+
+		return reflect.String, nil
+
+	case "chroot.old_root.filename":
+
+		return reflect.String, nil
+
+	case "chroot.old_root.inode":
+
+		return reflect.Int, nil
+
+	case "chroot.old_root.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "chroot.retval":
+
+		return reflect.Int, nil
+
+	case "chroot.root.basename":
+
+		return reflect.String, nil
+
+	case "chroot.root.container_path":
+
+		return reflect.String, nil
+
+	case "chroot.root.filename":
+
+		return reflect.String, nil
+
+	case "chroot.root.inode":
+
+		return reflect.Int, nil
+
+	case "chroot.root.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "commit_creds.new.cap_effective":
+
+		return reflect.Int, nil
+
+	case "commit_creds.new.cap_inheritable":
+
+		return reflect.Int, nil
+
+	case "commit_creds.new.cap_permitted":
+
+		return reflect.Int, nil
+
+	case "commit_creds.new.egid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.new.euid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.new.fsgid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.new.fsuid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.new.gid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.new.uid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.old.cap_effective":
+
+		return reflect.Int, nil
+
+	case "commit_creds.old.cap_inheritable":
+
+		return reflect.Int, nil
+
+	case "commit_creds.old.cap_permitted":
+
+		return reflect.Int, nil
+
+	case "commit_creds.old.egid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.old.euid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.old.fsgid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.old.fsuid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.old.gid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.old.uid":
+
+		return reflect.Int, nil
+
+	case "commit_creds.retval":
+
+		return reflect.Int, nil
+
+	case "connect.destination.ip":
+
+		return reflect.String, nil
+
+	case "connect.destination.port":
+
+		return reflect.Int, nil
+
+	case "connect.family":
+
+		return reflect.Int, nil
+
+	case "connect.retval":
+
+		return reflect.Int, nil
+
+	case "connect.source.ip":
+
+		return reflect.String, nil
+
+	case "connect.source.port":
+
+		return reflect.Int, nil
+
+	case "connect.type":
+
+		return reflect.Int, nil
+
+	case "container.cgroup_path":
+
+		return reflect.String, nil
+
+	case "container.id":
+
+		return reflect.String, nil
+
+	case "exit.cause":
+
+		return reflect.Int, nil
+
+	case "exit.code":
+
+		return reflect.Int, nil
+
+	case "exit.retval":
+
+		return reflect.Int, nil
+
+	case "fork.child_pid":
+
+		return reflect.Int, nil
+
+	case "fork.retval":
+
+		return reflect.Int, nil
+
+	case "init_module.basename":
+
+		return reflect.String, nil
+
+	case "init_module.container_path":
+
+		return reflect.String, nil
+
+	case "init_module.filename":
+
+		return reflect.String, nil
+
+	case "init_module.inode":
+
+		return reflect.Int, nil
+
+	case "init_module.name":
+
+		return reflect.String, nil
+
+	case "init_module.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "init_module.retval":
+
+		return reflect.Int, nil
+
+	case "io_uring.basename":
+
+		return reflect.String, nil
+
+	case "io_uring.container_path":
+
+		return reflect.String, nil
+
+	case "io_uring.filename":
+
+		return reflect.String, nil
+
+	case "io_uring.inode":
+
+		return reflect.Int, nil
+
+	case "io_uring.operation":
+
+		return reflect.Int, nil
+
+	case "io_uring.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "io_uring.retval":
+
+		return reflect.Int, nil
+
+	case "kill.pid":
+
+		return reflect.Int, nil
+
+	case "kill.retval":
+
+		return reflect.Int, nil
+
+	case "kill.signal":
+
+		return reflect.Int, nil
+
+	case "link.retval":
+
+		return reflect.Int, nil
+
+	case "link.source.basename":
+
+		return reflect.String, nil
+
+	case "link.source.container_path":
+
+		return reflect.String, nil
+
+	case "link.source.filename":
+
+		return reflect.String, nil
+
+	case "link.source.inode":
+
+		return reflect.Int, nil
+
+	case "link.source.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "link.target.basename":
+
+		return reflect.String, nil
+
+	case "link.target.container_path":
+
+		return reflect.String, nil
+
+	case "link.target.filename":
+
+		return reflect.String, nil
+
+	case "link.target.inode":
+
+		return reflect.Int, nil
+
+	case "link.target.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "memfd_create.flags":
+
+		return reflect.Int, nil
+
+	case "memfd_create.name":
+
+		return reflect.String, nil
+
+	case "memfd_create.retval":
+
+		return reflect.Int, nil
+
+	case "mkdir.basename":
+
+		return reflect.String, nil
+
+	case "mkdir.container_path":
+
+		return reflect.String, nil
+
+	case "mkdir.filename":
+
+		return reflect.String, nil
+
+	case "mkdir.inode":
+
+		return reflect.Int, nil
+
+	case "mkdir.mode":
+
+		return reflect.Int, nil
+
+	case "mkdir.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "mkdir.retval":
+
+		return reflect.Int, nil
+
+	case "mount.flags":
+
+		return reflect.Int, nil
+
+	case "mount.fs_type":
+
+		return reflect.String, nil
+
+	case "mount.retval":
+
+		return reflect.Int, nil
+
+	case "mount.root":
+
+		return reflect.String, nil
+
+	case "mount.source":
+
+		return reflect.String, nil
+
+	case "mount.target":
+
+		return reflect.String, nil
+
+	case "open.basename":
+
+		return reflect.String, nil
+
+	case "open.container_path":
+
+		return reflect.String, nil
+
+	case "open.filename":
+
+		return reflect.String, nil
+
+	case "open.flags":
+
+		return reflect.Int, nil
+
+	case "open.inode":
+
+		return reflect.Int, nil
+
+	case "open.mode":
+
+		return reflect.Int, nil
+
+	case "open.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "open.retval":
+
+		return reflect.Int, nil
+
+	case "pivot_root.old_root.basename":
+
+		return reflect.String, nil
+
+	case "pivot_root.old_root.container_path":
+
+		return reflect.String, nil
+
+	case "pivot_root.old_root.filename":
+
+		return reflect.String, nil
+
+	case "pivot_root.old_root.inode":
+
+		return reflect.Int, nil
+
+	case "pivot_root.old_root.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "pivot_root.retval":
+
+		return reflect.Int, nil
+
+	case "pivot_root.root.basename":
+
+		return reflect.String, nil
+
+	case "pivot_root.root.container_path":
+
+		return reflect.String, nil
+
+	case "pivot_root.root.filename":
+
+		return reflect.String, nil
+
+	case "pivot_root.root.inode":
+
+		return reflect.Int, nil
+
+	case "pivot_root.root.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "prctl.arg":
+
+		return reflect.Int, nil
+
+	case "prctl.new_name":
+
+		return reflect.String, nil
+
+	case "prctl.old_name":
+
+		return reflect.String, nil
+
+	case "prctl.option":
+
+		return reflect.Int, nil
+
+	case "prctl.retval":
+
+		return reflect.Int, nil
+
+	case "process.args":
+
+		return reflect.String, nil
+
+	case "process.basename":
+
+		return reflect.String, nil
+
+	case "process.container_path":
+
+		return reflect.String, nil
+
+	case "process.envs":
+
+		return reflect.String, nil
+
+	case "process.fileless":
+
+		return reflect.Bool, nil
+
+	case "process.filename":
+
+		return reflect.String, nil
+
+	case "process.gid":
+
+		return reflect.Int, nil
+
+	case "process.group":
+
+		return reflect.String, nil
+
+	case "process.inode":
+
+		return reflect.Int, nil
+
+	case "process.memfd_name":
+
+		return reflect.String, nil
+
+	case "process.mntns":
+
+		return reflect.Int, nil
+
+	case "process.name":
+
+		return reflect.String, nil
+
+	case "process.netns":
+
+		return reflect.Int, nil
+
+	case "process.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "process.pid":
+
+		return reflect.Int, nil
+
+	case "process.pidns":
+
+		return reflect.Int, nil
+
+	case "process.script.basename":
+
+		return reflect.String, nil
+
+	case "process.script.container_path":
+
+		return reflect.String, nil
+
+	case "process.script.filename":
+
+		return reflect.String, nil
+
+	case "process.script.inode":
+
+		return reflect.Int, nil
+
+	case "process.script.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "process.tid":
+
+		return reflect.Int, nil
+
+	case "process.tty_name":
+
+		return reflect.String, nil
+
+	case "process.uid":
+
+		return reflect.Int, nil
+
+	case "process.user":
+
+		return reflect.String, nil
+
+	case "ptrace.pid":
+
+		return reflect.Int, nil
+
+	case "ptrace.request":
+
+		return reflect.Int, nil
+
+	case "ptrace.retval":
+
+		return reflect.Int, nil
+
+	case "removexattr.basename":
+
+		return reflect.String, nil
+
+	case "removexattr.container_path":
+
+		return reflect.String, nil
+
+	case "removexattr.filename":
+
+		return reflect.String, nil
+
+	case "removexattr.inode":
+
+		return reflect.Int, nil
+
+	case "removexattr.name":
+
+		return reflect.String, nil
+
+	case "removexattr.namespace":
+
+		return reflect.String, nil
+
+	case "removexattr.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "removexattr.retval":
+
+		return reflect.Int, nil
+
+	case "rename.new.basename":
+
+		return reflect.String, nil
+
+	case "rename.new.container_path":
+
+		return reflect.String, nil
+
+	case "rename.new.filename":
+
+		return reflect.String, nil
+
+	case "rename.new.inode":
+
+		return reflect.Int, nil
+
+	case "rename.new.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "rename.old.basename":
+
+		return reflect.String, nil
+
+	case "rename.old.container_path":
+
+		return reflect.String, nil
+
+	case "rename.old.filename":
+
+		return reflect.String, nil
+
+	case "rename.old.inode":
+
+		return reflect.Int, nil
+
+	case "rename.old.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "rename.retval":
+
+		return reflect.Int, nil
+
+	case "rmdir.basename":
+
+		return reflect.String, nil
+
+	case "rmdir.container_path":
+
+		return reflect.String, nil
+
+	case "rmdir.filename":
+
+		return reflect.String, nil
+
+	case "rmdir.inode":
+
+		return reflect.Int, nil
+
+	case "rmdir.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "rmdir.retval":
+
+		return reflect.Int, nil
+
+	case "setgid.new.egid":
+
+		return reflect.Int, nil
+
+	case "setgid.new.euid":
+
+		return reflect.Int, nil
+
+	case "setgid.new.fsgid":
+
+		return reflect.Int, nil
+
+	case "setgid.new.fsuid":
+
+		return reflect.Int, nil
+
+	case "setgid.new.gid":
+
+		return reflect.Int, nil
+
+	case "setgid.new.uid":
+
+		return reflect.Int, nil
+
+	case "setgid.old.egid":
+
+		return reflect.Int, nil
+
+	case "setgid.old.euid":
+
+		return reflect.Int, nil
+
+	case "setgid.old.fsgid":
+
+		return reflect.Int, nil
+
+	case "setgid.old.fsuid":
+
+		return reflect.Int, nil
+
+	case "setgid.old.gid":
+
+		return reflect.Int, nil
+
+	case "setgid.old.uid":
+
+		return reflect.Int, nil
+
+	case "setgid.retval":
+
+		return reflect.Int, nil
+
+	case "setns.fd":
+
+		return reflect.Int, nil
+
+	case "setns.ns_inode":
+
+		return reflect.Int, nil
+
+	case "setns.nstype":
+
+		return reflect.Int, nil
+
+	case "setns.retval":
+
+		return reflect.Int, nil
+
+	case "setuid.new.egid":
+
+		return reflect.Int, nil
+
+	case "setuid.new.euid":
+
+		return reflect.Int, nil
+
+	case "setuid.new.fsgid":
+
+		return reflect.Int, nil
+
+	case "setuid.new.fsuid":
+
+		return reflect.Int, nil
+
+	case "setuid.new.gid":
+
+		return reflect.Int, nil
+
+	case "setuid.new.uid":
+
+		return reflect.Int, nil
+
+	case "setuid.old.egid":
+
+		return reflect.Int, nil
+
+	case "setuid.old.euid":
+
+		return reflect.Int, nil
+
+	case "setuid.old.fsgid":
+
+		return reflect.Int, nil
+
+	case "setuid.old.fsuid":
+
+		return reflect.Int, nil
+
+	case "setuid.old.gid":
+
+		return reflect.Int, nil
+
+	case "setuid.old.uid":
+
+		return reflect.Int, nil
+
+	case "setuid.retval":
+
+		return reflect.Int, nil
+
+	case "setxattr.basename":
+
+		return reflect.String, nil
+
+	case "setxattr.container_path":
+
+		return reflect.String, nil
+
+	case "setxattr.filename":
+
+		return reflect.String, nil
+
+	case "setxattr.inode":
+
+		return reflect.Int, nil
+
+	case "setxattr.name":
+
+		return reflect.String, nil
+
+	case "setxattr.namespace":
+
+		return reflect.String, nil
+
+	case "setxattr.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "setxattr.retval":
+
+		return reflect.Int, nil
+
+	case "symlink.linkpath.basename":
+
+		return reflect.String, nil
+
+	case "symlink.linkpath.container_path":
+
+		return reflect.String, nil
+
+	case "symlink.linkpath.filename":
+
+		return reflect.String, nil
+
+	case "symlink.linkpath.inode":
+
+		return reflect.Int, nil
+
+	case "symlink.linkpath.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "symlink.retval":
+
+		return reflect.Int, nil
+
+	case "symlink.target":
+
+		return reflect.String, nil
+
+	case "truncate.basename":
+
+		return reflect.String, nil
+
+	case "truncate.container_path":
+
+		return reflect.String, nil
+
+	case "truncate.filename":
+
+		return reflect.String, nil
+
+	case "truncate.inode":
+
+		return reflect.Int, nil
+
+	case "truncate.length":
+
+		return reflect.Int, nil
+
+	case "truncate.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "truncate.retval":
+
+		return reflect.Int, nil
+
+	case "truncate.wipe":
+
+		return reflect.Bool, nil
+
+	case "umount.retval":
+
+		return reflect.Int, nil
+
+	case "umount.target":
+
+		return reflect.String, nil
+
+	case "unlink.basename":
+
+		return reflect.String, nil
+
+	case "unlink.container_path":
+
+		return reflect.String, nil
+
+	case "unlink.filename":
+
+		return reflect.String, nil
+
+	case "unlink.flags":
+
+		return reflect.Int, nil
+
+	case "unlink.inode":
+
+		return reflect.Int, nil
+
+	case "unlink.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "unlink.retval":
+
+		return reflect.Int, nil
+
+	case "utimes.basename":
+
+		return reflect.String, nil
+
+	case "utimes.container_path":
+
+		return reflect.String, nil
+
+	case "utimes.filename":
+
+		return reflect.String, nil
+
+	case "utimes.inode":
+
+		return reflect.Int, nil
+
+	case "utimes.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "utimes.retval":
+
+		return reflect.Int, nil
+
+	}
+
+	return reflect.Invalid, &eval.ErrFieldNotFound{Field: field}
+}
+
+func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
+	var ok bool
+	switch field {
+
+	case "bind.destination.ip":
+
+		if e.Bind.Destination.IP, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Destination.IP"}
+		}
+		return nil
+
+	case "bind.destination.port":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Destination.Port"}
+		}
+		e.Bind.Destination.Port = uint32(v)
+		return nil
+
+	case "bind.family":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Family"}
+		}
+		e.Bind.Family = uint32(v)
+		return nil
+
+	case "bind.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Retval"}
+		}
+		e.Bind.Retval = int64(v)
+		return nil
+
+	case "bind.source.ip":
+
+		if e.Bind.Source.IP, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Source.IP"}
+		}
+		return nil
+
+	case "bind.source.port":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Source.Port"}
+		}
+		e.Bind.Source.Port = uint32(v)
+		return nil
+
+	case "bind.type":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Type"}
+		}
+		e.Bind.Type = uint32(v)
+		return nil
+
+	case "bpf.cmd":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BPF.Cmd"}
+		}
+		e.BPF.Cmd = uint32(v)
+		return nil
+
+	case "bpf.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BPF.Retval"}
+		}
+		e.BPF.Retval = int64(v)
+		return nil
+
+	case "capset.cap_gained":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Capset.Gained"}
+		}
+		e.Capset.Gained = uint64(v)
+		return nil
+
+	case "capset.new.cap_effective":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Capset.New.Effective"}
+		}
+		e.Capset.New.Effective = uint64(v)
+		return nil
+
+	case "capset.new.cap_inheritable":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Capset.New.Inheritable"}
+		}
+		e.Capset.New.Inheritable = uint64(v)
+		return nil
+
+	case "capset.new.cap_permitted":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Capset.New.Permitted"}
+		}
+		e.Capset.New.Permitted = uint64(v)
+		return nil
+
+	case "capset.old.cap_effective":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Capset.Old.Effective"}
+		}
+		e.Capset.Old.Effective = uint64(v)
+		return nil
+
+	case "capset.old.cap_inheritable":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Capset.Old.Inheritable"}
+		}
+		e.Capset.Old.Inheritable = uint64(v)
+		return nil
+
+	case "capset.old.cap_permitted":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Capset.Old.Permitted"}
+		}
+		e.Capset.Old.Permitted = uint64(v)
+		return nil
+
+	case "capset.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Capset.Retval"}
+		}
+		e.Capset.Retval = int64(v)
+		return nil
+
+	case "chmod.basename":
+
+		if e.Chmod.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.BasenameStr"}
+		}
+		return nil
+
+	case "chmod.container_path":
+
+		if e.Chmod.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.ContainerPath"}
+		}
+		return nil
+
+	case "chmod.filename":
+
+		if e.Chmod.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.PathnameStr"}
+		}
+		return nil
+
+	case "chmod.inode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.Inode"}
+		}
+		e.Chmod.Inode = uint64(v)
+		return nil
+
+	case "chmod.mode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.Mode"}
+		}
+		e.Chmod.Mode = uint32(v)
+		return nil
+
+	case "chmod.overlay_numlower":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.OverlayNumLower"}
+		}
+		e.Chmod.OverlayNumLower = int32(v)
+		return nil
+
+	case "chmod.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.Retval"}
+		}
+		e.Chmod.Retval = int64(v)
+		return nil
+
+	case "chown.basename":
+
+		if e.Chown.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.BasenameStr"}
+		}
+		return nil
+
+	case "chown.container_path":
+
+		if e.Chown.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.ContainerPath"}
+		}
+		return nil
+
+	case "chown.filename":
+
+		if e.Chown.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.PathnameStr"}
+		}
+		return nil
+
+	case "chown.gid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.GID"}
+		}
+		e.Chown.GID = int32(v)
+		return nil
+
+	case "chown.inode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.Inode"}
+		}
+		e.Chown.Inode = uint64(v)
+		return nil
+
+	case "chown.overlay_numlower":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.OverlayNumLower"}
+		}
+		e.Chown.OverlayNumLower = int32(v)
+		return nil
+
+	case "chown.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.Retval"}
+		}
+		e.Chown.Retval = int64(v)
+		return nil
+
+	case "chown.uid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.UID"}
+		}
+		e.Chown.UID = int32(v)
+		return nil
+
+	case "chroot.old_root.basename":
+
+		if e.Chroot.OldRoot.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chroot.OldRoot.BasenameStr"}
+		}
+		return nil
+
+	case "chroot.old_root.container_path":
+
+		if e.Chroot.OldRoot.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chroot.OldRoot.ContainerPath"}
+		}
+		return nil
+
+	case "chroot.old_root.filename":
+
+		if e.Chroot.OldRoot.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chroot.OldRoot.PathnameStr"}
+		}
+		return nil
+
+	case "chroot.old_root.inode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chroot.OldRoot.Inode"}
+		}
+		e.Chroot.OldRoot.Inode = uint64(v)
+		return nil
+
+	case "chroot.old_root.overlay_numlower":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chroot.OldRoot.OverlayNumLower"}
+		}
+		e.Chroot.OldRoot.OverlayNumLower = int32(v)
+		return nil
+
+	case "chroot.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chroot.Retval"}
+		}
+		e.Chroot.Retval = int64(v)
+		return nil
+
+	case "chroot.root.basename":
+
+		if e.Chroot.Root.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chroot.Root.BasenameStr"}
+		}
+		return nil
+
+	case "chroot.root.container_path":
+
+		if e.Chroot.Root.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chroot.Root.ContainerPath"}
+		}
+		return nil
+
+	case "chroot.root.filename":
+
+		if e.Chroot.Root.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chroot.Root.PathnameStr"}
+		}
+		return nil
+
+	case "chroot.root.inode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chroot.Root.Inode"}
+		}
+		e.Chroot.Root.Inode = uint64(v)
+		return nil
+
+	case "chroot.root.overlay_numlower":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chroot.Root.OverlayNumLower"}
+		}
+		e.Chroot.Root.OverlayNumLower = int32(v)
+		return nil
+
+	case "commit_creds.new.cap_effective":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.New.Effective"}
+		}
+		e.CommitCreds.New.Effective = uint64(v)
+		return nil
+
+	case "commit_creds.new.cap_inheritable":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.New.Inheritable"}
+		}
+		e.CommitCreds.New.Inheritable = uint64(v)
+		return nil
+
+	case "commit_creds.new.cap_permitted":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.New.Permitted"}
+		}
+		e.CommitCreds.New.Permitted = uint64(v)
+		return nil
+
+	case "commit_creds.new.egid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.New.EGID"}
+		}
+		e.CommitCreds.New.EGID = uint32(v)
+		return nil
+
+	case "commit_creds.new.euid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.New.EUID"}
+		}
+		e.CommitCreds.New.EUID = uint32(v)
+		return nil
+
+	case "commit_creds.new.fsgid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.New.FSGID"}
+		}
+		e.CommitCreds.New.FSGID = uint32(v)
+		return nil
+
+	case "commit_creds.new.fsuid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.New.FSUID"}
+		}
+		e.CommitCreds.New.FSUID = uint32(v)
+		return nil
+
+	case "commit_creds.new.gid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.New.GID"}
+		}
+		e.CommitCreds.New.GID = uint32(v)
+		return nil
+
+	case "commit_creds.new.uid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.New.UID"}
+		}
+		e.CommitCreds.New.UID = uint32(v)
+		return nil
+
+	case "commit_creds.old.cap_effective":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.Old.Effective"}
+		}
+		e.CommitCreds.Old.Effective = uint64(v)
+		return nil
+
+	case "commit_creds.old.cap_inheritable":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.Old.Inheritable"}
+		}
+		e.CommitCreds.Old.Inheritable = uint64(v)
+		return nil
+
+	case "commit_creds.old.cap_permitted":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.Old.Permitted"}
+		}
+		e.CommitCreds.Old.Permitted = uint64(v)
+		return nil
+
+	case "commit_creds.old.egid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.Old.EGID"}
+		}
+		e.CommitCreds.Old.EGID = uint32(v)
+		return nil
+
+	case "commit_creds.old.euid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.Old.EUID"}
+		}
+		e.CommitCreds.Old.EUID = uint32(v)
+		return nil
+
+	case "commit_creds.old.fsgid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.Old.FSGID"}
+		}
+		e.CommitCreds.Old.FSGID = uint32(v)
+		return nil
+
+	case "commit_creds.old.fsuid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.Old.FSUID"}
+		}
+		e.CommitCreds.Old.FSUID = uint32(v)
+		return nil
+
+	case "commit_creds.old.gid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.Old.GID"}
+		}
+		e.CommitCreds.Old.GID = uint32(v)
+		return nil
+
+	case "commit_creds.old.uid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.Old.UID"}
+		}
+		e.CommitCreds.Old.UID = uint32(v)
+		return nil
+
+	case "commit_creds.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CommitCreds.Retval"}
+		}
+		e.CommitCreds.Retval = int64(v)
+		return nil
+
+	case "connect.destination.ip":
+
+		if e.Connect.Destination.IP, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Connect.Destination.IP"}
+		}
+		return nil
+
+	case "connect.destination.port":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Connect.Destination.Port"}
+		}
+		e.Connect.Destination.Port = uint32(v)
+		return nil
+
+	case "connect.family":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Connect.Family"}
+		}
+		e.Connect.Family = uint32(v)
+		return nil
+
+	case "connect.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Connect.Retval"}
+		}
+		e.Connect.Retval = int64(v)
+		return nil
+
+	case "connect.source.ip":
+
+		if e.Connect.Source.IP, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Connect.Source.IP"}
+		}
+		return nil
+
+	case "connect.source.port":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Connect.Source.Port"}
+		}
+		e.Connect.Source.Port = uint32(v)
+		return nil
+
+	case "connect.type":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Connect.Type"}
+		}
+		e.Connect.Type = uint32(v)
+		return nil
+
+	case "container.cgroup_path":
+
+		if e.Container.CgroupPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Container.CgroupPath"}
+		}
+		return nil
+
+	case "container.id":
+
+		if e.Container.ID, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Container.ID"}
+		}
+		return nil
+
+	case "exit.cause":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Cause"}
+		}
+		e.Exit.Cause = uint32(v)
+		return nil
+
+	case "exit.code":
 
-	case "link.source.basename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Code"}
+		}
+		e.Exit.Code = uint32(v)
+		return nil
 
-		return reflect.String, nil
+	case "exit.retval":
 
-	case "link.source.container_path":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Retval"}
+		}
+		e.Exit.Retval = int64(v)
+		return nil
 
-		return reflect.String, nil
+	case "fork.child_pid":
 
-	case "link.source.filename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Fork.ChildPID"}
+		}
+		e.Fork.ChildPID = uint32(v)
+		return nil
 
-		return reflect.String, nil
+	case "fork.retval":
 
-	case "link.source.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Fork.Retval"}
+		}
+		e.Fork.Retval = int64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "init_module.basename":
 
-	case "link.source.overlay_numlower":
+		if e.InitModule.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "InitModule.BasenameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "init_module.container_path":
 
-	case "link.target.basename":
+		if e.InitModule.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "InitModule.ContainerPath"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "init_module.filename":
 
-	case "link.target.container_path":
+		if e.InitModule.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "InitModule.PathnameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "init_module.inode":
 
-	case "link.target.filename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "InitModule.Inode"}
+		}
+		e.InitModule.Inode = uint64(v)
+		return nil
 
-		return reflect.String, nil
+	case "init_module.name":
 
-	case "link.target.inode":
+		if e.InitModule.Name, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "InitModule.Name"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "init_module.overlay_numlower":
 
-	case "link.target.overlay_numlower":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "InitModule.OverlayNumLower"}
+		}
+		e.InitModule.OverlayNumLower = int32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "init_module.retval":
 
-	case "mkdir.basename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "InitModule.Retval"}
+		}
+		e.InitModule.Retval = int64(v)
+		return nil
 
-		return reflect.String, nil
+	case "io_uring.basename":
 
-	case "mkdir.container_path":
+		if e.IOUring.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "IOUring.BasenameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "io_uring.container_path":
 
-	case "mkdir.filename":
+		if e.IOUring.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "IOUring.ContainerPath"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "io_uring.filename":
 
-	case "mkdir.inode":
+		if e.IOUring.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "IOUring.PathnameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "io_uring.inode":
 
-	case "mkdir.mode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "IOUring.Inode"}
+		}
+		e.IOUring.Inode = uint64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "io_uring.operation":
 
-	case "mkdir.overlay_numlower":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "IOUring.Operation"}
+		}
+		e.IOUring.Operation = uint32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "io_uring.overlay_numlower":
 
-	case "mkdir.retval":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "IOUring.OverlayNumLower"}
+		}
+		e.IOUring.OverlayNumLower = int32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "io_uring.retval":
 
-	case "open.basename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "IOUring.Retval"}
+		}
+		e.IOUring.Retval = int64(v)
+		return nil
 
-		return reflect.String, nil
+	case "kill.pid":
 
-	case "open.container_path":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Kill.PID"}
+		}
+		e.Kill.PID = uint32(v)
+		return nil
 
-		return reflect.String, nil
+	case "kill.retval":
 
-	case "open.filename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Kill.Retval"}
+		}
+		e.Kill.Retval = int64(v)
+		return nil
 
-		return reflect.String, nil
+	case "kill.signal":
 
-	case "open.flags":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Kill.Signal"}
+		}
+		e.Kill.Signal = uint32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "link.retval":
 
-	case "open.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Retval"}
+		}
+		e.Link.Retval = int64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "link.source.basename":
 
-	case "open.mode":
+		if e.Link.Source.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.BasenameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "link.source.container_path":
 
-	case "open.overlay_numlower":
+		if e.Link.Source.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.ContainerPath"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "link.source.filename":
 
-	case "open.retval":
+		if e.Link.Source.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.PathnameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "link.source.inode":
 
-	case "process.basename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.Inode"}
+		}
+		e.Link.Source.Inode = uint64(v)
+		return nil
 
-		return reflect.String, nil
+	case "link.source.overlay_numlower":
 
-	case "process.container_path":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.OverlayNumLower"}
+		}
+		e.Link.Source.OverlayNumLower = int32(v)
+		return nil
 
-		return reflect.String, nil
+	case "link.target.basename":
 
-	case "process.filename":
+		if e.Link.Target.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.BasenameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "link.target.container_path":
 
-	case "process.gid":
+		if e.Link.Target.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.ContainerPath"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "link.target.filename":
 
-	case "process.group":
+		if e.Link.Target.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.PathnameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "link.target.inode":
 
-	case "process.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.Inode"}
+		}
+		e.Link.Target.Inode = uint64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "link.target.overlay_numlower":
 
-	case "process.name":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.OverlayNumLower"}
+		}
+		e.Link.Target.OverlayNumLower = int32(v)
+		return nil
 
-		return reflect.String, nil
+	case "memfd_create.flags":
 
-	case "process.overlay_numlower":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MemfdCreate.Flags"}
+		}
+		e.MemfdCreate.Flags = uint32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "memfd_create.name":
 
-	case "process.pid":
+		if e.MemfdCreate.Name, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MemfdCreate.Name"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "memfd_create.retval":
 
-	case "process.pidns":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MemfdCreate.Retval"}
+		}
+		e.MemfdCreate.Retval = int64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "mkdir.basename":
 
-	case "process.tid":
+		if e.Mkdir.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.BasenameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "mkdir.container_path":
 
-	case "process.tty_name":
+		if e.Mkdir.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.ContainerPath"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "mkdir.filename":
 
-	case "process.uid":
+		if e.Mkdir.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.PathnameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "mkdir.inode":
 
-	case "process.user":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Inode"}
+		}
+		e.Mkdir.Inode = uint64(v)
+		return nil
 
-		return reflect.String, nil
+	case "mkdir.mode":
 
-	case "removexattr.basename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Mode"}
+		}
+		e.Mkdir.Mode = int32(v)
+		return nil
 
-		return reflect.String, nil
+	case "mkdir.overlay_numlower":
 
-	case "removexattr.container_path":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.OverlayNumLower"}
+		}
+		e.Mkdir.OverlayNumLower = int32(v)
+		return nil
 
-		return reflect.String, nil
+	case "mkdir.retval":
 
-	case "removexattr.filename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Retval"}
+		}
+		e.Mkdir.Retval = int64(v)
+		return nil
 
-		return reflect.String, nil
+	case "mount.flags":
 
-	case "removexattr.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mount.Flags"}
+		}
+		e.Mount.Flags = uint64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "mount.fs_type":
 
-	case "removexattr.name":
+		if e.Mount.FSType, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mount.FSType"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "mount.retval":
 
-	case "removexattr.namespace":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mount.Retval"}
+		}
+		e.Mount.Retval = int64(v)
+		return nil
 
-		return reflect.String, nil
+	case "mount.root":
 
-	case "removexattr.overlay_numlower":
+		if e.Mount.RootStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mount.RootStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "mount.source":
 
-	case "removexattr.retval":
+		if e.Mount.SourceStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mount.SourceStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "mount.target":
 
-	case "rename.new.basename":
+		if e.Mount.MountPointStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mount.MountPointStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "open.basename":
 
-	case "rename.new.container_path":
+		if e.Open.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.BasenameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "open.container_path":
 
-	case "rename.new.filename":
+		if e.Open.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.ContainerPath"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "open.filename":
 
-	case "rename.new.inode":
+		if e.Open.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.PathnameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "open.flags":
 
-	case "rename.new.overlay_numlower":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.Flags"}
+		}
+		e.Open.Flags = uint32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "open.inode":
 
-	case "rename.old.basename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.Inode"}
+		}
+		e.Open.Inode = uint64(v)
+		return nil
 
-		return reflect.String, nil
+	case "open.mode":
 
-	case "rename.old.container_path":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.Mode"}
+		}
+		e.Open.Mode = uint32(v)
+		return nil
 
-		return reflect.String, nil
+	case "open.overlay_numlower":
 
-	case "rename.old.filename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.OverlayNumLower"}
+		}
+		e.Open.OverlayNumLower = int32(v)
+		return nil
 
-		return reflect.String, nil
+	case "open.retval":
 
-	case "rename.old.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.Retval"}
+		}
+		e.Open.Retval = int64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "pivot_root.old_root.basename":
 
-	case "rename.old.overlay_numlower":
+		if e.PivotRoot.OldRoot.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PivotRoot.OldRoot.BasenameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "pivot_root.old_root.container_path":
 
-	case "rename.retval":
+		if e.PivotRoot.OldRoot.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PivotRoot.OldRoot.ContainerPath"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "pivot_root.old_root.filename":
 
-	case "rmdir.basename":
+		if e.PivotRoot.OldRoot.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PivotRoot.OldRoot.PathnameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "pivot_root.old_root.inode":
 
-	case "rmdir.container_path":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PivotRoot.OldRoot.Inode"}
+		}
+		e.PivotRoot.OldRoot.Inode = uint64(v)
+		return nil
 
-		return reflect.String, nil
+	case "pivot_root.old_root.overlay_numlower":
 
-	case "rmdir.filename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PivotRoot.OldRoot.OverlayNumLower"}
+		}
+		e.PivotRoot.OldRoot.OverlayNumLower = int32(v)
+		return nil
 
-		return reflect.String, nil
+	case "pivot_root.retval":
 
-	case "rmdir.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PivotRoot.Retval"}
+		}
+		e.PivotRoot.Retval = int64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "pivot_root.root.basename":
 
-	case "rmdir.overlay_numlower":
+		if e.PivotRoot.Root.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PivotRoot.Root.BasenameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "pivot_root.root.container_path":
 
-	case "rmdir.retval":
+		if e.PivotRoot.Root.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PivotRoot.Root.ContainerPath"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "pivot_root.root.filename":
 
-	case "setxattr.basename":
+		if e.PivotRoot.Root.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PivotRoot.Root.PathnameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "pivot_root.root.inode":
 
-	case "setxattr.container_path":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PivotRoot.Root.Inode"}
+		}
+		e.PivotRoot.Root.Inode = uint64(v)
+		return nil
 
-		return reflect.String, nil
+	case "pivot_root.root.overlay_numlower":
 
-	case "setxattr.filename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PivotRoot.Root.OverlayNumLower"}
+		}
+		e.PivotRoot.Root.OverlayNumLower = int32(v)
+		return nil
 
-		return reflect.String, nil
+	case "prctl.arg":
 
-	case "setxattr.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.Arg"}
+		}
+		e.Prctl.Arg = uint64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "prctl.new_name":
 
-	case "setxattr.name":
+		if e.Prctl.NewName, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.NewName"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "prctl.old_name":
 
-	case "setxattr.namespace":
+		if e.Prctl.OldName, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.OldName"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "prctl.option":
 
-	case "setxattr.overlay_numlower":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.Option"}
+		}
+		e.Prctl.Option = uint32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "prctl.retval":
 
-	case "setxattr.retval":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.Retval"}
+		}
+		e.Prctl.Retval = int64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "process.args":
 
-	case "unlink.basename":
+		if e.Process.Args, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Args"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "process.basename":
 
-	case "unlink.container_path":
+		if e.Process.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.BasenameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "process.container_path":
 
-	case "unlink.filename":
+		if e.Process.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.ContainerPath"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "process.envs":
 
-	case "unlink.flags":
+		if e.Process.Envs, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Envs"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "process.fileless":
 
-	case "unlink.inode":
+		if e.Process.Fileless, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Fileless"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "process.filename":
 
-	case "unlink.overlay_numlower":
+		if e.Process.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.PathnameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "process.gid":
 
-	case "unlink.retval":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.GID"}
+		}
+		e.Process.GID = uint32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "process.group":
 
-	case "utimes.basename":
+		if e.Process.Group, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Group"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "process.inode":
 
-	case "utimes.container_path":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Inode"}
+		}
+		e.Process.Inode = uint64(v)
+		return nil
 
-		return reflect.String, nil
+	case "process.memfd_name":
 
-	case "utimes.filename":
+		if e.Process.MemfdName, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.MemfdName"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "process.mntns":
 
-	case "utimes.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Mntns"}
+		}
+		e.Process.Mntns = uint32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "process.name":
 
-	case "utimes.overlay_numlower":
+		if e.Process.Comm, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Comm"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "process.netns":
 
-	case "utimes.retval":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Netns"}
+		}
+		e.Process.Netns = uint32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "process.overlay_numlower":
 
-	}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.OverlayNumLower"}
+		}
+		e.Process.OverlayNumLower = int32(v)
+		return nil
 
-	return reflect.Invalid, &eval.ErrFieldNotFound{Field: field}
-}
+	case "process.pid":
 
-func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
-	var ok bool
-	switch field {
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Pid"}
+		}
+		e.Process.Pid = uint32(v)
+		return nil
 
-	case "chmod.basename":
+	case "process.pidns":
 
-		if e.Chmod.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.BasenameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Pidns"}
 		}
+		e.Process.Pidns = uint64(v)
 		return nil
 
-	case "chmod.container_path":
+	case "process.script.basename":
 
-		if e.Chmod.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.ContainerPath"}
+		if e.Process.Script.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Script.BasenameStr"}
 		}
 		return nil
 
-	case "chmod.filename":
+	case "process.script.container_path":
 
-		if e.Chmod.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.PathnameStr"}
+		if e.Process.Script.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Script.ContainerPath"}
 		}
 		return nil
 
-	case "chmod.inode":
+	case "process.script.filename":
 
-		v, ok := value.(int)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.Inode"}
+		if e.Process.Script.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Script.PathnameStr"}
 		}
-		e.Chmod.Inode = uint64(v)
 		return nil
 
-	case "chmod.mode":
+	case "process.script.inode":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Process.Script.Inode"}
 		}
-		e.Chmod.Mode = uint32(v)
+		e.Process.Script.Inode = uint64(v)
 		return nil
 
-	case "chmod.overlay_numlower":
+	case "process.script.overlay_numlower":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "Process.Script.OverlayNumLower"}
 		}
-		e.Chmod.OverlayNumLower = int32(v)
+		e.Process.Script.OverlayNumLower = int32(v)
 		return nil
 
-	case "chmod.retval":
+	case "process.tid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "Process.Tid"}
 		}
-		e.Chmod.Retval = int64(v)
+		e.Process.Tid = uint32(v)
 		return nil
 
-	case "chown.basename":
+	case "process.tty_name":
 
-		if e.Chown.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.BasenameStr"}
+		if e.Process.TTYName, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.TTYName"}
 		}
 		return nil
 
-	case "chown.container_path":
+	case "process.uid":
 
-		if e.Chown.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.ContainerPath"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.UID"}
 		}
+		e.Process.UID = uint32(v)
 		return nil
 
-	case "chown.filename":
+	case "process.user":
 
-		if e.Chown.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.PathnameStr"}
+		if e.Process.User, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.User"}
 		}
 		return nil
 
-	case "chown.gid":
+	case "ptrace.pid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "Ptrace.PID"}
 		}
-		e.Chown.GID = int32(v)
+		e.Ptrace.PID = uint32(v)
 		return nil
 
-	case "chown.inode":
+	case "ptrace.request":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Ptrace.Request"}
 		}
-		e.Chown.Inode = uint64(v)
+		e.Ptrace.Request = uint32(v)
 		return nil
 
-	case "chown.overlay_numlower":
+	case "ptrace.retval":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "Ptrace.Retval"}
 		}
-		e.Chown.OverlayNumLower = int32(v)
+		e.Ptrace.Retval = int64(v)
 		return nil
 
-	case "chown.retval":
+	case "removexattr.basename":
 
-		v, ok := value.(int)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.Retval"}
+		if e.RemoveXAttr.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.BasenameStr"}
 		}
-		e.Chown.Retval = int64(v)
 		return nil
 
-	case "chown.uid":
+	case "removexattr.container_path":
+
+		if e.RemoveXAttr.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.ContainerPath"}
+		}
+		return nil
+
+	case "removexattr.filename":
+
+		if e.RemoveXAttr.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.PathnameStr"}
+		}
+		return nil
+
+	case "removexattr.inode":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Inode"}
 		}
-		e.Chown.UID = int32(v)
+		e.RemoveXAttr.Inode = uint64(v)
 		return nil
 
-	case "container.id":
+	case "removexattr.name":
 
-		if e.Container.ID, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Container.ID"}
+		if e.RemoveXAttr.Name, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Name"}
 		}
 		return nil
 
-	case "link.retval":
+	case "removexattr.namespace":
+
+		if e.RemoveXAttr.Namespace, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Namespace"}
+		}
+		return nil
+
+	case "removexattr.overlay_numlower":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.OverlayNumLower"}
 		}
-		e.Link.Retval = int64(v)
+		e.RemoveXAttr.OverlayNumLower = int32(v)
 		return nil
 
-	case "link.source.basename":
+	case "removexattr.retval":
 
-		if e.Link.Source.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.BasenameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Retval"}
 		}
+		e.RemoveXAttr.Retval = int64(v)
 		return nil
 
-	case "link.source.container_path":
+	case "rename.new.basename":
 
-		if e.Link.Source.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.ContainerPath"}
+		if e.Rename.New.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.BasenameStr"}
 		}
 		return nil
 
-	case "link.source.filename":
+	case "rename.new.container_path":
 
-		if e.Link.Source.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.PathnameStr"}
+		if e.Rename.New.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.ContainerPath"}
 		}
 		return nil
 
-	case "link.source.inode":
+	case "rename.new.filename":
+
+		if e.Rename.New.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.PathnameStr"}
+		}
+		return nil
+
+	case "rename.new.inode":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.Inode"}
 		}
-		e.Link.Source.Inode = uint64(v)
+		e.Rename.New.Inode = uint64(v)
 		return nil
 
-	case "link.source.overlay_numlower":
+	case "rename.new.overlay_numlower":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.OverlayNumLower"}
 		}
-		e.Link.Source.OverlayNumLower = int32(v)
+		e.Rename.New.OverlayNumLower = int32(v)
 		return nil
 
-	case "link.target.basename":
+	case "rename.old.basename":
 
-		if e.Link.Target.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.BasenameStr"}
+		if e.Rename.Old.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.BasenameStr"}
 		}
 		return nil
 
-	case "link.target.container_path":
+	case "rename.old.container_path":
 
-		if e.Link.Target.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.ContainerPath"}
+		if e.Rename.Old.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.ContainerPath"}
 		}
 		return nil
 
-	case "link.target.filename":
+	case "rename.old.filename":
 
-		if e.Link.Target.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.PathnameStr"}
+		if e.Rename.Old.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.PathnameStr"}
 		}
 		return nil
 
-	case "link.target.inode":
+	case "rename.old.inode":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.Inode"}
 		}
-		e.Link.Target.Inode = uint64(v)
+		e.Rename.Old.Inode = uint64(v)
 		return nil
 
-	case "link.target.overlay_numlower":
+	case "rename.old.overlay_numlower":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.OverlayNumLower"}
 		}
-		e.Link.Target.OverlayNumLower = int32(v)
+		e.Rename.Old.OverlayNumLower = int32(v)
 		return nil
 
-	case "mkdir.basename":
+	case "rename.retval":
 
-		if e.Mkdir.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.BasenameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Retval"}
 		}
+		e.Rename.Retval = int64(v)
 		return nil
 
-	case "mkdir.container_path":
+	case "rmdir.basename":
 
-		if e.Mkdir.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.ContainerPath"}
+		if e.Rmdir.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.BasenameStr"}
 		}
 		return nil
 
-	case "mkdir.filename":
+	case "rmdir.container_path":
 
-		if e.Mkdir.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.PathnameStr"}
+		if e.Rmdir.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.ContainerPath"}
+		}
+		return nil
+
+	case "rmdir.filename":
+
+		if e.Rmdir.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.PathnameStr"}
+		}
+		return nil
+
+	case "rmdir.inode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.Inode"}
+		}
+		e.Rmdir.Inode = uint64(v)
+		return nil
+
+	case "rmdir.overlay_numlower":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.OverlayNumLower"}
 		}
+		e.Rmdir.OverlayNumLower = int32(v)
 		return nil
 
-	case "mkdir.inode":
+	case "rmdir.retval":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.Retval"}
 		}
-		e.Mkdir.Inode = uint64(v)
+		e.Rmdir.Retval = int64(v)
 		return nil
 
-	case "mkdir.mode":
+	case "setgid.new.egid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.New.EGID"}
 		}
-		e.Mkdir.Mode = int32(v)
+		e.SetGID.New.EGID = uint32(v)
 		return nil
 
-	case "mkdir.overlay_numlower":
+	case "setgid.new.euid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.New.EUID"}
 		}
-		e.Mkdir.OverlayNumLower = int32(v)
+		e.SetGID.New.EUID = uint32(v)
 		return nil
 
-	case "mkdir.retval":
+	case "setgid.new.fsgid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.New.FSGID"}
 		}
-		e.Mkdir.Retval = int64(v)
+		e.SetGID.New.FSGID = uint32(v)
 		return nil
 
-	case "open.basename":
+	case "setgid.new.fsuid":
 
-		if e.Open.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.BasenameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.New.FSUID"}
 		}
+		e.SetGID.New.FSUID = uint32(v)
 		return nil
 
-	case "open.container_path":
+	case "setgid.new.gid":
 
-		if e.Open.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.ContainerPath"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.New.GID"}
 		}
+		e.SetGID.New.GID = uint32(v)
 		return nil
 
-	case "open.filename":
+	case "setgid.new.uid":
 
-		if e.Open.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.PathnameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.New.UID"}
 		}
+		e.SetGID.New.UID = uint32(v)
 		return nil
 
-	case "open.flags":
+	case "setgid.old.egid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.Flags"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.Old.EGID"}
 		}
-		e.Open.Flags = uint32(v)
+		e.SetGID.Old.EGID = uint32(v)
 		return nil
 
-	case "open.inode":
+	case "setgid.old.euid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.Old.EUID"}
 		}
-		e.Open.Inode = uint64(v)
+		e.SetGID.Old.EUID = uint32(v)
 		return nil
 
-	case "open.mode":
+	case "setgid.old.fsgid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.Old.FSGID"}
 		}
-		e.Open.Mode = uint32(v)
+		e.SetGID.Old.FSGID = uint32(v)
 		return nil
 
-	case "open.overlay_numlower":
+	case "setgid.old.fsuid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.Old.FSUID"}
 		}
-		e.Open.OverlayNumLower = int32(v)
+		e.SetGID.Old.FSUID = uint32(v)
 		return nil
 
-	case "open.retval":
+	case "setgid.old.gid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.Old.GID"}
 		}
-		e.Open.Retval = int64(v)
+		e.SetGID.Old.GID = uint32(v)
 		return nil
 
-	case "process.basename":
+	case "setgid.old.uid":
 
-		if e.Process.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.BasenameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.Old.UID"}
 		}
+		e.SetGID.Old.UID = uint32(v)
 		return nil
 
-	case "process.container_path":
+	case "setgid.retval":
 
-		if e.Process.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.ContainerPath"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.Retval"}
 		}
+		e.SetGID.Retval = int64(v)
 		return nil
 
-	case "process.filename":
+	case "setns.fd":
 
-		if e.Process.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.PathnameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Setns.FD"}
 		}
+		e.Setns.FD = uint32(v)
 		return nil
 
-	case "process.gid":
+	case "setns.ns_inode":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "Setns.NSInode"}
 		}
-		e.Process.GID = uint32(v)
+		e.Setns.NSInode = uint64(v)
 		return nil
 
-	case "process.group":
+	case "setns.nstype":
 
-		if e.Process.Group, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.Group"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Setns.NSType"}
 		}
+		e.Setns.NSType = uint32(v)
 		return nil
 
-	case "process.inode":
+	case "setns.retval":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Setns.Retval"}
 		}
-		e.Process.Inode = uint64(v)
+		e.Setns.Retval = int64(v)
 		return nil
 
-	case "process.name":
+	case "setuid.new.egid":
 
-		if e.Process.Comm, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.Comm"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.New.EGID"}
 		}
+		e.SetUID.New.EGID = uint32(v)
 		return nil
 
-	case "process.overlay_numlower":
+	case "setuid.new.euid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.New.EUID"}
 		}
-		e.Process.OverlayNumLower = int32(v)
+		e.SetUID.New.EUID = uint32(v)
 		return nil
 
-	case "process.pid":
+	case "setuid.new.fsgid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.Pid"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.New.FSGID"}
 		}
-		e.Process.Pid = uint32(v)
+		e.SetUID.New.FSGID = uint32(v)
 		return nil
 
-	case "process.pidns":
+	case "setuid.new.fsuid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.Pidns"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.New.FSUID"}
 		}
-		e.Process.Pidns = uint64(v)
+		e.SetUID.New.FSUID = uint32(v)
 		return nil
 
-	case "process.tid":
+	case "setuid.new.gid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.Tid"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.New.GID"}
 		}
-		e.Process.Tid = uint32(v)
+		e.SetUID.New.GID = uint32(v)
 		return nil
 
-	case "process.tty_name":
+	case "setuid.new.uid":
 
-		if e.Process.TTYName, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.TTYName"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.New.UID"}
 		}
+		e.SetUID.New.UID = uint32(v)
 		return nil
 
-	case "process.uid":
+	case "setuid.old.egid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.Old.EGID"}
 		}
-		e.Process.UID = uint32(v)
+		e.SetUID.Old.EGID = uint32(v)
 		return nil
 
-	case "process.user":
+	case "setuid.old.euid":
 
-		if e.Process.User, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Process.User"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.Old.EUID"}
 		}
+		e.SetUID.Old.EUID = uint32(v)
 		return nil
 
-	case "removexattr.basename":
+	case "setuid.old.fsgid":
 
-		if e.RemoveXAttr.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.BasenameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.Old.FSGID"}
 		}
+		e.SetUID.Old.FSGID = uint32(v)
 		return nil
 
-	case "removexattr.container_path":
+	case "setuid.old.fsuid":
 
-		if e.RemoveXAttr.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.ContainerPath"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.Old.FSUID"}
 		}
+		e.SetUID.Old.FSUID = uint32(v)
 		return nil
 
-	case "removexattr.filename":
+	case "setuid.old.gid":
 
-		if e.RemoveXAttr.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.PathnameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.Old.GID"}
 		}
+		e.SetUID.Old.GID = uint32(v)
 		return nil
 
-	case "removexattr.inode":
+	case "setuid.old.uid":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.Old.UID"}
 		}
-		e.RemoveXAttr.Inode = uint64(v)
+		e.SetUID.Old.UID = uint32(v)
 		return nil
 
-	case "removexattr.name":
+	case "setuid.retval":
 
-		if e.RemoveXAttr.Name, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Name"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.Retval"}
 		}
+		e.SetUID.Retval = int64(v)
 		return nil
 
-	case "removexattr.namespace":
+	case "setxattr.basename":
 
-		if e.RemoveXAttr.Namespace, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Namespace"}
+		if e.SetXAttr.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.BasenameStr"}
 		}
 		return nil
 
-	case "removexattr.overlay_numlower":
+	case "setxattr.container_path":
 
-		v, ok := value.(int)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.OverlayNumLower"}
+		if e.SetXAttr.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.ContainerPath"}
 		}
-		e.RemoveXAttr.OverlayNumLower = int32(v)
 		return nil
 
-	case "removexattr.retval":
+	case "setxattr.filename":
 
-		v, ok := value.(int)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Retval"}
+		if e.SetXAttr.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.PathnameStr"}
 		}
-		e.RemoveXAttr.Retval = int64(v)
 		return nil
 
-	case "rename.new.basename":
+	case "setxattr.inode":
 
-		if e.Rename.New.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.BasenameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Inode"}
 		}
+		e.SetXAttr.Inode = uint64(v)
 		return nil
 
-	case "rename.new.container_path":
+	case "setxattr.name":
 
-		if e.Rename.New.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.ContainerPath"}
+		if e.SetXAttr.Name, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Name"}
 		}
 		return nil
 
-	case "rename.new.filename":
+	case "setxattr.namespace":
 
-		if e.Rename.New.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.PathnameStr"}
+		if e.SetXAttr.Namespace, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Namespace"}
 		}
 		return nil
 
-	case "rename.new.inode":
+	case "setxattr.overlay_numlower":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.OverlayNumLower"}
 		}
-		e.Rename.New.Inode = uint64(v)
+		e.SetXAttr.OverlayNumLower = int32(v)
 		return nil
 
-	case "rename.new.overlay_numlower":
+	case "setxattr.retval":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Retval"}
 		}
-		e.Rename.New.OverlayNumLower = int32(v)
+		e.SetXAttr.Retval = int64(v)
 		return nil
 
-	case "rename.old.basename":
+	case "symlink.linkpath.basename":
 
-		if e.Rename.Old.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.BasenameStr"}
+		if e.Symlink.Link.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Symlink.Link.BasenameStr"}
 		}
 		return nil
 
-	case "rename.old.container_path":
+	case "symlink.linkpath.container_path":
 
-		if e.Rename.Old.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.ContainerPath"}
+		if e.Symlink.Link.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Symlink.Link.ContainerPath"}
 		}
 		return nil
 
-	case "rename.old.filename":
+	case "symlink.linkpath.filename":
 
-		if e.Rename.Old.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.PathnameStr"}
+		if e.Symlink.Link.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Symlink.Link.PathnameStr"}
 		}
 		return nil
 
-	case "rename.old.inode":
+	case "symlink.linkpath.inode":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Symlink.Link.Inode"}
 		}
-		e.Rename.Old.Inode = uint64(v)
+		e.Symlink.Link.Inode = uint64(v)
 		return nil
 
-	case "rename.old.overlay_numlower":
+	case "symlink.linkpath.overlay_numlower":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "Symlink.Link.OverlayNumLower"}
 		}
-		e.Rename.Old.OverlayNumLower = int32(v)
+		e.Symlink.Link.OverlayNumLower = int32(v)
 		return nil
 
-	case "rename.retval":
+	case "symlink.retval":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "Symlink.Retval"}
 		}
-		e.Rename.Retval = int64(v)
+		e.Symlink.Retval = int64(v)
 		return nil
 
-	case "rmdir.basename":
+	case "symlink.target":
 
-		if e.Rmdir.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.BasenameStr"}
+		if e.Symlink.Target, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Symlink.Target"}
 		}
 		return nil
 
-	case "rmdir.container_path":
+	case "truncate.basename":
 
-		if e.Rmdir.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.ContainerPath"}
+		if e.Truncate.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Truncate.BasenameStr"}
 		}
 		return nil
 
-	case "rmdir.filename":
+	case "truncate.container_path":
 
-		if e.Rmdir.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.PathnameStr"}
+		if e.Truncate.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Truncate.ContainerPath"}
 		}
 		return nil
 
-	case "rmdir.inode":
+	case "truncate.filename":
 
-		v, ok := value.(int)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.Inode"}
+		if e.Truncate.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Truncate.PathnameStr"}
 		}
-		e.Rmdir.Inode = uint64(v)
 		return nil
 
-	case "rmdir.overlay_numlower":
+	case "truncate.inode":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "Truncate.Inode"}
 		}
-		e.Rmdir.OverlayNumLower = int32(v)
+		e.Truncate.Inode = uint64(v)
 		return nil
 
-	case "rmdir.retval":
+	case "truncate.length":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.Retval"}
-		}
-		e.Rmdir.Retval = int64(v)
-		return nil
-
-	case "setxattr.basename":
-
-		if e.SetXAttr.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.BasenameStr"}
-		}
-		return nil
-
-	case "setxattr.container_path":
-
-		if e.SetXAttr.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.ContainerPath"}
-		}
-		return nil
-
-	case "setxattr.filename":
-
-		if e.SetXAttr.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Truncate.Length"}
 		}
+		e.Truncate.Length = uint64(v)
 		return nil
 
-	case "setxattr.inode":
+	case "truncate.overlay_numlower":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Truncate.OverlayNumLower"}
 		}
-		e.SetXAttr.Inode = uint64(v)
+		e.Truncate.OverlayNumLower = int32(v)
 		return nil
 
-	case "setxattr.name":
+	case "truncate.retval":
 
-		if e.SetXAttr.Name, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Name"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Truncate.Retval"}
 		}
+		e.Truncate.Retval = int64(v)
 		return nil
 
-	case "setxattr.namespace":
+	case "truncate.wipe":
 
-		if e.SetXAttr.Namespace, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Namespace"}
+		if e.Truncate.Wipe, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Truncate.Wipe"}
 		}
 		return nil
 
-	case "setxattr.overlay_numlower":
+	case "umount.retval":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "Umount.Retval"}
 		}
-		e.SetXAttr.OverlayNumLower = int32(v)
+		e.Umount.Retval = int64(v)
 		return nil
 
-	case "setxattr.retval":
+	case "umount.target":
 
-		v, ok := value.(int)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Retval"}
+		if e.Umount.TargetStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Umount.TargetStr"}
 		}
-		e.SetXAttr.Retval = int64(v)
 		return nil
 
 	case "unlink.basename":
@@ -9,7 +9,10 @@ package probe
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/json"
+	"net"
+	"syscall"
 	"testing"
 )
 
@@ -50,3 +53,108 @@ func TestMkdirJSON(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestExitUnmarshalBinary(t *testing.T) {
+	tests := []struct {
+		status uint32
+		cause  ExitCause
+		code   uint32
+	}{
+		{status: 0, cause: ExitExited, code: 0},
+		{status: 3 << 8, cause: ExitExited, code: 3},
+		{status: 9, cause: ExitSignaled, code: 9},
+		{status: 0x80 | 11, cause: ExitCoreDumped, code: 11},
+	}
+
+	for _, test := range tests {
+		data := make([]byte, 24)
+		byteOrder.PutUint32(data[16:20], test.status)
+
+		var e ExitEvent
+		if _, err := e.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+
+		if ExitCause(e.Cause) != test.cause || e.Code != test.code {
+			t.Errorf("expected %s with code %d for status %#x, got %s with code %d", test.cause, test.code, test.status, ExitCause(e.Cause), e.Code)
+		}
+	}
+}
+
+func TestInitModuleUnmarshalBinary(t *testing.T) {
+	data := make([]byte, 88)
+	byteOrder.PutUint64(data[16:24], 42)
+	copy(data[32:], "dummy_module")
+
+	var e InitModuleEvent
+	n, err := e.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != len(data) {
+		t.Errorf("expected %d bytes to be read, got %d", len(data), n)
+	}
+
+	if e.Inode != 42 || e.Name != "dummy_module" {
+		t.Errorf("unexpected init_module event: inode %d, name %s", e.Inode, e.Name)
+	}
+}
+
+func TestProcessUnmarshalBinaryNamespaces(t *testing.T) {
+	data := make([]byte, 152)
+	byteOrder.PutUint64(data[0:8], 4026531836)
+	byteOrder.PutUint32(data[144:148], 4026531840)
+	byteOrder.PutUint32(data[148:152], 4026531992)
+
+	var p ProcessEvent
+	n, err := p.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != len(data) {
+		t.Errorf("expected %d bytes to be read, got %d", len(data), n)
+	}
+
+	if p.Pidns != 4026531836 || p.Mntns != 4026531840 || p.Netns != 4026531992 {
+		t.Errorf("unexpected namespaces: pidns %d, mntns %d, netns %d", p.Pidns, p.Mntns, p.Netns)
+	}
+
+	if _, err := p.UnmarshalBinary(data[:144]); err != ErrNotEnoughData {
+		t.Errorf("expected ErrNotEnoughData for a truncated process context, got %v", err)
+	}
+}
+
+func TestSocketUnmarshalBinary(t *testing.T) {
+	data := make([]byte, 56)
+	byteOrder.PutUint16(data[16:18], syscall.AF_INET)
+	byteOrder.PutUint16(data[18:20], syscall.SOCK_STREAM)
+	byteOrder.PutUint16(data[20:22], 34567)
+	binary.BigEndian.PutUint16(data[22:24], 443)
+	copy(data[24:28], net.IPv4(10, 0, 0, 1).To4())
+	copy(data[40:44], net.IPv4(192, 168, 0, 1).To4())
+
+	var e SocketEvent
+	if _, err := e.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if e.Source.IP != "10.0.0.1" || e.Source.Port != 34567 {
+		t.Errorf("unexpected source %s:%d", e.Source.IP, e.Source.Port)
+	}
+
+	if e.Destination.IP != "192.168.0.1" || e.Destination.Port != 443 {
+		t.Errorf("unexpected destination %s:%d", e.Destination.IP, e.Destination.Port)
+	}
+
+	byteOrder.PutUint16(data[16:18], syscall.AF_INET6)
+	copy(data[24:40], net.IPv6loopback)
+	if _, err := e.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if e.Source.IP != "::1" {
+		t.Errorf("expected ::1 source, got %s", e.Source.IP)
+	}
+}
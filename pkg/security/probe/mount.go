@@ -46,7 +46,7 @@ var mountHookPoints = []*HookPoint{
 	{
 		Name:       "sys_mount",
 		KProbes:    syscallKprobe("mount", true),
-		EventTypes: []eval.EventType{"*"},
+		EventTypes: []eval.EventType{"*", "mount"},
 	},
 	{
 		Name: "security_sb_umount",
@@ -58,7 +58,7 @@ var mountHookPoints = []*HookPoint{
 	{
 		Name:       "sys_umount",
 		KProbes:    syscallKprobe("umount"),
-		EventTypes: []eval.EventType{"*"},
+		EventTypes: []eval.EventType{"*", "umount"},
 	},
 }
 
@@ -92,6 +92,7 @@ func newMountEventFromMountInfo(mnt *mountinfo.Info) (*MountEvent, error) {
 		NewGroupID:    uint32(groupID),
 		NewDevice:     uint32(unix.Mkdev(uint32(mnt.Major), uint32(mnt.Minor))),
 		FSType:        mnt.Fstype,
+		SourceStr:     mnt.Source,
 	}, nil
 }
 
@@ -0,0 +1,34 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"expvar"
+
+	"github.com/DataDog/datadog-agent/pkg/telemetry"
+)
+
+// The kernel reports lost events per perf buffer, without their type nor the hook point that produced them,
+// so they can only be accounted to a perf map.
+var (
+	perfMapsExpvars   = expvar.NewMap("runtime_security_perf_maps")
+	lostEventsExpvars = new(expvar.Map).Init()
+
+	tlmLostEvents = telemetry.NewCounter("runtime_security", "lost_events",
+		[]string{"perf_map"}, "Number of events lost because a perf buffer was full")
+)
+
+func init() {
+	perfMapsExpvars.Set("lost", lostEventsExpvars)
+}
+
+// countLostEvents increments the lost events counters of the given perf map
+func countLostEvents(perfMap string, count uint64) {
+	lostEventsExpvars.Add(perfMap, int64(count))
+	tlmLostEvents.Add(float64(count), perfMap)
+}
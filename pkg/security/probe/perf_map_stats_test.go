@@ -0,0 +1,24 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"testing"
+)
+
+func TestCountLostEvents(t *testing.T) {
+	lostEventsExpvars.Init()
+	defer lostEventsExpvars.Init()
+
+	countLostEvents("test_perf_map", 2)
+	countLostEvents("test_perf_map", 3)
+
+	if value := lostEventsExpvars.Get("test_perf_map"); value == nil || value.String() != "5" {
+		t.Errorf("expected 5 lost events, got %v", value)
+	}
+}
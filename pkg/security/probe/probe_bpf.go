@@ -10,6 +10,7 @@ package probe
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/DataDog/datadog-go/statsd"
 
@@ -59,6 +60,9 @@ type Probe struct {
 	kernelVersion    uint32
 	_                uint32 // padding for goarch=386
 	eventsStats      EventsStats
+
+	hookPointsLock     sync.RWMutex
+	disabledHookPoints map[string]bool
 }
 
 func (p *Probe) getTableNames() []string {
@@ -74,6 +78,7 @@ func (p *Probe) getTableNames() []string {
 	tables = append(tables, execTables...)
 	tables = append(tables, unlinkTables...)
 	tables = append(tables, mountTables...)
+	tables = append(tables, bpfTables...)
 
 	return tables
 }
@@ -90,14 +95,18 @@ func (p *Probe) Table(name string) *ebpf.Table {
 func (p *Probe) getPerfMaps() []*ebpf.PerfMapDefinition {
 	return []*ebpf.PerfMapDefinition{
 		{
-			Name:        "events",
-			Handler:     p.handleEvent,
-			LostHandler: p.handleLostEvents,
+			Name:    "events",
+			Handler: p.handleEvent,
+			LostHandler: func(count uint64) {
+				p.handleLostEvents("events", count)
+			},
 		},
 		{
-			Name:        "mountpoints_events",
-			Handler:     p.handleEvent,
-			LostHandler: p.handleLostEvents,
+			Name:    "mountpoints_events",
+			Handler: p.handleEvent,
+			LostHandler: func(count uint64) {
+				p.handleLostEvents("mountpoints_events", count)
+			},
 		},
 	}
 }
@@ -138,6 +147,18 @@ func (p *Probe) Start() error {
 		return err
 	}
 
+	if err := p.setExecEnvAllowlist(); err != nil {
+		return err
+	}
+
+	if err := p.setMntNsInumOffset(); err != nil {
+		return err
+	}
+
+	if err := p.setAgentPid(); err != nil {
+		return err
+	}
+
 	if p.config.SyscallMonitor {
 		p.syscallMonitor, err = NewSyscallMonitor(
 			p.Module,
@@ -247,9 +268,10 @@ func (p *Probe) GetEventsStats() EventsStats {
 	return p.eventsStats
 }
 
-func (p *Probe) handleLostEvents(count uint64) {
+func (p *Probe) handleLostEvents(perfMap string, count uint64) {
 	log.Warnf("lost %d events\n", count)
 	p.eventsStats.CountLost(int64(count))
+	countLostEvents(perfMap, count)
 }
 
 func (p *Probe) handleEvent(data []byte) {
@@ -312,6 +334,16 @@ func (p *Probe) handleEvent(data []byte) {
 			log.Errorf("failed to decode link event: %s (offset %d, len %d)", err, offset, len(data))
 			return
 		}
+	case FileTruncateEventType:
+		if _, err := event.Truncate.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode truncate event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileSymlinkEventType:
+		if _, err := event.Symlink.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode symlink event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
 	case FileMountEventType:
 		if _, err := event.Mount.UnmarshalBinary(data[offset:]); err != nil {
 			log.Errorf("failed to decode mount event: %s (offset %d, len %d)", err, offset, len(data))
@@ -328,6 +360,8 @@ func (p *Probe) handleEvent(data []byte) {
 			log.Errorf("failed to decode umount event: %s (offset %d, len %d)", err, offset, len(data))
 			return
 		}
+		// Resolve the mount point before it is removed from the cache
+		event.Umount.ResolveTarget(p.resolvers)
 		// Delete new mount point from cache
 		if err := p.resolvers.MountResolver.Delete(event.Umount.MountID); err != nil {
 			log.Errorf("failed to delete mount point %d from cache: %s", event.Umount.MountID, err)
@@ -342,12 +376,103 @@ func (p *Probe) handleEvent(data []byte) {
 			log.Errorf("failed to decode removexattr event: %s (offset %d, len %d)", err, offset, len(data))
 			return
 		}
+	case SetuidEventType:
+		if _, err := event.SetUID.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode setuid event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case SetgidEventType:
+		if _, err := event.SetGID.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode setgid event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case PtraceEventType:
+		if _, err := event.Ptrace.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode ptrace event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case ForkEventType:
+		if _, err := event.Fork.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode fork event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case ExitEventType:
+		if _, err := event.Exit.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode exit event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case CommitCredsEventType:
+		if _, err := event.CommitCreds.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode commit_creds event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case InitModuleEventType:
+		if _, err := event.InitModule.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode init_module event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case ConnectEventType:
+		if _, err := event.Connect.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode connect event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case BindEventType:
+		if _, err := event.Bind.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode bind event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case SetnsEventType:
+		if _, err := event.Setns.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode setns event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case BPFEventType:
+		if _, err := event.BPF.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode bpf event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case ChrootEventType:
+		if _, err := event.Chroot.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode chroot event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case PivotRootEventType:
+		if _, err := event.PivotRoot.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode pivot_root event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case KillEventType:
+		if _, err := event.Kill.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode kill event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case MemfdCreateEventType:
+		if _, err := event.MemfdCreate.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode memfd_create event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case CapsetEventType:
+		if _, err := event.Capset.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode capset event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case IOUringEventType:
+		if _, err := event.IOUring.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode io_uring event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case PrctlEventType:
+		if _, err := event.Prctl.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode prctl event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
 	default:
 		log.Errorf("unsupported event type %d", eventType)
 		return
 	}
 
 	p.eventsStats.CountEventType(eventType, 1)
+	countEventTypeEvent(eventType.String())
 
 	log.Tracef("Dispatching event %+v\n", event)
 	p.DispatchEvent(event)
@@ -448,6 +573,106 @@ func (p *Probe) RegisterTracepoint(tracepoint string) error {
 	return err
 }
 
+// EnableHookPoint attaches again the kprobes of a disabled hook point. The policies and the approvers of its event
+// types are set up by the rule set applier first, as they may not have been applied if the hook point was disabled
+// at startup. A hook point whose event types aren't used by the rule set is only marked as enabled.
+func (p *Probe) EnableHookPoint(name string, rs *rules.RuleSet, rsa *RuleSetApplier) error {
+	hookPoint, err := p.getToggleableHookPoint(name)
+	if err != nil {
+		return err
+	}
+
+	p.hookPointsLock.Lock()
+	defer p.hookPointsLock.Unlock()
+
+	if !p.disabledHookPoints[name] {
+		return nil
+	}
+
+	if !rsa.IsHookPointNeeded(rs, hookPoint) {
+		delete(p.disabledHookPoints, name)
+		log.Infof("hook point `%s` enabled, not attached as no rule requires it", name)
+		return nil
+	}
+
+	attached, err := rsa.EnableHookPoint(rs, hookPoint, p)
+	if err != nil {
+		return err
+	}
+
+	if !attached {
+		return fmt.Errorf("failed to enable hook point `%s`", name)
+	}
+
+	delete(p.disabledHookPoints, name)
+	log.Infof("hook point `%s` enabled", name)
+
+	return nil
+}
+
+// DisableHookPoint detaches the kprobes of a hook point and reports it as disabled. The hook point can be enabled
+// again with EnableHookPoint.
+func (p *Probe) DisableHookPoint(name string, rsa *RuleSetApplier) error {
+	hookPoint, err := p.getToggleableHookPoint(name)
+	if err != nil {
+		return err
+	}
+
+	p.hookPointsLock.Lock()
+	defer p.hookPointsLock.Unlock()
+
+	if p.disabledHookPoints[name] {
+		return nil
+	}
+
+	for _, kprobe := range hookPoint.KProbes {
+		// some of the kprobes may not have been attached, the errors are ignored
+		if err := p.Module.UnregisterKprobe(kprobe); err != nil {
+			log.Debugf("failed to unregister kProbe `%s`: %s", kprobe.Name, err)
+		}
+	}
+
+	p.disabledHookPoints[name] = true
+	rsa.DisableHookPoint(hookPoint)
+	log.Infof("hook point `%s` disabled", name)
+
+	return nil
+}
+
+// IsHookPointDisabled returns whether the given hook point is disabled
+func (p *Probe) IsHookPointDisabled(name string) bool {
+	p.hookPointsLock.RLock()
+	defer p.hookPointsLock.RUnlock()
+
+	return p.disabledHookPoints[name]
+}
+
+// GetDisabledHookPoints returns the names of the disabled hook points
+func (p *Probe) GetDisabledHookPoints() []string {
+	p.hookPointsLock.RLock()
+	defer p.hookPointsLock.RUnlock()
+
+	var names []string
+	for name := range p.disabledHookPoints {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (p *Probe) getToggleableHookPoint(name string) (*HookPoint, error) {
+	hookPoint := getHookPoint(name)
+	if hookPoint == nil {
+		return nil, fmt.Errorf("unknown hook point `%s`", name)
+	}
+
+	// tracepoints can't be detached, they can only be disabled at startup with disabled_hook_points
+	if len(hookPoint.Tracepoint) > 0 {
+		return nil, fmt.Errorf("hook point `%s` is a tracepoint and can't be toggled at runtime, use runtime_security_config.disabled_hook_points instead", name)
+	}
+
+	return hookPoint, nil
+}
+
 // Snapshot runs the different snapshot functions of the resolvers that
 // require to sync with the current state of the system
 func (p *Probe) Snapshot() error {
@@ -457,9 +682,16 @@ func (p *Probe) Snapshot() error {
 // NewProbe instantiates a new runtime security agent probe
 func NewProbe(config *config.Config) (*Probe, error) {
 	p := &Probe{
-		config:           config,
-		onDiscardersFncs: make(map[eval.EventType][]onDiscarderFnc),
-		tables:           make(map[string]*ebpf.Table),
+		config:             config,
+		onDiscardersFncs:   make(map[eval.EventType][]onDiscarderFnc),
+		tables:             make(map[string]*ebpf.Table),
+		disabledHookPoints: make(map[string]bool),
+	}
+
+	for _, name := range config.DisabledHookPoints {
+		if getHookPoint(name) != nil {
+			p.disabledHookPoints[name] = true
+		}
 	}
 
 	p.Probe = &ebpf.Probe{
@@ -45,6 +45,11 @@ func (p *Probe) RegisterTracepoint(tracepoint string) error {
 	return nil
 }
 
+// IsHookPointDisabled returns whether the given hook point is disabled
+func (p *Probe) IsHookPointDisabled(name string) bool {
+	return false
+}
+
 // NewProbe instantiates a new runtime security agent probe
 func NewProbe(config *config.Config) (*Probe, error) {
 	p := &Probe{}
@@ -19,15 +19,27 @@ type PolicyReport struct {
 	Approvers rules.Approvers
 }
 
+// HookPointReport describes the registration status of a hook point
+type HookPointReport struct {
+	Optional   bool
+	Disabled   bool
+	Attached   bool
+	EventTypes []eval.EventType
+	Errors     []string `json:",omitempty"`
+}
+
 // Report describes the event types and their associated policy reports
 type Report struct {
-	Policies map[string]*PolicyReport
+	Policies   map[string]*PolicyReport
+	HookPoints map[string]*HookPointReport
+	Error      string `json:",omitempty"`
 }
 
 // NewReport returns a new report
 func NewReport() *Report {
 	return &Report{
-		Policies: make(map[string]*PolicyReport),
+		Policies:   make(map[string]*PolicyReport),
+		HookPoints: make(map[string]*HookPointReport),
 	}
 }
 
@@ -58,6 +70,34 @@ func (r *Reporter) SetApprovers(eventType eval.EventType, approvers rules.Approv
 	return nil
 }
 
+// SetHookPointStatus is called once the kprobes and tracepoint of a hook point were registered. A hook point
+// is considered attached when its tracepoint and at least one of its kprobes were registered.
+func (r *Reporter) SetHookPointStatus(hookPoint *HookPoint, attached bool, errs []error) {
+	hookPointReport := &HookPointReport{
+		Optional:   hookPoint.Optional,
+		Attached:   attached,
+		EventTypes: hookPoint.EventTypes,
+	}
+	for _, err := range errs {
+		hookPointReport.Errors = append(hookPointReport.Errors, err.Error())
+	}
+	r.report.HookPoints[hookPoint.Name] = hookPointReport
+}
+
+// SetHookPointDisabled is called when a hook point isn't registered because it was disabled
+func (r *Reporter) SetHookPointDisabled(hookPoint *HookPoint) {
+	r.report.HookPoints[hookPoint.Name] = &HookPointReport{
+		Optional:   hookPoint.Optional,
+		Disabled:   true,
+		EventTypes: hookPoint.EventTypes,
+	}
+}
+
+// SetError is called when the rule set couldn't be applied
+func (r *Reporter) SetError(err error) {
+	r.report.Error = err.Error()
+}
+
 // GetReport returns the report
 func (r *Reporter) GetReport() *Report {
 	return r.report
@@ -18,9 +18,10 @@ func NewResolvers(probe *Probe) (*Resolvers, error) {
 		return nil, err
 	}
 	return &Resolvers{
-		probe:          probe,
-		DentryResolver: dentryResolver,
-		MountResolver:  NewMountResolver(probe),
-		TimeResolver:   timeResolver,
+		probe:             probe,
+		DentryResolver:    dentryResolver,
+		MountResolver:     NewMountResolver(probe),
+		ContainerResolver: &ContainerResolver{},
+		TimeResolver:      timeResolver,
 	}, nil
 }
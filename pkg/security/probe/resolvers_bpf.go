@@ -8,6 +8,7 @@
 package probe
 
 import (
+	"bytes"
 	"os"
 	"syscall"
 
@@ -42,10 +43,12 @@ type ProcCache struct {
 
 // Bytes returns the bytes representation of process cache entry
 func (pc ProcCache) Bytes() []byte {
-	b := make([]byte, 16+utils.ContainerIDLen)
+	// the control group directory of the process, after the container ID, can't be retrieved from procfs either
+	b := make([]byte, 32+utils.ContainerIDLen+16)
 	byteOrder.PutUint64(b[0:8], pc.Inode)
 	byteOrder.PutUint32(b[8:12], pc.Numlower)
-	copy(b[16:16+utils.ContainerIDLen], pc.ID[:])
+	// the script of the process, at 16:32, can't be retrieved from procfs
+	copy(b[32:32+utils.ContainerIDLen], pc.ID[:])
 	return b
 }
 
@@ -55,6 +58,8 @@ type Resolvers struct {
 	inodeNumlowerMap *ebpf.Table
 	procCacheMap     *ebpf.Table
 	pidCookieMap     *ebpf.Table
+	execArgsMap      *ebpf.Table
+	execMemfdMap     *ebpf.Table
 
 	DentryResolver    *DentryResolver
 	MountResolver     *MountResolver
@@ -76,6 +81,18 @@ func (r *Resolvers) Start() error {
 		return errors.New("pid_cookie BPF_HASH table doesn't exist")
 	}
 
+	// Select the in-kernel cookie <-> exec arguments cache
+	r.execArgsMap = r.probe.Table("exec_args")
+	if r.execArgsMap == nil {
+		return errors.New("exec_args BPF_HASH table doesn't exist")
+	}
+
+	// Select the in-kernel cookie <-> memfd_create file cache
+	r.execMemfdMap = r.probe.Table("exec_memfd")
+	if r.execMemfdMap == nil {
+		return errors.New("exec_memfd BPF_HASH table doesn't exist")
+	}
+
 	if err := r.MountResolver.Start(); err != nil {
 		return err
 	}
@@ -83,6 +100,62 @@ func (r *Resolvers) Start() error {
 	return r.DentryResolver.Start()
 }
 
+// getExecArgs returns the raw arguments and environment variables captured when the process
+// cache entry identified by the provided cookie was created
+func (r *Resolvers) getExecArgs(cookie uint32) []byte {
+	cookieb := make([]byte, 4)
+	byteOrder.PutUint32(cookieb, cookie)
+
+	data, err := r.execArgsMap.Get(cookieb)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// parseExecStrings parses a list of NUL terminated strings stored in fixed size buffers, preceded by
+// their count and a truncation flag
+func parseExecStrings(data []byte, max, size int) ([]string, bool) {
+	if len(data) < 8 {
+		return nil, false
+	}
+
+	count := int(byteOrder.Uint32(data[0:4]))
+	truncated := byteOrder.Uint32(data[4:8]) != 0
+
+	var values []string
+	for i := 0; i < count && i < max; i++ {
+		offset := 8 + i*size
+		if offset+size > len(data) {
+			break
+		}
+
+		value := data[offset : offset+size]
+		if n := bytes.IndexByte(value, 0); n >= 0 {
+			value = value[:n]
+		}
+		values = append(values, string(value))
+	}
+
+	return values, truncated
+}
+
+// ResolveProcessArgs returns the arguments captured when the process cache entry identified by
+// the provided cookie was created, and whether they were truncated
+func (r *Resolvers) ResolveProcessArgs(cookie uint32) ([]string, bool) {
+	return parseExecStrings(r.getExecArgs(cookie), maxExecArgs, maxExecArgLen)
+}
+
+// ResolveProcessEnvs returns the allowlisted environment variables captured when the process cache
+// entry identified by the provided cookie was created, and whether they were truncated
+func (r *Resolvers) ResolveProcessEnvs(cookie uint32) ([]string, bool) {
+	data := r.getExecArgs(cookie)
+	if len(data) < execEnvsOffset {
+		return nil, false
+	}
+	return parseExecStrings(data[execEnvsOffset:], maxExecEnvs, maxExecEnvLen)
+}
+
 // Snapshot collects data on the current state of the system to populate user space and kernel space caches.
 func (r *Resolvers) Snapshot(retry int) error {
 	// Register snapshot tables
@@ -219,3 +292,19 @@ func (r *Resolvers) snapshotProcess(pid uint32) bool {
 	}
 	return true
 }
+
+// ResolveProcessMemfd returns the name given to memfd_create for the file the process cache entry identified by the
+// provided cookie was executed from, and whether it was executed from such a file
+func (r *Resolvers) ResolveProcessMemfd(cookie uint32) (string, bool) {
+	cookieb := make([]byte, 4)
+	byteOrder.PutUint32(cookieb, cookie)
+
+	data, err := r.execMemfdMap.Get(cookieb)
+	if err != nil {
+		return "", false
+	}
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		data = data[:i]
+	}
+	return string(data), true
+}
@@ -15,3 +15,21 @@ type Resolvers struct {
 	ContainerResolver *ContainerResolver
 	TimeResolver      *TimeResolver
 }
+
+// ResolveProcessArgs returns the arguments captured when the process cache entry identified by
+// the provided cookie was created, and whether they were truncated
+func (r *Resolvers) ResolveProcessArgs(cookie uint32) ([]string, bool) {
+	return nil, false
+}
+
+// ResolveProcessEnvs returns the allowlisted environment variables captured when the process cache
+// entry identified by the provided cookie was created, and whether they were truncated
+func (r *Resolvers) ResolveProcessEnvs(cookie uint32) ([]string, bool) {
+	return nil, false
+}
+
+// ResolveProcessMemfd returns the name given to memfd_create for the file the process cache entry identified by the
+// provided cookie was executed from, and whether it was executed from such a file
+func (r *Resolvers) ResolveProcessMemfd(cookie uint32) (string, bool) {
+	return "", false
+}
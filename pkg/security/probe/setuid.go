@@ -0,0 +1,77 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// setuidHookPoints holds the list of hookpoints to track process credentials changes
+var setuidHookPoints = []*HookPoint{
+	{
+		Name:       "sys_setuid",
+		KProbes:    syscallKprobe("setuid"),
+		EventTypes: []eval.EventType{"setuid"},
+	},
+	{
+		Name:       "sys_setreuid",
+		KProbes:    syscallKprobe("setreuid"),
+		EventTypes: []eval.EventType{"setuid"},
+		Optional:   true,
+	},
+	{
+		Name:       "sys_setresuid",
+		KProbes:    syscallKprobe("setresuid"),
+		EventTypes: []eval.EventType{"setuid"},
+		Optional:   true,
+	},
+	{
+		Name:       "sys_setfsuid",
+		KProbes:    syscallKprobe("setfsuid"),
+		EventTypes: []eval.EventType{"setuid"},
+		Optional:   true,
+	},
+	{
+		Name:       "sys_setgid",
+		KProbes:    syscallKprobe("setgid"),
+		EventTypes: []eval.EventType{"setgid"},
+	},
+	{
+		Name:       "sys_setregid",
+		KProbes:    syscallKprobe("setregid"),
+		EventTypes: []eval.EventType{"setgid"},
+		Optional:   true,
+	},
+	{
+		Name:       "sys_setresgid",
+		KProbes:    syscallKprobe("setresgid"),
+		EventTypes: []eval.EventType{"setgid"},
+		Optional:   true,
+	},
+	{
+		Name:       "sys_setfsgid",
+		KProbes:    syscallKprobe("setfsgid"),
+		EventTypes: []eval.EventType{"setgid"},
+		Optional:   true,
+	},
+	{
+		Name:       "sys_capset",
+		KProbes:    syscallKprobe("capset"),
+		EventTypes: []eval.EventType{"capset"},
+	},
+	{
+		// commit_creds is the common path of every credential change, including the ones not caused by the
+		// setuid family, such as capset or the execution of a setuid binary
+		Name: "commit_creds",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/commit_creds",
+		}},
+		EventTypes: []eval.EventType{"commit_creds"},
+	},
+}
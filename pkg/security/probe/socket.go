@@ -0,0 +1,41 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// socketHookPoints holds the list of hookpoints to track the connections and the bindings of internet sockets
+var socketHookPoints = []*HookPoint{
+	{
+		Name:       "sys_connect",
+		KProbes:    syscallKprobe("connect"),
+		EventTypes: []eval.EventType{"connect"},
+	},
+	{
+		Name: "security_socket_connect",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/security_socket_connect",
+		}},
+		EventTypes: []eval.EventType{"connect"},
+	},
+	{
+		Name:       "sys_bind",
+		KProbes:    syscallKprobe("bind"),
+		EventTypes: []eval.EventType{"bind"},
+	},
+	{
+		Name: "security_socket_bind",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/security_socket_bind",
+		}},
+		EventTypes: []eval.EventType{"bind"},
+	},
+}
@@ -334,13 +334,27 @@ func (s Syscall) MarshalText() ([]byte, error) {
 var syscallPrefix string
 var ia32SyscallPrefix string
 
+// syscallPrefixCandidates holds the syscalls used to detect the syscall prefix, the first one found
+// in the kernel symbols is used. Some architectures, such as arm64, don't provide the open syscall.
+var syscallPrefixCandidates = []string{"open", "openat"}
+
+func resolveSyscallPrefix() (prefix string, err error) {
+	for _, candidate := range syscallPrefixCandidates {
+		var syscall string
+		if syscall, err = ebpf.GetSyscallFnName(candidate); err == nil {
+			return strings.TrimSuffix(syscall, candidate), nil
+		}
+	}
+	return "", err
+}
+
 func getSyscallFnName(name string) string {
 	if syscallPrefix == "" {
-		syscall, err := ebpf.GetSyscallFnName("open")
+		prefix, err := resolveSyscallPrefix()
 		if err != nil {
 			panic(err)
 		}
-		syscallPrefix = strings.TrimSuffix(syscall, "open")
+		syscallPrefix = prefix
 		if syscallPrefix != "SyS_" {
 			ia32SyscallPrefix = "__ia32_"
 		} else {
@@ -22,9 +22,11 @@ var UnlinkHookPoints = []*HookPoint{
 		EventTypes: []eval.EventType{"unlink"},
 	},
 	{
+		// unlink is not available on architectures only exposing unlinkat, such as arm64
 		Name:       "sys_unlink",
 		KProbes:    syscallKprobe("unlink"),
 		EventTypes: []eval.EventType{"unlink"},
+		Optional:   true,
 	},
 	{
 		Name:       "sys_unlinkat",
@@ -0,0 +1,70 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"runtime"
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	sprobe "github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+)
+
+func TestCapset(t *testing.T) {
+	ruleDefs := []*rules.RuleDefinition{
+		{
+			ID:         "test_rule_capset",
+			Expression: `capset.old.cap_effective & CAP_SYS_ADMIN == 0 && capset.new.cap_effective & CAP_SYS_ADMIN != 0`,
+		},
+	}
+
+	test, err := newTestModule(nil, ruleDefs, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	// the capabilities are changed for the current thread only
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	header := unix.CapUserHeader{Version: unix.LINUX_CAPABILITY_VERSION_3}
+	var data [2]unix.CapUserData
+	if err := unix.Capget(&header, &data[0]); err != nil {
+		t.Fatal(err)
+	}
+	original := data
+
+	// drop CAP_SYS_ADMIN from the effective set, then raise it back from the permitted set
+	data[0].Effective &^= 1 << unix.CAP_SYS_ADMIN
+	if err := unix.Capset(&header, &data[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := unix.Capset(&header, &original[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.GetType() != "capset" {
+		t.Errorf("expected capset event, got %s", event.GetType())
+	}
+
+	if event.Capset.Old.Effective&(1<<unix.CAP_SYS_ADMIN) != 0 {
+		t.Errorf("expected CAP_SYS_ADMIN to be missing from the old effective set, got %s", sprobe.KernelCapability(event.Capset.Old.Effective))
+	}
+
+	if event.Capset.New.Effective&(1<<unix.CAP_SYS_ADMIN) == 0 {
+		t.Errorf("expected CAP_SYS_ADMIN in the new effective set, got %s", sprobe.KernelCapability(event.Capset.New.Effective))
+	}
+}
@@ -0,0 +1,78 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+)
+
+// testCgroupRoot returns the root of a control group hierarchy processes can be moved to
+func testCgroupRoot(t *testing.T) string {
+	// unified hierarchy
+	if _, err := os.Stat("/sys/fs/cgroup/cgroup.controllers"); err == nil {
+		return "/sys/fs/cgroup"
+	}
+
+	if _, err := os.Stat("/sys/fs/cgroup/pids/cgroup.procs"); err == nil {
+		return "/sys/fs/cgroup/pids"
+	}
+
+	t.Skip("no control group hierarchy available")
+	return ""
+}
+
+func TestCgroupPathExitedProcess(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `open.filename == "{{.Root}}/test-cgroup"`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	testFile, _, err := test.Path("test-cgroup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(testFile)
+
+	cgroupDir := path.Join(testCgroupRoot(t), "cws-test-cgroup")
+	if err := os.Mkdir(cgroupDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(cgroupDir)
+
+	// move the shell to the control group, then open the file from it
+	script := fmt.Sprintf("echo $$ > %s/cgroup.procs && exec touch %s", cgroupDir, testFile)
+	if err := exec.Command("sh", "-c", script).Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the process exited, the path can't be read from procfs anymore
+	if _, err := os.Stat(fmt.Sprintf("/proc/%d", event.Process.Pid)); err == nil {
+		t.Fatalf("expected process %d to have exited", event.Process.Pid)
+	}
+
+	if cgroupPath, _ := event.GetFieldValue("container.cgroup_path"); cgroupPath != "/cws-test-cgroup" {
+		t.Errorf("expected cgroup path `/cws-test-cgroup`, got `%v`", cgroupPath)
+	}
+}
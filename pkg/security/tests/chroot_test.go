@@ -0,0 +1,59 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+)
+
+func TestChroot(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `chroot.root.filename == "{{.Root}}/test-chroot"`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	testDir, _, err := test.Path("test-chroot")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Mkdir(testDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(testDir)
+
+	// the command doesn't exist in the new root, only the chroot call matters
+	_ = exec.Command("chroot", testDir, "/test-chroot").Run()
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.GetType() != "chroot" {
+		t.Errorf("expected chroot event, got %s", event.GetType())
+	}
+
+	if event.Chroot.Retval != 0 {
+		t.Errorf("expected chroot to succeed, got %d", event.Chroot.Retval)
+	}
+
+	if oldRoot, _ := event.GetFieldValue("chroot.old_root.filename"); oldRoot != "/" {
+		t.Errorf("expected old root `/`, got `%v`", oldRoot)
+	}
+}
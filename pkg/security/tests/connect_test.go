@@ -0,0 +1,76 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"net"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+)
+
+func TestSocket(t *testing.T) {
+	ruleDefs := []*rules.RuleDefinition{
+		{
+			ID:         "test_rule_bind",
+			Expression: `bind.source.port == 4242 && bind.family == AF_INET`,
+		},
+		{
+			ID:         "test_rule_connect",
+			Expression: `connect.destination.ip == "127.0.0.1" && connect.destination.port == 4242 && connect.type == SOCK_STREAM`,
+		},
+	}
+
+	test, err := newTestModule(nil, ruleDefs, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	listener, err := net.Listen("tcp4", "127.0.0.1:4242")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	t.Run("bind", func(t *testing.T) {
+		event, _, err := test.GetEvent()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if event.GetType() != "bind" {
+			t.Errorf("expected bind event, got %s", event.GetType())
+		}
+
+		if event.Bind.Source.IP != "127.0.0.1" {
+			t.Errorf("expected 127.0.0.1 source, got %s", event.Bind.Source.IP)
+		}
+	})
+
+	t.Run("connect", func(t *testing.T) {
+		conn, err := net.Dial("tcp4", "127.0.0.1:4242")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		event, _, err := test.GetEvent()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if event.GetType() != "connect" {
+			t.Errorf("expected connect event, got %s", event.GetType())
+		}
+
+		if event.Connect.Source.Port == 0 {
+			t.Error("expected a source port")
+		}
+	})
+}
@@ -0,0 +1,140 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	sprobe "github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+)
+
+const (
+	sysIOUringSetup = 425
+	sysIOUringEnter = 426
+
+	ioringOffSQRing      = 0
+	ioringOffSQEs        = 0x10000000
+	ioringOpOpenat       = 18
+	ioringEnterGetEvents = 1
+)
+
+type ioUringParams struct {
+	SQEntries    uint32
+	CQEntries    uint32
+	Flags        uint32
+	SQThreadCPU  uint32
+	SQThreadIdle uint32
+	Features     uint32
+	WQFd         uint32
+	Resv         [3]uint32
+	SQOff        [10]uint32 // struct io_sqring_offsets, array at index 6
+	CQOff        [10]uint32 // struct io_cqring_offsets
+}
+
+type ioUringSQE struct {
+	Opcode    uint8
+	Flags     uint8
+	IOPrio    uint16
+	Fd        int32
+	Off       uint64
+	Addr      uint64
+	Len       uint32
+	OpenFlags uint32
+	UserData  uint64
+	Pad       [3]uint64
+}
+
+// ioUringOpen opens the given file through a single entry io_uring instance, without calling open or openat
+func ioUringOpen(t *testing.T, filename string) {
+	var params ioUringParams
+	fd, _, errno := unix.Syscall(sysIOUringSetup, 1, uintptr(unsafe.Pointer(&params)), 0)
+	if errno != 0 {
+		t.Skipf("io_uring not supported: %s", errno)
+	}
+	defer unix.Close(int(fd))
+
+	tailOff, maskOff, arrayOff := params.SQOff[1], params.SQOff[2], params.SQOff[6]
+	sqRing, err := unix.Mmap(int(fd), ioringOffSQRing, int(arrayOff+params.SQEntries*4), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Munmap(sqRing)
+
+	sqes, err := unix.Mmap(int(fd), ioringOffSQEs, int(params.SQEntries)*int(unsafe.Sizeof(ioUringSQE{})), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Munmap(sqes)
+
+	path, err := unix.BytePtrFromString(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dirfd := int32(unix.AT_FDCWD)
+	sqe := (*ioUringSQE)(unsafe.Pointer(&sqes[0]))
+	*sqe = ioUringSQE{
+		Opcode:    ioringOpOpenat,
+		Fd:        dirfd,
+		Addr:      uint64(uintptr(unsafe.Pointer(path))),
+		OpenFlags: unix.O_RDONLY,
+	}
+
+	tail := (*uint32)(unsafe.Pointer(&sqRing[tailOff]))
+	mask := *(*uint32)(unsafe.Pointer(&sqRing[maskOff]))
+	*(*uint32)(unsafe.Pointer(&sqRing[arrayOff+(*tail&mask)*4])) = 0
+	*tail++
+
+	if _, _, errno := unix.Syscall6(sysIOUringEnter, fd, 1, 1, ioringEnterGetEvents, 0, 0); errno != 0 {
+		t.Fatal(errno)
+	}
+	runtime.KeepAlive(path)
+}
+
+func TestIOUringOpen(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: fmt.Sprintf(`io_uring.operation == IO_URING_OPEN && io_uring.filename == "/etc/hosts" && process.pid == %d`, os.Getpid()),
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	ioUringOpen(t, "/etc/hosts")
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.GetType() != "io_uring" {
+		t.Errorf("expected io_uring event, got %s", event.GetType())
+	}
+
+	if operation := sprobe.IOUringOperation(event.IOUring.Operation); operation != sprobe.IOUringOpen {
+		t.Errorf("expected open operation, got %s", operation)
+	}
+
+	if filename, _ := event.GetFieldValue("io_uring.filename"); filename != "/etc/hosts" {
+		t.Errorf("expected opened file /etc/hosts, got %v", filename)
+	}
+
+	if retval := event.IOUring.Retval; retval < 0 {
+		t.Errorf("expected a file descriptor, got %d", retval)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"syscall"
 	"testing"
 
+	"github.com/DataDog/datadog-agent/pkg/security/probe"
 	"github.com/DataDog/datadog-agent/pkg/security/rules"
 )
 
@@ -58,6 +59,7 @@ func TestLink(t *testing.T) {
 		if event.GetType() != "link" {
 			t.Errorf("expected link event, got %s", event.GetType())
 		}
+		assertLinkPaths(t, event, testOldFile, testNewFile)
 	}
 
 	if err := os.Remove(testNewFile); err != nil {
@@ -74,7 +76,18 @@ func TestLink(t *testing.T) {
 		t.Error(err)
 	} else {
 		if event.GetType() != "link" {
-			t.Errorf("expected rename event, got %s", event.GetType())
+			t.Errorf("expected link event, got %s", event.GetType())
 		}
+		assertLinkPaths(t, event, testOldFile, testNewFile)
+	}
+}
+
+func assertLinkPaths(t *testing.T, event *probe.Event, source, target string) {
+	if filename, _ := event.GetFieldValue("link.source.filename"); filename != source {
+		t.Errorf("expected link source %s, got %v", source, filename)
+	}
+
+	if filename, _ := event.GetFieldValue("link.target.filename"); filename != target {
+		t.Errorf("expected link target %s, got %v", target, filename)
 	}
 }
@@ -0,0 +1,141 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+)
+
+func TestMemfdCreate(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `memfd_create.name == "test-memfd"`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	fd, err := unix.MemfdCreate("test-memfd", unix.MFD_CLOEXEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.GetType() != "memfd_create" {
+		t.Errorf("expected memfd_create event, got %s", event.GetType())
+	}
+
+	if flags := event.MemfdCreate.Flags; flags != unix.MFD_CLOEXEC {
+		t.Errorf("expected memfd_create flag MFD_CLOEXEC, got %d", flags)
+	}
+
+	if retval := event.MemfdCreate.Retval; retval != int64(fd) {
+		t.Errorf("expected file descriptor %d, got %d", fd, retval)
+	}
+}
+
+func TestProcessFileless(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `process.fileless && process.memfd_name == "test-fileless" && open.filename == "/etc/hosts"`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	fd, err := unix.MemfdCreate("test-fileless", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	memfd := os.NewFile(uintptr(fd), "test-fileless")
+	defer memfd.Close()
+
+	executable, err := exec.LookPath("cat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := os.Open(executable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer src.Close()
+
+	if _, err := io.Copy(memfd, src); err != nil {
+		t.Fatal(err)
+	}
+
+	// executing the descriptor through procfs, like fexecve does, loads the memory file
+	if err := exec.Command(fmt.Sprintf("/proc/%d/fd/%d", os.Getpid(), fd), "/etc/hosts").Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name, _ := event.GetFieldValue("process.memfd_name"); name != "test-fileless" {
+		t.Errorf("expected memfd name test-fileless, got %v", name)
+	}
+}
+
+func TestProcessNotFilelessWithOpenMemfd(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `process.name == "head" && open.filename == "/etc/hosts"`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	// an open memory file doesn't affect the processes executed from files on disk
+	fd, err := unix.MemfdCreate("test-not-fileless", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	if err := exec.Command("head", "/etc/hosts").Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fileless, _ := event.GetFieldValue("process.fileless"); fileless != false {
+		t.Errorf("expected a process executed from a file, got fileless %v", fileless)
+	}
+
+	if name, _ := event.GetFieldValue("process.memfd_name"); name != "" {
+		t.Errorf("expected no memfd name, got %v", name)
+	}
+}
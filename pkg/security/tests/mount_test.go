@@ -85,3 +85,46 @@ func TestMount(t *testing.T) {
 		}
 	}
 }
+
+func TestMountRule(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule_mount",
+		Expression: `mount.source == "{{.Root}}/test-rule-mount" && mount.flags & MS_BIND != 0`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	mntPath, _, err := test.Path("test-rule-mount")
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.MkdirAll(mntPath, 0755)
+
+	dstMntPath, _, err := test.Path("test-rule-dest-mount")
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.MkdirAll(dstMntPath, 0755)
+
+	if err := syscall.Mount(mntPath, dstMntPath, "bind", syscall.MS_BIND, ""); err != nil {
+		t.Fatalf("could not create bind mount: %s", err)
+	}
+	defer syscall.Unmount(dstMntPath, syscall.MNT_DETACH)
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.GetType() != "mount" {
+		t.Errorf("expected mount event, got %s", event.GetType())
+	}
+
+	if event.Mount.SourceStr != mntPath {
+		t.Errorf("expected source %s, got %s", mntPath, event.Mount.SourceStr)
+	}
+}
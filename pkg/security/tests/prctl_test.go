@@ -0,0 +1,94 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	sprobe "github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+)
+
+func TestPrctlSetName(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `prctl.option == PR_SET_NAME && prctl.new_name == "test-prctl"`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	// the name is changed for the current thread only
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var comm [16]byte
+	if err := unix.Prctl(unix.PR_GET_NAME, uintptr(unsafe.Pointer(&comm[0])), 0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Prctl(unix.PR_SET_NAME, uintptr(unsafe.Pointer(&comm[0])), 0, 0, 0)
+
+	name, err := unix.BytePtrFromString("test-prctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := unix.Prctl(unix.PR_SET_NAME, uintptr(unsafe.Pointer(name)), 0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.GetType() != "prctl" {
+		t.Errorf("expected prctl event, got %s", event.GetType())
+	}
+
+	if oldName := string(bytes.TrimRight(comm[:], "\x00")); event.Prctl.OldName != oldName {
+		t.Errorf("expected old name %s, got %s", oldName, event.Prctl.OldName)
+	}
+
+	if name, _ := event.GetFieldValue("process.name"); name != "test-prctl" {
+		t.Errorf("expected process name test-prctl, got %v", name)
+	}
+}
+
+func TestPrctlSeccomp(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `prctl.option == PR_GET_SECCOMP && prctl.retval == SECCOMP_MODE_DISABLED`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	if err := unix.Prctl(unix.PR_GET_SECCOMP, 0, 0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if option := sprobe.PrctlOption(event.Prctl.Option); option != unix.PR_GET_SECCOMP {
+		t.Errorf("expected PR_GET_SECCOMP option, got %s", option)
+	}
+}
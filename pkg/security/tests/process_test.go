@@ -9,9 +9,13 @@ package tests
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
+	"os/exec"
 	"os/user"
 	"path"
+	"strings"
+	"syscall"
 	"testing"
 
 	"github.com/DataDog/datadog-agent/pkg/security/rules"
@@ -54,3 +58,267 @@ func TestProcess(t *testing.T) {
 		}
 	}
 }
+
+func TestProcessNamespaces(t *testing.T) {
+	ruleDef := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: fmt.Sprintf(`open.filename == "/etc/hosts" && process.pid == %d`, os.Getpid()),
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{ruleDef}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	f, err := os.Open("/etc/hosts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ns := range []string{"pid_for_children", "mnt", "net"} {
+		var stat syscall.Stat_t
+		if err := syscall.Stat("/proc/self/ns/"+ns, &stat); err != nil {
+			t.Fatal(err)
+		}
+
+		field := "process." + strings.TrimSuffix(ns, "_for_children") + "ns"
+		if inum, _ := event.GetFieldValue(field); inum != int(stat.Ino) {
+			t.Errorf("expected %s %d, got %v", field, stat.Ino, inum)
+		}
+	}
+}
+
+func TestProcessArgs(t *testing.T) {
+	ruleDef := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `process.name == "head" && process.args != "" && open.filename == "/etc/hosts"`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{ruleDef}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	t.Run("args", func(t *testing.T) {
+		if err := exec.Command("head", "-n", "1", "/etc/hosts").Run(); err != nil {
+			t.Fatal(err)
+		}
+
+		event, _, err := test.GetEvent()
+		if err != nil {
+			t.Error(err)
+		} else {
+			if args := event.Process.Args; args != "head -n 1 /etc/hosts" {
+				t.Errorf("expected process args 'head -n 1 /etc/hosts', got '%s'", args)
+			}
+
+			if event.Process.ArgsTruncated {
+				t.Error("expected process args not to be truncated")
+			}
+		}
+	})
+
+	t.Run("boundary", func(t *testing.T) {
+		// 63 bytes plus the trailing NUL byte fill exactly the kernel buffer of an argument
+		arg := strings.Repeat("/", 54) + "etc/hosts"
+
+		if err := exec.Command("head", "-n", "1", arg).Run(); err != nil {
+			t.Fatal(err)
+		}
+
+		event, _, err := test.GetEvent()
+		if err != nil {
+			t.Error(err)
+		} else {
+			if args := event.Process.Args; args != "head -n 1 "+arg {
+				t.Errorf("expected process args 'head -n 1 %s', got '%s'", arg, args)
+			}
+
+			if event.Process.ArgsTruncated {
+				t.Error("expected process args not to be truncated")
+			}
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		args := []string{"-q"}
+		for i := 0; i != 20; i++ {
+			args = append(args, "-n", "1")
+		}
+		args = append(args, "/etc/hosts")
+
+		if err := exec.Command("head", args...).Run(); err != nil {
+			t.Fatal(err)
+		}
+
+		event, _, err := test.GetEvent()
+		if err != nil {
+			t.Error(err)
+		} else {
+			if !event.Process.ArgsTruncated {
+				t.Error("expected process args to be truncated")
+			}
+		}
+	})
+}
+
+func TestProcessEnvs(t *testing.T) {
+	ruleDef := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `process.name == "head" && process.envs != "" && open.filename == "/etc/hosts"`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{ruleDef}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	cmd := exec.Command("head", "-n", "1", "/etc/hosts")
+	cmd.Env = []string{"FOO=bar", "LD_LIBRARY_PATH=/tmp/test-envs"}
+	if err := cmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if envs := event.Process.Envs; envs != "LD_LIBRARY_PATH=/tmp/test-envs" {
+			t.Errorf("expected process envs 'LD_LIBRARY_PATH=/tmp/test-envs', got '%s'", envs)
+		}
+
+		if event.Process.EnvsTruncated {
+			t.Error("expected process envs not to be truncated")
+		}
+	}
+}
+
+func TestProcessFork(t *testing.T) {
+	executable, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ruleDefs := []*rules.RuleDefinition{
+		{
+			ID:         "test_rule_fork",
+			Expression: fmt.Sprintf(`fork.child_pid != 0 && process.name == "%s"`, path.Base(executable)),
+		},
+		{
+			ID:         "test_rule_exec",
+			Expression: `process.name == "head" && open.filename == "/etc/hosts"`,
+		},
+	}
+
+	test, err := newTestModule(nil, ruleDefs, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	if err := exec.Command("head", "-n", "1", "/etc/hosts").Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	event, rule, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.ID != "test_rule_fork" {
+		t.Fatalf("expected rule 'test_rule_fork' to be triggered, got %s", rule.ID)
+	}
+	if pid := event.Process.Pid; pid != uint32(os.Getpid()) {
+		t.Errorf("expected fork parent pid %d, got %d", os.Getpid(), pid)
+	}
+	childPid := event.Fork.ChildPID
+
+	event, rule, err = test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.ID != "test_rule_exec" {
+		t.Fatalf("expected rule 'test_rule_exec' to be triggered, got %s", rule.ID)
+	}
+	if pid := event.Process.Pid; pid != childPid {
+		t.Errorf("expected the executed process pid to be the fork child pid %d, got %d", childPid, pid)
+	}
+}
+
+func TestProcessExit(t *testing.T) {
+	ruleDef := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `process.name == "sleep" && exit.cause == SIGNALED && exit.code == SIGKILL`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{ruleDef}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	cmd.Process.Kill()
+	cmd.Wait()
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "exit" {
+			t.Errorf("expected exit event, got %s", event.GetType())
+		}
+
+		if pid := event.Process.Pid; pid != uint32(cmd.Process.Pid) {
+			t.Errorf("expected exit pid %d, got %d", cmd.Process.Pid, pid)
+		}
+	}
+}
+
+func TestProcessScript(t *testing.T) {
+	ruleDef := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `process.script.filename == "{{.Root}}/test-script" && open.filename == "/etc/hosts"`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{ruleDef}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	testScript, _, err := test.Path("test-script")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the redirection is done by the shell itself, the open event is reported with its context
+	if err := ioutil.WriteFile(testScript, []byte("#!/bin/sh\n: < /etc/hosts\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(testScript)
+
+	if err := exec.Command(testScript).Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if filename, _ := event.GetFieldValue("process.filename"); filename == testScript {
+		t.Errorf("expected the interpreter as executable, got %v", filename)
+	}
+}
@@ -0,0 +1,57 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os/exec"
+	"runtime"
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+)
+
+func TestPtrace(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `ptrace.request == PTRACE_ATTACH`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Process.Kill()
+
+	// the tracer is the calling thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := syscall.PtraceAttach(cmd.Process.Pid); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "ptrace" {
+			t.Errorf("expected ptrace event, got %s", event.GetType())
+		}
+
+		if pid := event.Ptrace.PID; pid != uint32(cmd.Process.Pid) {
+			t.Errorf("expected ptrace pid %d, got %d", cmd.Process.Pid, pid)
+		}
+	}
+}
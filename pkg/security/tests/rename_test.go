@@ -13,9 +13,22 @@ import (
 	"syscall"
 	"testing"
 
+	sprobe "github.com/DataDog/datadog-agent/pkg/security/probe"
 	"github.com/DataDog/datadog-agent/pkg/security/rules"
 )
 
+func assertRenamePaths(t *testing.T, event *sprobe.Event, oldFile, newFile string) {
+	t.Helper()
+
+	if filename, _ := event.GetFieldValue("rename.old.filename"); filename != oldFile {
+		t.Errorf("expected old filename %s, got %v", oldFile, filename)
+	}
+
+	if filename, _ := event.GetFieldValue("rename.new.filename"); filename != newFile {
+		t.Errorf("expected new filename %s, got %v", newFile, filename)
+	}
+}
+
 func TestRename(t *testing.T) {
 	rule := &rules.RuleDefinition{
 		ID:         "test_rule",
@@ -60,6 +73,7 @@ func TestRename(t *testing.T) {
 			if event.GetType() != "rename" {
 				t.Errorf("expected rename event, got %s", event.GetType())
 			}
+			assertRenamePaths(t, event, testOldFile, testNewFile)
 		}
 	})
 
@@ -80,6 +94,7 @@ func TestRename(t *testing.T) {
 			if event.GetType() != "rename" {
 				t.Errorf("expected rename event, got %s", event.GetType())
 			}
+			assertRenamePaths(t, event, testOldFile, testNewFile)
 		}
 	})
 
@@ -110,6 +125,7 @@ func TestRename(t *testing.T) {
 			if event.GetType() != "rename" {
 				t.Errorf("expected rename event, got %s", event.GetType())
 			}
+			assertRenamePaths(t, event, testOldFile, testNewFile)
 		}
 	})
 }
@@ -0,0 +1,118 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"runtime"
+	"syscall"
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	sprobe "github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+)
+
+func TestSetID(t *testing.T) {
+	ruleDefs := []*rules.RuleDefinition{
+		{
+			ID:         "test_rule_setuid",
+			Expression: `setuid.new.fsuid == 1001`,
+		},
+		{
+			ID:         "test_rule_setgid",
+			Expression: `setgid.new.fsgid == 1001`,
+		},
+	}
+
+	test, err := newTestModule(nil, ruleDefs, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	// the credentials are changed for the current thread only
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	t.Run("setfsuid", func(t *testing.T) {
+		syscall.RawSyscall(syscall.SYS_SETFSUID, 1001, 0, 0)
+		defer syscall.RawSyscall(syscall.SYS_SETFSUID, 0, 0, 0)
+
+		event, _, err := test.GetEvent()
+		if err != nil {
+			t.Error(err)
+		} else {
+			if event.GetType() != "setuid" {
+				t.Errorf("expected setuid event, got %s", event.GetType())
+			}
+
+			if event.SetUID.Old.FSUID != 0 {
+				t.Errorf("expected old fsuid 0, got %d", event.SetUID.Old.FSUID)
+			}
+		}
+	})
+
+	t.Run("setfsgid", func(t *testing.T) {
+		syscall.RawSyscall(syscall.SYS_SETFSGID, 1001, 0, 0)
+		defer syscall.RawSyscall(syscall.SYS_SETFSGID, 0, 0, 0)
+
+		event, _, err := test.GetEvent()
+		if err != nil {
+			t.Error(err)
+		} else {
+			if event.GetType() != "setgid" {
+				t.Errorf("expected setgid event, got %s", event.GetType())
+			}
+
+			if event.SetGID.Old.FSGID != 0 {
+				t.Errorf("expected old fsgid 0, got %d", event.SetGID.Old.FSGID)
+			}
+		}
+	})
+}
+
+func TestCommitCreds(t *testing.T) {
+	ruleDefs := []*rules.RuleDefinition{
+		{
+			ID:         "test_rule_commit_creds",
+			Expression: `commit_creds.new.fsuid == 1002 && commit_creds.old.cap_effective & CAP_SYS_ADMIN != 0`,
+		},
+	}
+
+	test, err := newTestModule(nil, ruleDefs, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	// the credentials are changed for the current thread only
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	syscall.RawSyscall(syscall.SYS_SETFSUID, 1002, 0, 0)
+	defer syscall.RawSyscall(syscall.SYS_SETFSUID, 0, 0, 0)
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.GetType() != "commit_creds" {
+		t.Errorf("expected commit_creds event, got %s", event.GetType())
+	}
+
+	if event.CommitCreds.Old.FSUID != 0 {
+		t.Errorf("expected old fsuid 0, got %d", event.CommitCreds.Old.FSUID)
+	}
+
+	// dropping the root filesystem uid clears the filesystem related capabilities
+	if event.CommitCreds.New.CapabilitySets.Effective&(1<<unix.CAP_CHOWN) != 0 {
+		t.Errorf("expected CAP_CHOWN to be dropped, got %s", sprobe.KernelCapability(event.CommitCreds.New.CapabilitySets.Effective))
+	}
+}
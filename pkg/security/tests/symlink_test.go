@@ -0,0 +1,82 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+)
+
+func TestSymlink(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `symlink.target == "/etc/passwd" && symlink.linkpath.filename =~ "{{.Root}}/test-symlink*"`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	target, err := syscall.BytePtrFromString("/etc/passwd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSymlinkEvent := func(t *testing.T, linkpath string) {
+		event, _, err := test.GetEvent()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if event.GetType() != "symlink" {
+			t.Errorf("expected symlink event, got %s", event.GetType())
+		}
+
+		if event.Symlink.Target != "/etc/passwd" {
+			t.Errorf("expected symlink target /etc/passwd, got %s", event.Symlink.Target)
+		}
+
+		if filename, _ := event.GetFieldValue("symlink.linkpath.filename"); filename != linkpath {
+			t.Errorf("expected symlink linkpath %s, got %v", linkpath, filename)
+		}
+	}
+
+	t.Run("symlink", func(t *testing.T) {
+		testFile, testFilePtr, err := test.Path("test-symlink")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, errno := syscall.Syscall(syscall.SYS_SYMLINK, uintptr(unsafe.Pointer(target)), uintptr(testFilePtr), 0); errno != 0 {
+			t.Fatal(error(errno))
+		}
+		defer os.Remove(testFile)
+
+		assertSymlinkEvent(t, testFile)
+	})
+
+	t.Run("symlinkat", func(t *testing.T) {
+		testFile, testFilePtr, err := test.Path("test-symlinkat")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, errno := syscall.Syscall(syscall.SYS_SYMLINKAT, uintptr(unsafe.Pointer(target)), 0, uintptr(testFilePtr)); errno != 0 {
+			t.Fatal(error(errno))
+		}
+		defer os.Remove(testFile)
+
+		assertSymlinkEvent(t, testFile)
+	})
+}
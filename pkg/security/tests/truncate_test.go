@@ -0,0 +1,88 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+)
+
+func TestTruncate(t *testing.T) {
+	rule := &rules.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `truncate.filename == "{{.Root}}/test-truncate"`,
+	}
+
+	test, err := newTestModule(nil, []*rules.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	testFile, testFilePtr, err := test.Path("test-truncate")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(testFile, []byte("some log lines"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(testFile)
+
+	t.Run("truncate", func(t *testing.T) {
+		if _, _, errno := syscall.Syscall(syscall.SYS_TRUNCATE, uintptr(testFilePtr), 4, 0); errno != 0 {
+			t.Fatal(error(errno))
+		}
+
+		event, _, err := test.GetEvent()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if event.GetType() != "truncate" {
+			t.Errorf("expected truncate event, got %s", event.GetType())
+		}
+
+		if length := event.Truncate.Length; length != 4 {
+			t.Errorf("expected truncate length 4, got %d", length)
+		}
+
+		if event.Truncate.Wipe {
+			t.Error("expected a partial truncation not to be reported as a wipe")
+		}
+	})
+
+	t.Run("ftruncate", func(t *testing.T) {
+		f, err := os.OpenFile(testFile, os.O_WRONLY, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		if _, _, errno := syscall.Syscall(syscall.SYS_FTRUNCATE, f.Fd(), 0, 0); errno != 0 {
+			t.Fatal(error(errno))
+		}
+
+		event, _, err := test.GetEvent()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if event.GetType() != "truncate" {
+			t.Errorf("expected truncate event, got %s", event.GetType())
+		}
+
+		if !event.Truncate.Wipe {
+			t.Errorf("expected a truncation to zero bytes to be reported as a wipe, got length %d", event.Truncate.Length)
+		}
+	})
+}
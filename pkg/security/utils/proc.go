@@ -8,8 +8,14 @@
 package utils
 
 import (
+	"bufio"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
 
 	"github.com/DataDog/datadog-agent/pkg/process/util"
 )
@@ -33,3 +39,46 @@ func CgroupTaskPath(tgid, pid uint32) string {
 func ProcExePath(pid uint32) string {
 	return filepath.Join(util.HostProc(), fmt.Sprintf("%d/exe", pid))
 }
+
+// StatusPath returns the path to the status file of the current pid in /proc
+func StatusPath() string {
+	return filepath.Join(util.HostProc(), "/self/status")
+}
+
+// GetHostPid returns the pid of the current process in the pid namespace of /proc, which is the host pid namespace
+// when the host /proc is mounted. os.Getpid returns the pid in the namespace of the process, which differs when
+// running in a container.
+func GetHostPid() (uint32, error) {
+	f, err := os.Open(StatusPath())
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var pidField string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		// NSpid lists the pids from the outermost to the innermost namespace, Pid is used on kernels without NSpid
+		if strings.HasPrefix(line, "NSpid:") || (pidField == "" && strings.HasPrefix(line, "Pid:")) {
+			fields := strings.Fields(line)
+			if len(fields) > 1 {
+				pidField = fields[1]
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	if pidField == "" {
+		return 0, errors.Errorf("no pid found in %s", StatusPath())
+	}
+
+	pid, err := strconv.ParseUint(pidField, 10, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid pid in %s", StatusPath())
+	}
+
+	return uint32(pid), nil
+}
@@ -12,7 +12,9 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 )
 
 // Replacer structure to store regex matching and replacement functions
@@ -103,6 +105,66 @@ func AddStrippedKeys(strippedKeys []string) {
 	}
 }
 
+// minSecretValueLength is the minimum length of the values scrubbed by AddSecretValues, shorter values would scrub
+// unrelated parts of the logs
+const minSecretValueLength = 4
+
+var (
+	secretValuesLock     sync.RWMutex
+	secretValues         = map[string]struct{}{}
+	secretValuesReplacer *strings.Replacer
+)
+
+// AddSecretValues registers values that are scrubbed wherever they appear, including within larger strings like
+// connection URLs. Values shorter than 4 characters are ignored.
+func AddSecretValues(values ...string) {
+	secretValuesLock.Lock()
+	defer secretValuesLock.Unlock()
+
+	added := false
+	for _, value := range values {
+		if len(value) < minSecretValueLength {
+			continue
+		}
+		if _, ok := secretValues[value]; !ok {
+			secretValues[value] = struct{}{}
+			added = true
+		}
+	}
+	if !added {
+		return
+	}
+
+	// longest values first so that a value containing another one is fully scrubbed
+	sorted := make([]string, 0, len(secretValues))
+	for value := range secretValues {
+		sorted = append(sorted, value)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if len(sorted[i]) != len(sorted[j]) {
+			return len(sorted[i]) > len(sorted[j])
+		}
+		return sorted[i] < sorted[j]
+	})
+
+	oldnew := make([]string, 0, 2*len(sorted))
+	for _, value := range sorted {
+		oldnew = append(oldnew, value, "********")
+	}
+	secretValuesReplacer = strings.NewReplacer(oldnew...)
+}
+
+func scrubSecretValues(b []byte) []byte {
+	secretValuesLock.RLock()
+	replacer := secretValuesReplacer
+	secretValuesLock.RUnlock()
+
+	if replacer == nil {
+		return b
+	}
+	return []byte(replacer.Replace(string(b)))
+}
+
 // CredentialsCleanerFile scrubs credentials from file in path
 func CredentialsCleanerFile(filePath string) ([]byte, error) {
 	file, err := os.Open(filePath)
@@ -177,5 +239,5 @@ func credentialsCleaner(file io.Reader) ([]byte, error) {
 		}
 	}
 
-	return cleanedFile, nil
+	return scrubSecretValues(cleanedFile), nil
 }
@@ -353,3 +353,22 @@ log_level: info`
 
 	assert.Equal(t, cleanedConfigFile, cleanedString)
 }
+
+func TestSecretValues(t *testing.T) {
+	defer func() {
+		secretValues = map[string]struct{}{}
+		secretValuesReplacer = nil
+	}()
+
+	AddSecretValues("s3cr3t", "s3cr3t_longer", "abc")
+
+	assertClean(t,
+		`url: https://localhost:8080/api?auth=s3cr3t&retry=1`,
+		`url: https://localhost:8080/api?auth=********&retry=1`)
+	assertClean(t,
+		`connecting with host=localhost secret=s3cr3t_longer`,
+		`connecting with host=localhost secret=********`)
+	assertClean(t,
+		`options: abc`,
+		`options: abc`)
+}